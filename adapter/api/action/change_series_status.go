@@ -0,0 +1,85 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type ChangeSeriesStatusAction struct {
+	uc        usecase.ChangeSeriesStatusUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewChangeSeriesStatusAction(
+	uc usecase.ChangeSeriesStatusUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) ChangeSeriesStatusAction {
+	return ChangeSeriesStatusAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a ChangeSeriesStatusAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+
+	input := usecase.ChangeSeriesStatusInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.ID = seriesID
+
+	version, conditional, err := ifMatchVersion(r)
+	if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	if conditional {
+		input.Version = version
+	}
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrStatusEndYear):
+		res = response.NewError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrStatusTransition):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification) && conditional:
+		res = response.NewError(http.StatusPreconditionFailed, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("ETag", etag(output.Version)).
+			WithLinks(seriesLinks(a.urls, output.ID))
+	}
+}
@@ -0,0 +1,160 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChangeSeriesStatusUseCase struct {
+	output usecase.ChangeSeriesStatusOutput
+	err    error
+}
+
+func (uc mockChangeSeriesStatusUseCase) Execute(
+	context.Context,
+	usecase.ChangeSeriesStatusInput,
+) (usecase.ChangeSeriesStatusOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestChangeSeriesStatusAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.ChangeSeriesStatusUseCase
+		IfMatch      string
+		ExpectedCode int
+		ExpectedETag string
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful change",
+			UC: mockChangeSeriesStatusUseCase{
+				output: usecase.ChangeSeriesStatusOutput{
+					ID:        "ID",
+					Status:    "ended",
+					BeginYear: 1970,
+					EndYear:   1980,
+					Version:   2,
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedETag: `"2"`,
+			ExpectedBody: usecase.ChangeSeriesStatusOutput{
+				ID:        "ID",
+				Status:    "ended",
+				BeginYear: 1970,
+				EndYear:   1980,
+				Version:   2,
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockChangeSeriesStatusUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "End year does not match the status",
+			UC: mockChangeSeriesStatusUseCase{
+				err: domain.ErrStatusEndYear,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrStatusEndYear.Error()},
+			},
+		},
+
+		{
+			Description: "Transition not allowed",
+			UC: mockChangeSeriesStatusUseCase{
+				err: domain.ErrStatusTransition,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrStatusTransition.Error()},
+			},
+		},
+
+		{
+			Description: "Stale version in If-Match",
+			UC: mockChangeSeriesStatusUseCase{
+				err: domain.ErrConcurrentModification,
+			},
+			IfMatch:      `"1"`,
+			ExpectedCode: http.StatusPreconditionFailed,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrConcurrentModification.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockChangeSeriesStatusUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "ended",
+				EndYear: 1980,
+			})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			if test.IfMatch != "" {
+				req.Header.Set("If-Match", test.IfMatch)
+			}
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewChangeSeriesStatusAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.ChangeSeriesStatusOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+				assert.Equal(test.ExpectedETag, recorder.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -1,8 +1,29 @@
 package action
 
+import (
+	"net/http"
+	"strconv"
+)
+
 type CtxKey string
 
 const (
-	CtxKeySeriesID   CtxKey = "series_id"
-	CtxKeyTitleQuery CtxKey = "title_query"
+	CtxKeySeriesID     CtxKey = "series_id"
+	CtxKeySeasonNumber CtxKey = "season_number"
+	CtxKeyEpisodeID    CtxKey = "episode_id"
+	CtxKeyReviewID     CtxKey = "review_id"
+	CtxKeyPersonID     CtxKey = "person_id"
+	CtxKeyWebhookID    CtxKey = "webhook_id"
+	CtxKeyLanguage     CtxKey = "language"
 )
+
+// seasonNumber returns the season number the request context carries,
+// as set from the path. ok is false when it isn't a positive integer.
+func seasonNumber(r *http.Request) (number int, ok bool) {
+	value, _ := r.Context().Value(CtxKeySeasonNumber).(string)
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, false
+	}
+	return number, true
+}
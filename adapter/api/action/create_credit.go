@@ -0,0 +1,75 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type CreateCreditAction struct {
+	uc        usecase.CreateCreditUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreateCreditAction(
+	uc usecase.CreateCreditUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreateCreditAction {
+	return CreateCreditAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreateCreditAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+
+	input := usecase.CreateCreditInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.SeriesID = seriesID
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrPersonNotFound):
+		res = response.NewError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrCreditExists):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"series":         {RouteSeries, []string{"id", output.SeriesID}},
+				"series_credits": {RouteSeriesCredits, []string{"id", output.SeriesID}},
+				"person_credits": {RoutePersonCredits, []string{"id", output.PersonID}},
+			}))
+	}
+}
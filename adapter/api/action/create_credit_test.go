@@ -0,0 +1,158 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateCreditUseCase struct {
+	output usecase.CreateCreditOutput
+	err    error
+}
+
+func (uc mockCreateCreditUseCase) Execute(
+	context.Context,
+	usecase.CreateCreditInput,
+) (usecase.CreateCreditOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateCreditAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateCreditUseCase
+		SeriesID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateCreditUseCase{
+				output: usecase.CreateCreditOutput{
+					ID:        "CreditID",
+					SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					PersonID:  "PersonID",
+					Name:      "Bob Odenkirk",
+					Role:      "actor",
+					Character: "Jimmy McGill",
+					Episodes:  63,
+				},
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateCreditOutput{
+				ID:        "CreditID",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				PersonID:  "PersonID",
+				Name:      "Bob Odenkirk",
+				Role:      "actor",
+				Character: "Jimmy McGill",
+				Episodes:  63,
+			},
+		},
+
+		{
+			Description:  "Invalid series id",
+			UC:           mockCreateCreditUseCase{},
+			SeriesID:     "SeriesID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing series id"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockCreateCreditUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Person not found",
+			UC: mockCreateCreditUseCase{
+				err: domain.ErrPersonNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrPersonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Credit already exists",
+			UC: mockCreateCreditUseCase{
+				err: domain.ErrCreditExists,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrCreditExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateCreditUseCase{
+				err: errors.New("error"),
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateCreditInput{
+				PersonID:  "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+				Role:      "actor",
+				Character: "Jimmy McGill",
+				Episodes:  63,
+			})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeySeriesID, test.SeriesID)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateCreditAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreateCreditOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type CreateEpisodeAction struct {
+	uc        usecase.CreateEpisodeUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreateEpisodeAction(
+	uc usecase.CreateEpisodeUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreateEpisodeAction {
+	return CreateEpisodeAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreateEpisodeAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+	season, ok := seasonNumber(r)
+	if !ok {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing season number")
+		return
+	}
+
+	input := usecase.CreateEpisodeInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.SeriesID = seriesID
+	input.Season = season
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrSeasonNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrEpisodeExists):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(seasonLinks(a.urls, output.SeriesID, output.Season))
+	}
+}
@@ -0,0 +1,181 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateEpisodeUseCase struct {
+	output usecase.CreateEpisodeOutput
+	err    error
+}
+
+func (uc mockCreateEpisodeUseCase) Execute(
+	context.Context,
+	usecase.CreateEpisodeInput,
+) (usecase.CreateEpisodeOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateEpisodeAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateEpisodeUseCase
+		Season       string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateEpisodeUseCase{
+				output: usecase.CreateEpisodeOutput{
+					ID:      "ID",
+					Season:  5,
+					Number:  14,
+					Title:   "Ozymandias",
+					Runtime: 47,
+				},
+			},
+			Season:       "5",
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateEpisodeOutput{
+				ID:      "ID",
+				Season:  5,
+				Number:  14,
+				Title:   "Ozymandias",
+				Runtime: 47,
+			},
+		},
+
+		{
+			Description:  "Invalid season number",
+			UC:           mockCreateEpisodeUseCase{},
+			Season:       "0",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing season number"},
+			},
+		},
+
+		{
+			Description: "Season not found",
+			UC: mockCreateEpisodeUseCase{
+				err: domain.ErrSeasonNotFound,
+			},
+			Season:       "5",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeasonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Episode number taken",
+			UC: mockCreateEpisodeUseCase{
+				err: domain.ErrEpisodeExists,
+			},
+			Season:       "5",
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrEpisodeExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateEpisodeUseCase{
+				err: errors.New("error"),
+			},
+			Season:       "5",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateEpisodeInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			ctx = context.WithValue(ctx, CtxKeySeasonNumber, test.Season)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateEpisodeAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreateEpisodeOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
+
+func TestCreateEpisodeLinks(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+
+	req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader([]byte("{}")))
+	assert.Nil(err)
+	req.Header.Set("Accept", "application/hal+json")
+	ctx := context.WithValue(
+		req.Context(),
+		CtxKeySeriesID,
+		"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+	)
+	ctx = context.WithValue(ctx, CtxKeySeasonNumber, "5")
+	req = req.WithContext(ctx)
+	recorder := httptest.NewRecorder()
+
+	uc := mockCreateEpisodeUseCase{
+		output: usecase.CreateEpisodeOutput{
+			SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Season:   5,
+		},
+	}
+	action := NewCreateEpisodeAction(uc, mockValidator{}, mockURLBuilder{})
+	action.Execute(recorder, req)
+
+	output := struct {
+		Links response.Links `json:"_links"`
+	}{}
+	assert.Equal(http.StatusCreated, recorder.Code)
+	assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+	assert.Equal(response.Links{
+		"series":   {Href: "/series/1be9775b-8d32-4710-9ce6-7ece88e30f01"},
+		"seasons":  {Href: "/series.seasons/1be9775b-8d32-4710-9ce6-7ece88e30f01"},
+		"episodes": {Href: "/season.episodes/1be9775b-8d32-4710-9ce6-7ece88e30f01/5"},
+	}, output.Links)
+}
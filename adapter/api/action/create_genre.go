@@ -0,0 +1,62 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type CreateGenreAction struct {
+	uc        usecase.CreateGenreUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreateGenreAction(
+	uc usecase.CreateGenreUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreateGenreAction {
+	return CreateGenreAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreateGenreAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	input := usecase.CreateGenreInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrGenreExists):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"genres": {RouteGenres, nil},
+			}))
+	}
+}
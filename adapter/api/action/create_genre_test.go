@@ -0,0 +1,102 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateGenreUseCase struct {
+	output usecase.CreateGenreOutput
+	err    error
+}
+
+func (uc mockCreateGenreUseCase) Execute(
+	context.Context,
+	usecase.CreateGenreInput,
+) (usecase.CreateGenreOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateGenreAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateGenreUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateGenreUseCase{
+				output: usecase.CreateGenreOutput{
+					ID:   "GenreID",
+					Name: "Drama",
+				},
+			},
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateGenreOutput{
+				ID:   "GenreID",
+				Name: "Drama",
+			},
+		},
+
+		{
+			Description: "Genre already exists",
+			UC: mockCreateGenreUseCase{
+				err: domain.ErrGenreExists,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrGenreExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateGenreUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateGenreInput{Name: "Drama"})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateGenreAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreateGenreOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type CreateNetworkAction struct {
+	uc        usecase.CreateNetworkUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreateNetworkAction(
+	uc usecase.CreateNetworkUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreateNetworkAction {
+	return CreateNetworkAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreateNetworkAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	input := usecase.CreateNetworkInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrNetworkExists):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"networks": {RouteNetworks, nil},
+			}))
+	}
+}
@@ -0,0 +1,102 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateNetworkUseCase struct {
+	output usecase.CreateNetworkOutput
+	err    error
+}
+
+func (uc mockCreateNetworkUseCase) Execute(
+	context.Context,
+	usecase.CreateNetworkInput,
+) (usecase.CreateNetworkOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateNetworkAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateNetworkUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateNetworkUseCase{
+				output: usecase.CreateNetworkOutput{
+					ID:   "NetworkID",
+					Name: "HBO",
+				},
+			},
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateNetworkOutput{
+				ID:   "NetworkID",
+				Name: "HBO",
+			},
+		},
+
+		{
+			Description: "Network already exists",
+			UC: mockCreateNetworkUseCase{
+				err: domain.ErrNetworkExists,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrNetworkExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateNetworkUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateNetworkInput{Name: "HBO", Country: "US"})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateNetworkAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreateNetworkOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/usecase"
+)
+
+type CreatePersonAction struct {
+	uc        usecase.CreatePersonUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreatePersonAction(
+	uc usecase.CreatePersonUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreatePersonAction {
+	return CreatePersonAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreatePersonAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	input := usecase.CreatePersonInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"credits": {RoutePersonCredits, []string{"id", output.ID}},
+			}))
+	}
+}
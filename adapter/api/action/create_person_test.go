@@ -0,0 +1,90 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreatePersonUseCase struct {
+	output usecase.CreatePersonOutput
+	err    error
+}
+
+func (uc mockCreatePersonUseCase) Execute(
+	context.Context,
+	usecase.CreatePersonInput,
+) (usecase.CreatePersonOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreatePersonAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreatePersonUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreatePersonUseCase{
+				output: usecase.CreatePersonOutput{
+					ID:   "PersonID",
+					Name: "Vince Gilligan",
+				},
+			},
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreatePersonOutput{
+				ID:   "PersonID",
+				Name: "Vince Gilligan",
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreatePersonUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreatePersonInput{Name: "Vince Gilligan"})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewCreatePersonAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreatePersonOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
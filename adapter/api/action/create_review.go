@@ -1,9 +1,9 @@
 package action
 
 import (
-	"encoding/json"
 	"errors"
 	"net/http"
+	"series/adapter/api/request"
 	"series/adapter/api/response"
 	"series/adapter/validator"
 	"series/domain"
@@ -13,24 +13,30 @@ import (
 type CreateReviewAction struct {
 	uc        usecase.CreateReviewUseCase
 	validator validator.Validator
+	urls      URLBuilder
 }
 
 func NewCreateReviewAction(
 	uc usecase.CreateReviewUseCase,
 	validator validator.Validator,
+	urls URLBuilder,
 ) CreateReviewAction {
 	return CreateReviewAction{
 		uc:        uc,
 		validator: validator,
+		urls:      urls,
 	}
 }
 
 func (a CreateReviewAction) Execute(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
-	defer func() { res.Send(w) }()
+	defer func() { res.Send(w, r) }()
 
 	input := usecase.CreateReviewInput{}
-	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
 		res = response.NewError(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -43,11 +49,15 @@ func (a CreateReviewAction) Execute(w http.ResponseWriter, r *http.Request) {
 
 	output, err := a.uc.Execute(r.Context(), input)
 	switch {
-	case errors.Is(err, domain.ErrSeriesNotFound):
+	case errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrEpisodeNotFound):
 		res = response.NewError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrAlreadyReviewed):
+		res = response.NewError(http.StatusConflict, err.Error())
 	case err != nil:
 		res = response.NewError(http.StatusInternalServerError)
 	default:
-		res = response.NewSuccess(http.StatusCreated, output)
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(reviewLinks(a.urls, output.ID, output.SeriesID, output.EpisodeID))
 	}
 }
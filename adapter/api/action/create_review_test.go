@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"series/adapter/api/response"
 	"series/domain"
 	"series/usecase"
 	"testing"
@@ -68,6 +69,30 @@ func TestCreateReviewAction(t *testing.T) {
 			},
 		},
 
+		{
+			Description: "Create review for episode that does not exist",
+			UC: mockCreateReviewUsecase{
+				output: usecase.CreateReviewOutput{},
+				err:    domain.ErrEpisodeNotFound,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrEpisodeNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Author already reviewed the target",
+			UC: mockCreateReviewUsecase{
+				output: usecase.CreateReviewOutput{},
+				err:    domain.ErrAlreadyReviewed,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrAlreadyReviewed.Error()},
+			},
+		},
+
 		{
 			Description: "Generic error",
 			UC: mockCreateReviewUsecase{
@@ -89,7 +114,7 @@ func TestCreateReviewAction(t *testing.T) {
 			assert.Nil(err)
 			recorder := httptest.NewRecorder()
 
-			action := NewCreateReviewAction(test.UC, mockValidator{})
+			action := NewCreateReviewAction(test.UC, mockValidator{}, mockURLBuilder{})
 			action.Execute(recorder, req)
 
 			assert.Equal(test.ExpectedCode, recorder.Code)
@@ -107,3 +132,61 @@ func TestCreateReviewAction(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateReviewLinks(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Output      usecase.CreateReviewOutput
+		Expected    response.Links
+	}
+	tests := []Test{
+		{
+			Description: "Series review",
+			Output: usecase.CreateReviewOutput{
+				ID:       "ReviewID",
+				SeriesID: "SeriesID",
+			},
+			Expected: response.Links{
+				"self":   {Href: "/review/ReviewID"},
+				"series": {Href: "/series/SeriesID"},
+			},
+		},
+		{
+			Description: "Episode review",
+			Output: usecase.CreateReviewOutput{
+				ID:        "ReviewID",
+				SeriesID:  "SeriesID",
+				EpisodeID: "EpisodeID",
+			},
+			Expected: response.Links{
+				"self":            {Href: "/review/ReviewID"},
+				"series":          {Href: "/series/SeriesID"},
+				"episode_reviews": {Href: "/episode.reviews/EpisodeID"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader([]byte("{}")))
+			assert.Nil(err)
+			req.Header.Set("Accept", "application/hal+json")
+			recorder := httptest.NewRecorder()
+
+			uc := mockCreateReviewUsecase{output: test.Output}
+			action := NewCreateReviewAction(uc, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			output := struct {
+				Links response.Links `json:"_links"`
+			}{}
+			assert.Equal(http.StatusCreated, recorder.Code)
+			assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+			assert.Equal(test.Expected, output.Links)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type CreateSeasonAction struct {
+	uc        usecase.CreateSeasonUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewCreateSeasonAction(
+	uc usecase.CreateSeasonUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) CreateSeasonAction {
+	return CreateSeasonAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a CreateSeasonAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+
+	input := usecase.CreateSeasonInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.SeriesID = seriesID
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrSeasonExists):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(seasonLinks(a.urls, output.SeriesID, output.Number))
+	}
+}
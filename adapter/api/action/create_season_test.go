@@ -0,0 +1,136 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateSeasonUseCase struct {
+	output usecase.CreateSeasonOutput
+	err    error
+}
+
+func (uc mockCreateSeasonUseCase) Execute(
+	context.Context,
+	usecase.CreateSeasonInput,
+) (usecase.CreateSeasonOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateSeasonAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateSeasonUseCase
+		SeriesID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateSeasonUseCase{
+				output: usecase.CreateSeasonOutput{
+					ID:       "ID",
+					SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Number:   1,
+					AirDate:  "2008-01-20",
+				},
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateSeasonOutput{
+				ID:       "ID",
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Number:   1,
+				AirDate:  "2008-01-20",
+			},
+		},
+
+		{
+			Description:  "Invalid series id",
+			UC:           mockCreateSeasonUseCase{},
+			SeriesID:     "1",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing series id"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockCreateSeasonUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Season number taken",
+			UC: mockCreateSeasonUseCase{
+				err: domain.ErrSeasonExists,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeasonExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateSeasonUseCase{
+				err: errors.New("error"),
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateSeasonInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			req = req.WithContext(
+				context.WithValue(req.Context(), CtxKeySeriesID, test.SeriesID),
+			)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateSeasonAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.CreateSeasonOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
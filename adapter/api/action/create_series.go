@@ -1,34 +1,42 @@
 package action
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
+	"series/adapter/api/request"
 	"series/adapter/api/response"
 	"series/adapter/validator"
+	"series/domain"
 	"series/usecase"
 )
 
 type CreateSeriesAction struct {
 	uc        usecase.CreateSeriesUseCase
 	validator validator.Validator
+	urls      URLBuilder
 }
 
 func NewCreateSeriesAction(
 	uc usecase.CreateSeriesUseCase,
 	validator validator.Validator,
+	urls URLBuilder,
 ) CreateSeriesAction {
 	return CreateSeriesAction{
 		uc:        uc,
 		validator: validator,
+		urls:      urls,
 	}
 }
 
 func (a CreateSeriesAction) Execute(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
-	defer func() { res.Send(w) }()
+	defer func() { res.Send(w, r) }()
 
 	input := usecase.CreateSeriesInput{}
-	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
 		res = response.NewError(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -41,9 +49,16 @@ func (a CreateSeriesAction) Execute(w http.ResponseWriter, r *http.Request) {
 
 	output, err := a.uc.Execute(r.Context(), input)
 	switch {
+	case errors.Is(err, domain.ErrNetworkNotFound),
+		errors.Is(err, domain.ErrPersonNotFound),
+		errors.Is(err, domain.ErrStatusEndYear):
+		res = response.NewError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrSlugTaken):
+		res = response.NewError(http.StatusConflict, err.Error())
 	case err != nil:
 		res = response.NewError(http.StatusInternalServerError)
 	default:
-		res = response.NewSuccess(http.StatusCreated, output)
+		res = response.NewSuccess(http.StatusCreated, output).
+			WithLinks(seriesLinks(a.urls, output.ID))
 	}
 }
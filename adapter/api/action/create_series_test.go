@@ -7,6 +7,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"series/adapter/api/request"
+	"series/domain"
 	"series/usecase"
 	"testing"
 
@@ -31,6 +33,7 @@ func TestCreateSeriesAction(t *testing.T) {
 	type Test struct {
 		Description  string
 		UC           usecase.CreateSeriesUseCase
+		ContentType  string
 		ExpectedCode int
 		ExpectedBody any
 	}
@@ -61,6 +64,49 @@ func TestCreateSeriesAction(t *testing.T) {
 			},
 		},
 
+		{
+			Description:  "Unsupported content type",
+			UC:           mockCreateSeriesUseCase{},
+			ContentType:  "text/plain",
+			ExpectedCode: http.StatusUnsupportedMediaType,
+			ExpectedBody: errorResponse{
+				Errors: []string{request.ErrUnsupportedMediaType.Error()},
+			},
+		},
+
+		{
+			Description: "Network not found",
+			UC: mockCreateSeriesUseCase{
+				err: domain.ErrNetworkNotFound,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrNetworkNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Creator not found",
+			UC: mockCreateSeriesUseCase{
+				err: domain.ErrPersonNotFound,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrPersonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Slug taken by another series meanwhile",
+			UC: mockCreateSeriesUseCase{
+				err: domain.ErrSlugTaken,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSlugTaken.Error()},
+			},
+		},
+
 		{
 			Description: "Generic error",
 			UC: mockCreateSeriesUseCase{
@@ -82,9 +128,12 @@ func TestCreateSeriesAction(t *testing.T) {
 			assert.Nil(err)
 			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
 			assert.Nil(err)
+			if test.ContentType != "" {
+				req.Header.Set("Content-Type", test.ContentType)
+			}
 			recorder := httptest.NewRecorder()
 
-			action := NewCreateSeriesAction(test.UC, mockValidator{})
+			action := NewCreateSeriesAction(test.UC, mockValidator{}, mockURLBuilder{})
 			action.Execute(recorder, req)
 
 			assert.Equal(test.ExpectedCode, recorder.Code)
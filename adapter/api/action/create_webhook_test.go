@@ -0,0 +1,90 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateWebhookUseCase struct {
+	output usecase.CreateWebhookOutput
+	err    error
+}
+
+func (uc mockCreateWebhookUseCase) Execute(
+	context.Context,
+	usecase.CreateWebhookInput,
+) (usecase.CreateWebhookOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestCreateWebhookAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.CreateWebhookUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			UC: mockCreateWebhookUseCase{
+				output: usecase.CreateWebhookOutput{
+					ID:     "ID",
+					URL:    "http://localhost/hook",
+					Events: []string{"series.created"},
+					Secret: "secret",
+				},
+			},
+			ExpectedCode: http.StatusCreated,
+			ExpectedBody: usecase.CreateWebhookOutput{
+				ID:     "ID",
+				URL:    "http://localhost/hook",
+				Events: []string{"series.created"},
+				Secret: "secret",
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockCreateWebhookUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.CreateWebhookInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(input))
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewCreateWebhookAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else {
+				output := usecase.CreateWebhookOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type DeleteSeriesTranslationAction struct {
+	uc usecase.DeleteSeriesTranslationUseCase
+}
+
+func NewDeleteSeriesTranslationAction(
+	uc usecase.DeleteSeriesTranslationUseCase,
+) DeleteSeriesTranslationAction {
+	return DeleteSeriesTranslationAction{
+		uc: uc,
+	}
+}
+
+func (a DeleteSeriesTranslationAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+
+	language, ok := r.Context().Value(CtxKeyLanguage).(string)
+	if !ok || language == "" {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing language")
+		return
+	}
+
+	err := a.uc.Execute(r.Context(), domain.SeriesID(seriesID), language)
+	switch {
+	case errors.Is(err, domain.ErrTranslationNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusNoContent, nil)
+	}
+}
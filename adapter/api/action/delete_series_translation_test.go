@@ -0,0 +1,122 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeleteSeriesTranslationUseCase struct {
+	err error
+}
+
+func (uc mockDeleteSeriesTranslationUseCase) Execute(
+	context.Context,
+	domain.SeriesID,
+	string,
+) error {
+	return uc.err
+}
+
+func TestDeleteSeriesTranslationAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.DeleteSeriesTranslationUseCase
+		SeriesID     string
+		Language     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description:  "Successful deletion",
+			UC:           mockDeleteSeriesTranslationUseCase{},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Language:     "pt",
+			ExpectedCode: http.StatusNoContent,
+			ExpectedBody: nil,
+		},
+
+		{
+			Description:  "Invalid series id",
+			UC:           mockDeleteSeriesTranslationUseCase{},
+			SeriesID:     "invalid",
+			Language:     "pt",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing series id"},
+			},
+		},
+
+		{
+			Description:  "Missing language",
+			UC:           mockDeleteSeriesTranslationUseCase{},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Language:     "",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing language"},
+			},
+		},
+
+		{
+			Description: "Deleting translation that does not exist",
+			UC: mockDeleteSeriesTranslationUseCase{
+				err: domain.ErrTranslationNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Language:     "pt",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrTranslationNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockDeleteSeriesTranslationUseCase{
+				err: errors.New("error"),
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Language:     "pt",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodDelete, "", nil)
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeySeriesID, test.SeriesID)
+			ctx = context.WithValue(ctx, CtxKeyLanguage, test.Language)
+			req = req.WithContext(ctx)
+			recorder := httptest.NewRecorder()
+
+			action := NewDeleteSeriesTranslationAction(test.UC)
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				assert.Empty(recorder.Body.Bytes())
+			}
+		})
+	}
+}
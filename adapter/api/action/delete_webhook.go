@@ -0,0 +1,40 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type DeleteWebhookAction struct {
+	uc usecase.DeleteWebhookUseCase
+}
+
+func NewDeleteWebhookAction(uc usecase.DeleteWebhookUseCase) DeleteWebhookAction {
+	return DeleteWebhookAction{
+		uc: uc,
+	}
+}
+
+func (a DeleteWebhookAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	webhookID, ok := r.Context().Value(CtxKeyWebhookID).(string)
+	if !ok || !domain.IsValidUUID(webhookID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing webhook id")
+		return
+	}
+
+	err := a.uc.Execute(r.Context(), domain.WebhookID(webhookID))
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusNoContent, nil)
+	}
+}
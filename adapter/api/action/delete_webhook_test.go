@@ -0,0 +1,94 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeleteWebhookUseCase struct {
+	err error
+}
+
+func (uc mockDeleteWebhookUseCase) Execute(context.Context, domain.WebhookID) error {
+	return uc.err
+}
+
+func TestDeleteWebhookAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.DeleteWebhookUseCase
+		WebhookID    string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description:  "Successful deletion",
+			UC:           mockDeleteWebhookUseCase{},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNoContent,
+			ExpectedBody: nil,
+		},
+
+		{
+			Description: "Deleting webhook that does not exist",
+			UC: mockDeleteWebhookUseCase{
+				err: domain.ErrWebhookNotFound,
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrWebhookNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockDeleteWebhookUseCase{
+				err: errors.New("error"),
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodDelete, "", nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeyWebhookID,
+				test.WebhookID,
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewDeleteWebhookAction(test.UC)
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				assert.Empty(recorder.Body.Bytes())
+			}
+		})
+	}
+}
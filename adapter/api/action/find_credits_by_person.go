@@ -0,0 +1,48 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindCreditsByPersonAction struct {
+	uc   usecase.FindCreditsByPersonUseCase
+	urls URLBuilder
+}
+
+func NewFindCreditsByPersonAction(
+	uc usecase.FindCreditsByPersonUseCase,
+	urls URLBuilder,
+) FindCreditsByPersonAction {
+	return FindCreditsByPersonAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindCreditsByPersonAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	personID, ok := r.Context().Value(CtxKeyPersonID).(string)
+	if !ok || !domain.IsValidUUID(personID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing person id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.PersonID(personID))
+	switch {
+	case errors.Is(err, domain.ErrPersonNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self": {RoutePersonCredits, []string{"id", personID}},
+			}))
+	}
+}
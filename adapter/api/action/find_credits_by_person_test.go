@@ -0,0 +1,122 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindCreditsByPersonUseCase struct {
+	output usecase.FindCreditsByPersonOutput
+	err    error
+}
+
+func (uc mockFindCreditsByPersonUseCase) Execute(
+	context.Context,
+	domain.PersonID,
+) (usecase.FindCreditsByPersonOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindCreditsByPersonAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindCreditsByPersonUseCase
+		PersonID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of the filmography",
+			UC: mockFindCreditsByPersonUseCase{
+				output: usecase.FindCreditsByPersonOutput{
+					ID:   "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+					Name: "Bob Odenkirk",
+					Credits: []usecase.FindCreditsByPersonCredit{
+						{ID: "CreditID", Title: "Better Call Saul", Role: "actor"},
+					},
+				},
+			},
+			PersonID:     "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindCreditsByPersonOutput{
+				ID:   "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+				Name: "Bob Odenkirk",
+				Credits: []usecase.FindCreditsByPersonCredit{
+					{ID: "CreditID", Title: "Better Call Saul", Role: "actor"},
+				},
+			},
+		},
+
+		{
+			Description:  "Invalid person id",
+			UC:           mockFindCreditsByPersonUseCase{},
+			PersonID:     "PersonID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing person id"},
+			},
+		},
+
+		{
+			Description: "Person not found",
+			UC: mockFindCreditsByPersonUseCase{
+				err: domain.ErrPersonNotFound,
+			},
+			PersonID:     "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrPersonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindCreditsByPersonUseCase{
+				err: errors.New("error"),
+			},
+			PersonID:     "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeyPersonID, test.PersonID)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewFindCreditsByPersonAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindCreditsByPersonOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
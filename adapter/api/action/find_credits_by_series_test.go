@@ -0,0 +1,118 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindCreditsBySeriesUseCase struct {
+	output usecase.FindCreditsBySeriesOutput
+	err    error
+}
+
+func (uc mockFindCreditsBySeriesUseCase) Execute(
+	context.Context,
+	domain.SeriesID,
+) (usecase.FindCreditsBySeriesOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindCreditsBySeriesAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindCreditsBySeriesUseCase
+		SeriesID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of credits",
+			UC: mockFindCreditsBySeriesUseCase{
+				output: usecase.FindCreditsBySeriesOutput{
+					Credits: []usecase.FindCreditsBySeriesCredit{
+						{ID: "CreditID", Name: "Vince Gilligan", Role: "creator"},
+					},
+				},
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindCreditsBySeriesOutput{
+				Credits: []usecase.FindCreditsBySeriesCredit{
+					{ID: "CreditID", Name: "Vince Gilligan", Role: "creator"},
+				},
+			},
+		},
+
+		{
+			Description:  "Invalid series id",
+			UC:           mockFindCreditsBySeriesUseCase{},
+			SeriesID:     "SeriesID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing series id"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockFindCreditsBySeriesUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindCreditsBySeriesUseCase{
+				err: errors.New("error"),
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeySeriesID, test.SeriesID)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewFindCreditsBySeriesAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindCreditsBySeriesOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindDeliveriesByWebhookAction struct {
+	uc   usecase.FindDeliveriesByWebhookUseCase
+	urls URLBuilder
+}
+
+func NewFindDeliveriesByWebhookAction(
+	uc usecase.FindDeliveriesByWebhookUseCase,
+	urls URLBuilder,
+) FindDeliveriesByWebhookAction {
+	return FindDeliveriesByWebhookAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindDeliveriesByWebhookAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	webhookID, ok := r.Context().Value(CtxKeyWebhookID).(string)
+	if !ok || !domain.IsValidUUID(webhookID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing webhook id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.WebhookID(webhookID))
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self":    {RouteWebhookDeliveries, []string{"id", webhookID}},
+				"webhook": {RouteWebhook, []string{"id", webhookID}},
+			}))
+	}
+}
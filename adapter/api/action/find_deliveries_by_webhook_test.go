@@ -0,0 +1,106 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindDeliveriesByWebhookUseCase struct {
+	output usecase.FindDeliveriesByWebhookOutput
+	err    error
+}
+
+func (uc mockFindDeliveriesByWebhookUseCase) Execute(
+	context.Context,
+	domain.WebhookID,
+) (usecase.FindDeliveriesByWebhookOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindDeliveriesByWebhookAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindDeliveriesByWebhookUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of deliveries",
+			UC: mockFindDeliveriesByWebhookUseCase{
+				output: usecase.FindDeliveriesByWebhookOutput{
+					Deliveries: []usecase.FindDeliveriesByWebhookDelivery{
+						{ID: "ID", Status: "dead", Attempts: 8},
+					},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindDeliveriesByWebhookOutput{
+				Deliveries: []usecase.FindDeliveriesByWebhookDelivery{
+					{ID: "ID", Status: "dead", Attempts: 8},
+				},
+			},
+		},
+
+		{
+			Description: "Finding deliveries of webhook that does not exist",
+			UC: mockFindDeliveriesByWebhookUseCase{
+				err: domain.ErrWebhookNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrWebhookNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindDeliveriesByWebhookUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeyWebhookID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewFindDeliveriesByWebhookAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindDeliveriesByWebhookOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+	"strconv"
+)
+
+type FindEpisodesBySeasonAction struct {
+	uc   usecase.FindEpisodesBySeasonUseCase
+	urls URLBuilder
+}
+
+func NewFindEpisodesBySeasonAction(
+	uc usecase.FindEpisodesBySeasonUseCase,
+	urls URLBuilder,
+) FindEpisodesBySeasonAction {
+	return FindEpisodesBySeasonAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindEpisodesBySeasonAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+	season, ok := seasonNumber(r)
+	if !ok {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing season number")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), usecase.FindEpisodesBySeasonInput{
+		SeriesID: domain.SeriesID(seriesID),
+		Season:   season,
+	})
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrSeasonNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		number := strconv.Itoa(season)
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self":    {RouteSeasonEpisodes, []string{"id", seriesID, "number", number}},
+				"series":  {RouteSeries, []string{"id", seriesID}},
+				"seasons": {RouteSeriesSeasons, []string{"id", seriesID}},
+			}))
+	}
+}
@@ -0,0 +1,125 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindEpisodesBySeasonUseCase struct {
+	output usecase.FindEpisodesBySeasonOutput
+	err    error
+}
+
+func (uc mockFindEpisodesBySeasonUseCase) Execute(
+	context.Context,
+	usecase.FindEpisodesBySeasonInput,
+) (usecase.FindEpisodesBySeasonOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindEpisodesBySeasonAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindEpisodesBySeasonUseCase
+		Season       string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			UC: mockFindEpisodesBySeasonUseCase{
+				output: usecase.FindEpisodesBySeasonOutput{
+					Season: 2,
+					Episodes: []usecase.FindEpisodesBySeasonEpisode{
+						{ID: "ID", Number: 1, Title: "Seven Thirty-Seven"},
+					},
+				},
+			},
+			Season:       "2",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindEpisodesBySeasonOutput{
+				Season: 2,
+				Episodes: []usecase.FindEpisodesBySeasonEpisode{
+					{ID: "ID", Number: 1, Title: "Seven Thirty-Seven"},
+				},
+			},
+		},
+
+		{
+			Description:  "Invalid season number",
+			UC:           mockFindEpisodesBySeasonUseCase{},
+			Season:       "two",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing season number"},
+			},
+		},
+
+		{
+			Description: "Season not found",
+			UC: mockFindEpisodesBySeasonUseCase{
+				err: domain.ErrSeasonNotFound,
+			},
+			Season:       "9",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeasonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindEpisodesBySeasonUseCase{
+				err: errors.New("error"),
+			},
+			Season:       "2",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			ctx = context.WithValue(ctx, CtxKeySeasonNumber, test.Season)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewFindEpisodesBySeasonAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindEpisodesBySeasonOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
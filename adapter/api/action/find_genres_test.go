@@ -0,0 +1,80 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindGenresUseCase struct {
+	output usecase.FindGenresOutput
+	err    error
+}
+
+func (uc mockFindGenresUseCase) Execute(
+	context.Context,
+) (usecase.FindGenresOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindGenresAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindGenresUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			UC: mockFindGenresUseCase{
+				output: usecase.FindGenresOutput{
+					Genres: []usecase.FindGenresGenre{{ID: "GenreID", Name: "Drama"}},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindGenresOutput{
+				Genres: []usecase.FindGenresGenre{{ID: "GenreID", Name: "Drama"}},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindGenresUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewFindGenresAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else {
+				output := usecase.FindGenresOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
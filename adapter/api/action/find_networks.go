@@ -0,0 +1,38 @@
+package action
+
+import (
+	"net/http"
+	"series/adapter/api/response"
+	"series/usecase"
+)
+
+type FindNetworksAction struct {
+	uc   usecase.FindNetworksUseCase
+	urls URLBuilder
+}
+
+func NewFindNetworksAction(
+	uc usecase.FindNetworksUseCase,
+	urls URLBuilder,
+) FindNetworksAction {
+	return FindNetworksAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindNetworksAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	output, err := a.uc.Execute(r.Context())
+	switch {
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self": {RouteNetworks, nil},
+			}))
+	}
+}
@@ -0,0 +1,80 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindNetworksUseCase struct {
+	output usecase.FindNetworksOutput
+	err    error
+}
+
+func (uc mockFindNetworksUseCase) Execute(
+	context.Context,
+) (usecase.FindNetworksOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindNetworksAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindNetworksUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			UC: mockFindNetworksUseCase{
+				output: usecase.FindNetworksOutput{
+					Networks: []usecase.FindNetworksNetwork{{ID: "NetworkID", Name: "HBO"}},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindNetworksOutput{
+				Networks: []usecase.FindNetworksNetwork{{ID: "NetworkID", Name: "HBO"}},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindNetworksUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewFindNetworksAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else {
+				output := usecase.FindNetworksOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindReviewByIDAction struct {
+	uc   usecase.FindReviewByIDUseCase
+	urls URLBuilder
+}
+
+func NewFindReviewByIDAction(
+	uc usecase.FindReviewByIDUseCase,
+	urls URLBuilder,
+) FindReviewByIDAction {
+	return FindReviewByIDAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindReviewByIDAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	reviewID, ok := r.Context().Value(CtxKeyReviewID).(string)
+	if !ok || !domain.IsValidUUID(reviewID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing review id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.ReviewID(reviewID))
+	switch {
+	case errors.Is(err, domain.ErrReviewNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("ETag", etag(output.Version)).
+			WithLinks(reviewLinks(a.urls, output.ID, output.SeriesID, output.EpisodeID))
+	}
+}
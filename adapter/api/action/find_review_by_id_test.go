@@ -0,0 +1,122 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindReviewByIDUseCase struct {
+	output usecase.FindReviewByIDOutput
+	err    error
+}
+
+func (uc mockFindReviewByIDUseCase) Execute(
+	context.Context,
+	domain.ReviewID,
+) (usecase.FindReviewByIDOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindReviewByIDAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindReviewByIDUseCase
+		ReviewID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			UC: mockFindReviewByIDUseCase{
+				output: usecase.FindReviewByIDOutput{
+					ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					SeriesID: "635c4bb1-41b1-46e9-a87e-292a9d63b9e3",
+					Text:     "Text",
+					Version:  2,
+				},
+			},
+			ReviewID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindReviewByIDOutput{
+				ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				SeriesID: "635c4bb1-41b1-46e9-a87e-292a9d63b9e3",
+				Text:     "Text",
+				Version:  2,
+			},
+		},
+
+		{
+			Description:  "Invalid review id",
+			UC:           mockFindReviewByIDUseCase{},
+			ReviewID:     "ID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing review id"},
+			},
+		},
+
+		{
+			Description: "Searching review that does not exist",
+			UC: mockFindReviewByIDUseCase{
+				err: domain.ErrReviewNotFound,
+			},
+			ReviewID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrReviewNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindReviewByIDUseCase{
+				err: errors.New("error"),
+			},
+			ReviewID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeyReviewID,
+				test.ReviewID,
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewFindReviewByIDAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindReviewByIDOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
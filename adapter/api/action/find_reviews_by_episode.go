@@ -0,0 +1,49 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindReviewsByEpisodeAction struct {
+	uc   usecase.FindReviewsByEpisodeUseCase
+	urls URLBuilder
+}
+
+func NewFindReviewsByEpisodeAction(
+	uc usecase.FindReviewsByEpisodeUseCase,
+	urls URLBuilder,
+) FindReviewsByEpisodeAction {
+	return FindReviewsByEpisodeAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindReviewsByEpisodeAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	episodeID, ok := r.Context().Value(CtxKeyEpisodeID).(string)
+	if !ok || !domain.IsValidUUID(episodeID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing episode id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.EpisodeID(episodeID))
+	switch {
+	case errors.Is(err, domain.ErrEpisodeNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self":   {RouteEpisodeReviews, []string{"id", episodeID}},
+				"series": {RouteSeries, []string{"id", output.SeriesID}},
+			}))
+	}
+}
@@ -0,0 +1,132 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindReviewsByEpisodeUseCase struct {
+	output usecase.FindReviewsByEpisodeOutput
+	err    error
+}
+
+func (uc mockFindReviewsByEpisodeUseCase) Execute(
+	context.Context,
+	domain.EpisodeID,
+) (usecase.FindReviewsByEpisodeOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindReviewsByEpisodeAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		EpisodeID    string
+		UC           usecase.FindReviewsByEpisodeUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			EpisodeID:   "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			UC: mockFindReviewsByEpisodeUseCase{
+				output: usecase.FindReviewsByEpisodeOutput{
+					SeriesID: "SeriesID",
+					Rating:   usecase.RatingOutput{Average: 9, Count: 1},
+					Reviews: []usecase.FindReviewsByEpisodeReview{
+						{
+							ID:       "ID",
+							AuthorID: "AuthorID",
+							Text:     "Text",
+							Rating:   9,
+						},
+					},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindReviewsByEpisodeOutput{
+				SeriesID: "SeriesID",
+				Rating:   usecase.RatingOutput{Average: 9, Count: 1},
+				Reviews: []usecase.FindReviewsByEpisodeReview{
+					{
+						ID:       "ID",
+						AuthorID: "AuthorID",
+						Text:     "Text",
+						Rating:   9,
+					},
+				},
+			},
+		},
+
+		{
+			Description:  "Invalid episode id",
+			EpisodeID:    "episode",
+			UC:           mockFindReviewsByEpisodeUseCase{},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing episode id"},
+			},
+		},
+
+		{
+			Description: "Episode not found",
+			EpisodeID:   "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			UC: mockFindReviewsByEpisodeUseCase{
+				err: domain.ErrEpisodeNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrEpisodeNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			EpisodeID:   "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			UC: mockFindReviewsByEpisodeUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeyEpisodeID, test.EpisodeID)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewFindReviewsByEpisodeAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindReviewsByEpisodeOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
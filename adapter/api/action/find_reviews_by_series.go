@@ -4,23 +4,32 @@ import (
 	"errors"
 	"net/http"
 	"series/adapter/api/response"
+	"series/adapter/validator"
 	"series/domain"
 	"series/usecase"
 )
 
 type FindReviewsBySeriesAction struct {
-	uc usecase.FindReviewsBySeriesUseCase
+	uc        usecase.FindReviewsBySeriesUseCase
+	validator validator.Validator
+	urls      URLBuilder
 }
 
-func NewFindReviewsBySeriesAction(uc usecase.FindReviewsBySeriesUseCase) FindReviewsBySeriesAction {
+func NewFindReviewsBySeriesAction(
+	uc usecase.FindReviewsBySeriesUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) FindReviewsBySeriesAction {
 	return FindReviewsBySeriesAction{
-		uc: uc,
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
 	}
 }
 
 func (a FindReviewsBySeriesAction) Execute(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
-	defer func() { res.Send(w) }()
+	defer func() { res.Send(w, r) }()
 
 	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
 	if !ok || !domain.IsValidUUID(seriesID) {
@@ -28,13 +37,31 @@ func (a FindReviewsBySeriesAction) Execute(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	output, err := a.uc.Execute(r.Context(), domain.SeriesID(seriesID))
+	page, err := queryPage(r)
+	if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	input := usecase.FindReviewsBySeriesInput{
+		SeriesID:  domain.SeriesID(seriesID),
+		PageInput: page,
+	}
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
 	switch {
 	case errors.Is(err, domain.ErrSeriesNotFound):
 		res = response.NewError(http.StatusNotFound, err.Error())
 	case err != nil:
 		res = response.NewError(http.StatusInternalServerError)
 	default:
-		res = response.NewSuccess(http.StatusOK, output)
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(pageLinks(newLinks(a.urls, map[string]route{
+				"self":   {RouteSeriesReviews, []string{"id", seriesID}},
+				"series": {RouteSeries, []string{"id", seriesID}},
+			}), page, output.HasNext))
 	}
 }
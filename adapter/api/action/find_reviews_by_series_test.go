@@ -21,7 +21,7 @@ type mockFindReviewsBySeriesUseCase struct {
 
 func (uc mockFindReviewsBySeriesUseCase) Execute(
 	context.Context,
-	domain.SeriesID,
+	usecase.FindReviewsBySeriesInput,
 ) (usecase.FindReviewsBySeriesOutput, error) {
 	return uc.output, uc.err
 }
@@ -117,7 +117,7 @@ func TestFindReviewsBySeriesAction(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			action := NewFindReviewsBySeriesAction(test.UC)
+			action := NewFindReviewsBySeriesAction(test.UC, mockValidator{}, mockURLBuilder{})
 			action.Execute(recorder, req)
 
 			assert.Equal(test.ExpectedCode, recorder.Code)
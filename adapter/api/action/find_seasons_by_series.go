@@ -0,0 +1,49 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindSeasonsBySeriesAction struct {
+	uc   usecase.FindSeasonsBySeriesUseCase
+	urls URLBuilder
+}
+
+func NewFindSeasonsBySeriesAction(
+	uc usecase.FindSeasonsBySeriesUseCase,
+	urls URLBuilder,
+) FindSeasonsBySeriesAction {
+	return FindSeasonsBySeriesAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindSeasonsBySeriesAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.SeriesID(seriesID))
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self":   {RouteSeriesSeasons, []string{"id", seriesID}},
+				"series": {RouteSeries, []string{"id", seriesID}},
+			}))
+	}
+}
@@ -0,0 +1,107 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindSeasonsBySeriesUseCase struct {
+	output usecase.FindSeasonsBySeriesOutput
+	err    error
+}
+
+func (uc mockFindSeasonsBySeriesUseCase) Execute(
+	context.Context,
+	domain.SeriesID,
+) (usecase.FindSeasonsBySeriesOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindSeasonsBySeriesAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindSeasonsBySeriesUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			UC: mockFindSeasonsBySeriesUseCase{
+				output: usecase.FindSeasonsBySeriesOutput{
+					Seasons: []usecase.FindSeasonsBySeriesSeason{
+						{ID: "ID", Number: 1, Episodes: 7},
+					},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindSeasonsBySeriesOutput{
+				Seasons: []usecase.FindSeasonsBySeriesSeason{
+					{ID: "ID", Number: 1, Episodes: 7},
+				},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockFindSeasonsBySeriesUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindSeasonsBySeriesUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			))
+
+			recorder := httptest.NewRecorder()
+
+			action := NewFindSeasonsBySeriesAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindSeasonsBySeriesOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
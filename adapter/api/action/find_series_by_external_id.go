@@ -0,0 +1,56 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindSeriesByExternalIDAction struct {
+	uc        usecase.FindSeriesByExternalIDUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewFindSeriesByExternalIDAction(
+	uc usecase.FindSeriesByExternalIDUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) FindSeriesByExternalIDAction {
+	return FindSeriesByExternalIDAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a FindSeriesByExternalIDAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	query := r.URL.Query()
+	input := usecase.FindSeriesByExternalIDInput{
+		IMDb: query.Get("imdb"),
+		TVDB: query.Get("tvdb"),
+		TMDB: query.Get("tmdb"),
+	}
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("Vary", "Accept-Language").
+			WithLinks(seriesLinks(a.urls, output.ID))
+	}
+}
@@ -0,0 +1,102 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindSeriesByExternalIDUseCase struct {
+	output usecase.FindSeriesByIDOutput
+	err    error
+}
+
+func (uc mockFindSeriesByExternalIDUseCase) Execute(
+	context.Context,
+	usecase.FindSeriesByExternalIDInput,
+) (usecase.FindSeriesByIDOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindSeriesByExternalIDAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindSeriesByExternalIDUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful lookup",
+			UC: mockFindSeriesByExternalIDUseCase{
+				output: usecase.FindSeriesByIDOutput{
+					ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Title:       "Breaking Bad",
+					ExternalIDs: &usecase.ExternalIDsOutput{IMDb: "tt0903747"},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindSeriesByIDOutput{
+				ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Title:       "Breaking Bad",
+				ExternalIDs: &usecase.ExternalIDsOutput{IMDb: "tt0903747"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockFindSeriesByExternalIDUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindSeriesByExternalIDUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "/series/lookup?imdb=tt0903747", nil)
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewFindSeriesByExternalIDAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				assert.Equal("Accept-Language", recorder.Header().Get("Vary"))
+				output := usecase.FindSeriesByIDOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -4,37 +4,81 @@ import (
 	"errors"
 	"net/http"
 	"series/adapter/api/response"
+	"series/adapter/validator"
 	"series/domain"
 	"series/usecase"
 )
 
 type FindSeriesByIDAction struct {
-	uc usecase.FindSeriesByIDUseCase
+	uc        usecase.FindSeriesByIDUseCase
+	validator validator.Validator
+	urls      URLBuilder
 }
 
-func NewFindSeriesByIDAction(uc usecase.FindSeriesByIDUseCase) FindSeriesByIDAction {
+func NewFindSeriesByIDAction(
+	uc usecase.FindSeriesByIDUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) FindSeriesByIDAction {
 	return FindSeriesByIDAction{
-		uc: uc,
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
 	}
 }
 
 func (a FindSeriesByIDAction) Execute(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
-	defer func() { res.Send(w) }()
+	defer func() { res.Send(w, r) }()
 
 	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
-	if !ok || !domain.IsValidUUID(seriesID) {
+	if !ok || seriesID == "" {
 		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
 		return
 	}
 
-	output, err := a.uc.Execute(r.Context(), domain.SeriesID(seriesID))
+	// A series can be addressed by its ID or by one of its slugs.
+	input := usecase.FindSeriesByIDInput{}
+	if domain.IsValidUUID(seriesID) {
+		input.ID = domain.SeriesID(seriesID)
+	} else {
+		input.Slug = seriesID
+	}
+	input.Fields, _ = queryList(r, "fields")
+	// Reviews used to be embedded unconditionally, keep that
+	// as the default for clients that don't ask for anything.
+	if include, ok := queryList(r, "include"); ok {
+		input.Include = include
+	} else {
+		input.Include = []string{usecase.FindSeriesByIDIncludeReviews}
+	}
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
 	switch {
 	case errors.Is(err, domain.ErrSeriesNotFound):
 		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrSeriesMoved):
+		// Old slugs keep working, point the client at the current one.
+		location, err := a.urls.URL(RouteSeries, "id", output.Slug)
+		if err != nil {
+			res = response.NewError(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.RawQuery != "" {
+			location += "?" + r.URL.RawQuery
+		}
+		res = response.NewSuccess(http.StatusMovedPermanently, nil).
+			WithHeader("Location", location)
 	case err != nil:
 		res = response.NewError(http.StatusInternalServerError)
 	default:
-		res = response.NewSuccess(http.StatusOK, output)
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("Vary", "Accept-Language").
+			WithLinks(seriesLinks(a.urls, seriesID))
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"series/adapter/api/response"
 	"series/domain"
 	"series/usecase"
 	"testing"
@@ -21,7 +22,7 @@ type mockFindSeriesByIDUseCase struct {
 
 func (uc mockFindSeriesByIDUseCase) Execute(
 	context.Context,
-	domain.SeriesID,
+	usecase.FindSeriesByIDInput,
 ) (usecase.FindSeriesByIDOutput, error) {
 	return uc.output, uc.err
 }
@@ -29,6 +30,8 @@ func (uc mockFindSeriesByIDUseCase) Execute(
 func TestFindSeriesByID(t *testing.T) {
 	t.Parallel()
 
+	endYear, episodes := 1990, 20
+
 	type Test struct {
 		Description  string
 		UC           usecase.FindSeriesByIDUseCase
@@ -43,11 +46,11 @@ func TestFindSeriesByID(t *testing.T) {
 					ID:          "SeriesID",
 					Title:       "Title",
 					Description: "Description",
-					Episodes:    20,
+					Episodes:    &episodes,
 					BeginYear:   1980,
-					EndYear:     1990,
+					EndYear:     &endYear,
 					Creator:     "Creator",
-					Reviews: []usecase.FindSeriesByIDReview{
+					Reviews: &[]usecase.FindSeriesByIDReview{
 						{
 							ID:       "ReviewID",
 							AuthorID: "AuthorID",
@@ -62,11 +65,11 @@ func TestFindSeriesByID(t *testing.T) {
 				ID:          "SeriesID",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
+				EndYear:     &endYear,
 				Creator:     "Creator",
-				Reviews: []usecase.FindSeriesByIDReview{
+				Reviews: &[]usecase.FindSeriesByIDReview{
 					{
 						ID:       "ReviewID",
 						AuthorID: "AuthorID",
@@ -83,11 +86,11 @@ func TestFindSeriesByID(t *testing.T) {
 					ID:          "SeriesID",
 					Title:       "Title",
 					Description: "Description",
-					Episodes:    20,
+					Episodes:    &episodes,
 					BeginYear:   1980,
-					EndYear:     1990,
+					EndYear:     &endYear,
 					Creator:     "Creator",
-					Reviews:     []usecase.FindSeriesByIDReview{},
+					Reviews:     &[]usecase.FindSeriesByIDReview{},
 				},
 				err: nil,
 			},
@@ -96,11 +99,11 @@ func TestFindSeriesByID(t *testing.T) {
 				ID:          "SeriesID",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
+				EndYear:     &endYear,
 				Creator:     "Creator",
-				Reviews:     []usecase.FindSeriesByIDReview{},
+				Reviews:     &[]usecase.FindSeriesByIDReview{},
 			},
 		},
 
@@ -144,7 +147,7 @@ func TestFindSeriesByID(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			action := NewFindSeriesByIDAction(test.UC)
+			action := NewFindSeriesByIDAction(test.UC, mockValidator{}, mockURLBuilder{})
 			action.Execute(recorder, req)
 
 			assert.Equal(test.ExpectedCode, recorder.Code)
@@ -162,3 +165,101 @@ func TestFindSeriesByID(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSeriesByIDLinks(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+
+	req, err := http.NewRequest(http.MethodGet, "", bytes.NewReader(nil))
+	assert.Nil(err)
+	req.Header.Set("Accept", "application/hal+json")
+	req = req.WithContext(context.WithValue(
+		req.Context(),
+		CtxKeySeriesID,
+		"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+	))
+	recorder := httptest.NewRecorder()
+
+	uc := mockFindSeriesByIDUseCase{
+		output: usecase.FindSeriesByIDOutput{Title: "Title"},
+	}
+	action := NewFindSeriesByIDAction(uc, mockValidator{}, mockURLBuilder{})
+	action.Execute(recorder, req)
+
+	output := struct {
+		Links response.Links `json:"_links"`
+	}{}
+	assert.Equal(http.StatusOK, recorder.Code)
+	assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+	assert.Equal(response.Links{
+		"self":    {Href: "/series/1be9775b-8d32-4710-9ce6-7ece88e30f01"},
+		"reviews": {Href: "/series.reviews/1be9775b-8d32-4710-9ce6-7ece88e30f01"},
+		"seasons": {Href: "/series.seasons/1be9775b-8d32-4710-9ce6-7ece88e30f01"},
+	}, output.Links)
+}
+
+func TestFindSeriesBySlug(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description      string
+		UC               usecase.FindSeriesByIDUseCase
+		Query            string
+		ExpectedCode     int
+		ExpectedLocation string
+	}
+	tests := []Test{
+		{
+			Description: "Current slug",
+			UC: mockFindSeriesByIDUseCase{
+				output: usecase.FindSeriesByIDOutput{
+					Title: "Breaking Bad",
+					Slug:  "breaking-bad-2008",
+				},
+			},
+			ExpectedCode:     http.StatusOK,
+			ExpectedLocation: "",
+		},
+		{
+			Description: "Old slug redirects to the current one",
+			UC: mockFindSeriesByIDUseCase{
+				output: usecase.FindSeriesByIDOutput{Slug: "breaking-bad-2008"},
+				err:    domain.ErrSeriesMoved,
+			},
+			ExpectedCode:     http.StatusMovedPermanently,
+			ExpectedLocation: "/series/breaking-bad-2008",
+		},
+		{
+			Description: "Redirect keeps the query",
+			UC: mockFindSeriesByIDUseCase{
+				output: usecase.FindSeriesByIDOutput{Slug: "breaking-bad-2008"},
+				err:    domain.ErrSeriesMoved,
+			},
+			Query:            "fields=title",
+			ExpectedCode:     http.StatusMovedPermanently,
+			ExpectedLocation: "/series/breaking-bad-2008?fields=title",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "/?"+test.Query, nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"breaking-bad",
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewFindSeriesByIDAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			assert.Equal(test.ExpectedLocation, recorder.Header().Get("Location"))
+		})
+	}
+}
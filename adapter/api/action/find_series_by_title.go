@@ -2,35 +2,93 @@ package action
 
 import (
 	"net/http"
+	"net/url"
 	"series/adapter/api/response"
+	"series/adapter/validator"
 	"series/usecase"
 )
 
 type FindSeriesByTitleAction struct {
-	uc usecase.FindSeriesByTitleUseCase
+	uc        usecase.FindSeriesByTitleUseCase
+	validator validator.Validator
+	urls      URLBuilder
 }
 
-func NewFindSeriesByTitleAction(uc usecase.FindSeriesByTitleUseCase) FindSeriesByTitleAction {
+func NewFindSeriesByTitleAction(
+	uc usecase.FindSeriesByTitleUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) FindSeriesByTitleAction {
 	return FindSeriesByTitleAction{
-		uc: uc,
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
 	}
 }
 
 func (a FindSeriesByTitleAction) Execute(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
-	defer func() { res.Send(w) }()
+	defer func() { res.Send(w, r) }()
 
-	query, ok := r.Context().Value(CtxKeyTitleQuery).(string)
-	if !ok {
-		res = response.NewError(http.StatusBadRequest, "invalid or missing series id")
+	page, err := queryPage(r)
+	if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	input := usecase.FindSeriesByTitleInput{
+		Query:     query.Get("q"),
+		Genre:     query.Get("genre"),
+		Tag:       query.Get("tag"),
+		Network:   query.Get("network"),
+		Country:   query.Get("country"),
+		Language:  query.Get("language"),
+		Status:    query.Get("status"),
+		PageInput: page,
+	}
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
 		return
 	}
 
-	output, err := a.uc.Execute(r.Context(), query)
+	output, err := a.uc.Execute(r.Context(), input)
 	switch {
 	case err != nil:
 		res = response.NewError(http.StatusInternalServerError)
 	default:
-		res = response.NewSuccess(http.StatusOK, output)
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("Vary", "Accept-Language").
+			WithLinks(pageLinks(searchLinks(a.urls, input), page, output.HasNext))
+	}
+}
+
+// searchLinks links a search to itself. The search route has no
+// variables, the parameters that were set are added as a query.
+func searchLinks(urls URLBuilder, input usecase.FindSeriesByTitleInput) response.Links {
+	links := newLinks(urls, map[string]route{
+		"self": {RouteSeriesSearch, nil},
+	})
+	self, ok := links["self"]
+	if !ok {
+		return links
+	}
+
+	params := url.Values{}
+	for name, value := range map[string]string{
+		"q":        input.Query,
+		"genre":    input.Genre,
+		"tag":      input.Tag,
+		"network":  input.Network,
+		"country":  input.Country,
+		"language": input.Language,
+		"status":   input.Status,
+	} {
+		if value != "" {
+			params.Set(name, value)
+		}
 	}
+	self.Href += "?" + params.Encode()
+	links["self"] = self
+	return links
 }
@@ -1,12 +1,12 @@
 package action
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"series/adapter/api/response"
 	"series/usecase"
 	"testing"
 
@@ -20,7 +20,7 @@ type mockFindSeriesByTitleUseCase struct {
 
 func (uc mockFindSeriesByTitleUseCase) Execute(
 	context.Context,
-	string,
+	usecase.FindSeriesByTitleInput,
 ) (usecase.FindSeriesByTitleOutput, error) {
 	return uc.output, uc.err
 }
@@ -80,20 +80,12 @@ func TestFindSeriesByTitleAction(t *testing.T) {
 		t.Run(test.Description, func(t *testing.T) {
 			assert := assert.New(t)
 
-			input, err := json.Marshal(usecase.CreateSeriesInput{})
+			req, err := http.NewRequest(http.MethodGet, "/series?q=query", nil)
 			assert.Nil(err)
-			req, err := http.NewRequest(http.MethodGet, "", bytes.NewReader(input))
-			assert.Nil(err)
-			ctx := context.WithValue(
-				req.Context(),
-				CtxKeyTitleQuery,
-				"query",
-			)
-			req = req.WithContext(ctx)
 
 			recorder := httptest.NewRecorder()
 
-			action := NewFindSeriesByTitleAction(test.UC)
+			action := NewFindSeriesByTitleAction(test.UC, mockValidator{}, mockURLBuilder{})
 			action.Execute(recorder, req)
 
 			assert.Equal(test.ExpectedCode, recorder.Code)
@@ -111,3 +103,103 @@ func TestFindSeriesByTitleAction(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSeriesByTitleLinks(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		URL         string
+		HasNext     bool
+		Expected    response.Links
+	}
+	tests := []Test{
+		{
+			Description: "Full-text search",
+			URL:         "/series?q=query",
+			Expected: response.Links{
+				"self": {Href: "/series.search?q=query"},
+			},
+		},
+		{
+			Description: "Full-text search in a genre and tag",
+			URL:         "/series?q=query&genre=Drama&tag=anthology",
+			Expected: response.Links{
+				"self": {Href: "/series.search?genre=Drama&q=query&tag=anthology"},
+			},
+		},
+		{
+			Description: "Genre without a full-text search",
+			URL:         "/series?genre=Drama&page=2",
+			Expected: response.Links{
+				"self": {Href: "/series.search?genre=Drama"},
+			},
+		},
+		{
+			Description: "Status without a full-text search",
+			URL:         "/series?status=on_hiatus",
+			Expected: response.Links{
+				"self": {Href: "/series.search?status=on_hiatus"},
+			},
+		},
+		{
+			Description: "First page followed by another",
+			URL:         "/series?q=query&limit=10",
+			HasNext:     true,
+			Expected: response.Links{
+				"self": {Href: "/series.search?limit=10&q=query"},
+				"next": {Href: "/series.search?limit=10&offset=10&q=query"},
+			},
+		},
+		{
+			Description: "Middle page",
+			URL:         "/series?q=query&limit=10&offset=15",
+			HasNext:     true,
+			Expected: response.Links{
+				"self": {Href: "/series.search?limit=10&offset=15&q=query"},
+				"next": {Href: "/series.search?limit=10&offset=25&q=query"},
+				"prev": {Href: "/series.search?limit=10&offset=5&q=query"},
+			},
+		},
+		{
+			Description: "Last page with the default limit",
+			URL:         "/series?q=query&offset=30",
+			Expected: response.Links{
+				"self": {Href: "/series.search?offset=30&q=query"},
+				"prev": {Href: "/series.search?offset=10&q=query"},
+			},
+		},
+		{
+			Description: "Offset smaller than the limit",
+			URL:         "/series?q=query&offset=5",
+			Expected: response.Links{
+				"self": {Href: "/series.search?offset=5&q=query"},
+				"prev": {Href: "/series.search?q=query"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, test.URL, nil)
+			assert.Nil(err)
+			req.Header.Set("Accept", "application/hal+json")
+			recorder := httptest.NewRecorder()
+
+			uc := mockFindSeriesByTitleUseCase{
+				output: usecase.FindSeriesByTitleOutput{HasNext: test.HasNext},
+			}
+			action := NewFindSeriesByTitleAction(uc, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			output := struct {
+				Links response.Links `json:"_links"`
+			}{}
+			assert.Equal(http.StatusOK, recorder.Code)
+			assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+			assert.Equal(test.Expected, output.Links)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+)
+
+type FindWebhookByIDAction struct {
+	uc   usecase.FindWebhookByIDUseCase
+	urls URLBuilder
+}
+
+func NewFindWebhookByIDAction(
+	uc usecase.FindWebhookByIDUseCase,
+	urls URLBuilder,
+) FindWebhookByIDAction {
+	return FindWebhookByIDAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindWebhookByIDAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	webhookID, ok := r.Context().Value(CtxKeyWebhookID).(string)
+	if !ok || !domain.IsValidUUID(webhookID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing webhook id")
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), domain.WebhookID(webhookID))
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(webhookLinks(a.urls, webhookID))
+	}
+}
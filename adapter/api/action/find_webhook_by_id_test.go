@@ -0,0 +1,118 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindWebhookByIDUseCase struct {
+	output usecase.FindWebhookByIDOutput
+	err    error
+}
+
+func (uc mockFindWebhookByIDUseCase) Execute(
+	context.Context,
+	domain.WebhookID,
+) (usecase.FindWebhookByIDOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindWebhookByIDAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindWebhookByIDUseCase
+		WebhookID    string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			UC: mockFindWebhookByIDUseCase{
+				output: usecase.FindWebhookByIDOutput{
+					ID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					URL: "http://localhost/hook",
+				},
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindWebhookByIDOutput{
+				ID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				URL: "http://localhost/hook",
+			},
+		},
+
+		{
+			Description:  "Invalid webhook id",
+			UC:           mockFindWebhookByIDUseCase{},
+			WebhookID:    "ID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing webhook id"},
+			},
+		},
+
+		{
+			Description: "Searching webhook that does not exist",
+			UC: mockFindWebhookByIDUseCase{
+				err: domain.ErrWebhookNotFound,
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrWebhookNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindWebhookByIDUseCase{
+				err: errors.New("error"),
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeyWebhookID,
+				test.WebhookID,
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewFindWebhookByIDAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.FindWebhookByIDOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package action
+
+import (
+	"net/http"
+	"series/adapter/api/response"
+	"series/usecase"
+)
+
+type FindWebhooksAction struct {
+	uc   usecase.FindWebhooksUseCase
+	urls URLBuilder
+}
+
+func NewFindWebhooksAction(
+	uc usecase.FindWebhooksUseCase,
+	urls URLBuilder,
+) FindWebhooksAction {
+	return FindWebhooksAction{
+		uc:   uc,
+		urls: urls,
+	}
+}
+
+func (a FindWebhooksAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	output, err := a.uc.Execute(r.Context())
+	switch {
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(newLinks(a.urls, map[string]route{
+				"self": {RouteWebhooks, nil},
+			}))
+	}
+}
@@ -0,0 +1,84 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindWebhooksUseCase struct {
+	output usecase.FindWebhooksOutput
+	err    error
+}
+
+func (uc mockFindWebhooksUseCase) Execute(
+	context.Context,
+) (usecase.FindWebhooksOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestFindWebhooksAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.FindWebhooksUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			UC: mockFindWebhooksUseCase{
+				output: usecase.FindWebhooksOutput{
+					Webhooks: []usecase.FindWebhooksWebhook{
+						{ID: "ID", URL: "http://localhost/hook"},
+					},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.FindWebhooksOutput{
+				Webhooks: []usecase.FindWebhooksWebhook{
+					{ID: "ID", URL: "http://localhost/hook"},
+				},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockFindWebhooksUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			recorder := httptest.NewRecorder()
+
+			action := NewFindWebhooksAction(test.UC, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else {
+				output := usecase.FindWebhooksOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package action
+
+import (
+	"context"
+	"net/http"
+	"series/adapter/api/response"
+	"series/adapter/health"
+)
+
+type (
+	ReadinessChecker interface {
+		Ready(context.Context) error
+	}
+
+	StatusReporter interface {
+		Status(context.Context) health.Status
+	}
+
+	healthBody struct {
+		Status string `json:"status" xml:"status"`
+	}
+
+	HealthzAction struct{}
+
+	ReadyzAction struct {
+		checker ReadinessChecker
+	}
+
+	StatusAction struct {
+		reporter StatusReporter
+	}
+)
+
+// NewHealthzAction tells whether the process is up, it never fails
+// while the server is able to answer.
+func NewHealthzAction() HealthzAction {
+	return HealthzAction{}
+}
+
+func (a HealthzAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	res = response.NewSuccess(http.StatusOK, healthBody{Status: health.StatusOK})
+}
+
+func NewReadyzAction(checker ReadinessChecker) ReadyzAction {
+	return ReadyzAction{
+		checker: checker,
+	}
+}
+
+func (a ReadyzAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	if err := a.checker.Ready(r.Context()); err != nil {
+		res = response.NewError(http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	res = response.NewSuccess(http.StatusOK, healthBody{Status: health.StatusOK})
+}
+
+func NewStatusAction(reporter StatusReporter) StatusAction {
+	return StatusAction{
+		reporter: reporter,
+	}
+}
+
+// Execute always answers 200, the status of the server is in the body.
+func (a StatusAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	res = response.NewSuccess(http.StatusOK, a.reporter.Status(r.Context()))
+}
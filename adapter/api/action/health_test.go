@@ -0,0 +1,101 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"series/adapter/health"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockReadinessChecker struct {
+	err error
+}
+
+func (c mockReadinessChecker) Ready(context.Context) error {
+	return c.err
+}
+
+type mockStatusReporter struct {
+	status health.Status
+}
+
+func (r mockStatusReporter) Status(context.Context) health.Status {
+	return r.status
+}
+
+func TestHealthzAction(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	recorder := httptest.NewRecorder()
+	NewHealthzAction().Execute(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(http.StatusOK, recorder.Code)
+	assert.JSONEq(`{"status":"ok"}`, recorder.Body.String())
+}
+
+func TestReadyzAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		Checker      ReadinessChecker
+		ExpectedCode int
+		ExpectedBody string
+	}
+	tests := []Test{
+		{
+			Description:  "Ready",
+			Checker:      mockReadinessChecker{},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"status":"ok"}`,
+		},
+		{
+			Description:  "Shutting down",
+			Checker:      mockReadinessChecker{err: health.ErrDraining},
+			ExpectedCode: http.StatusServiceUnavailable,
+			ExpectedBody: `{"errors":["server is shutting down"]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			recorder := httptest.NewRecorder()
+			NewReadyzAction(test.Checker).
+				Execute(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			assert.JSONEq(test.ExpectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestStatusAction(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	status := health.Status{
+		Status:    health.StatusFailing,
+		Version:   "1.2.0",
+		Commit:    "abc123",
+		StartedAt: "2022-10-01T12:00:00Z",
+		Uptime:    "1h30m0s",
+		Dependencies: []health.DependencyStatus{
+			{Name: "postgres", Status: health.StatusOK, LatencyMS: 1.5},
+			{Name: "schema", Status: health.StatusFailing, LatencyMS: 2, Error: "missing tables: outbox"},
+		},
+	}
+	recorder := httptest.NewRecorder()
+	NewStatusAction(mockStatusReporter{status: status}).
+		Execute(recorder, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	assert.Equal(http.StatusOK, recorder.Code)
+	output := health.Status{}
+	assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+	assert.Equal(status, output)
+}
@@ -0,0 +1,131 @@
+package action
+
+import (
+	"net/url"
+	"series/adapter/api/response"
+	"series/usecase"
+	"strconv"
+)
+
+// Route names the router registers its routes under, links are
+// built from them so they follow any change to the paths.
+const (
+	RouteSeries        = "series"
+	RouteSeriesSearch  = "series.search"
+	RouteSeriesReviews = "series.reviews"
+	RouteSeriesSeasons = "series.seasons"
+	RouteSeriesCredits = "series.credits"
+	RouteReview        = "review"
+
+	RouteGenres        = "genres"
+	RouteNetworks      = "networks"
+	RoutePersonCredits = "person.credits"
+
+	RouteSeasonEpisodes = "season.episodes"
+	RouteEpisodeReviews = "episode.reviews"
+
+	RouteWebhooks          = "webhooks"
+	RouteWebhook           = "webhook"
+	RouteWebhookDeliveries = "webhook.deliveries"
+)
+
+// URLBuilder builds the URL of a named route from
+// its variables, given as name/value pairs.
+type URLBuilder interface {
+	URL(route string, pairs ...string) (string, error)
+}
+
+type route struct {
+	name  string
+	pairs []string
+}
+
+// newLinks resolves the route of every relation. Relations whose
+// route can't be built are left out instead of failing the request.
+func newLinks(urls URLBuilder, routes map[string]route) response.Links {
+	links := response.Links{}
+	for rel, route := range routes {
+		href, err := urls.URL(route.name, route.pairs...)
+		if err != nil {
+			continue
+		}
+		links[rel] = response.Link{Href: href}
+	}
+	return links
+}
+
+func seriesLinks(urls URLBuilder, seriesID string) response.Links {
+	return newLinks(urls, map[string]route{
+		"self":    {RouteSeries, []string{"id", seriesID}},
+		"reviews": {RouteSeriesReviews, []string{"id", seriesID}},
+		"seasons": {RouteSeriesSeasons, []string{"id", seriesID}},
+	})
+}
+
+func seasonLinks(urls URLBuilder, seriesID string, number int) response.Links {
+	season := strconv.Itoa(number)
+	return newLinks(urls, map[string]route{
+		"series":   {RouteSeries, []string{"id", seriesID}},
+		"seasons":  {RouteSeriesSeasons, []string{"id", seriesID}},
+		"episodes": {RouteSeasonEpisodes, []string{"id", seriesID, "number", season}},
+	})
+}
+
+// reviewLinks links an episode review to the episode reviews as well,
+// episodeID is empty for the reviews of the series itself.
+func reviewLinks(urls URLBuilder, reviewID, seriesID, episodeID string) response.Links {
+	routes := map[string]route{
+		"self":   {RouteReview, []string{"id", reviewID}},
+		"series": {RouteSeries, []string{"id", seriesID}},
+	}
+	if episodeID != "" {
+		routes["episode_reviews"] = route{RouteEpisodeReviews, []string{"id", episodeID}}
+	}
+	return newLinks(urls, routes)
+}
+
+func webhookLinks(urls URLBuilder, webhookID string) response.Links {
+	return newLinks(urls, map[string]route{
+		"self":       {RouteWebhook, []string{"id", webhookID}},
+		"deliveries": {RouteWebhookDeliveries, []string{"id", webhookID}},
+	})
+}
+
+// pageLinks adds the limit and offset of the page to the self link of a
+// list, and links its next and prev pages the same way.
+func pageLinks(links response.Links, page usecase.PageInput, hasNext bool) response.Links {
+	self, ok := links["self"]
+	if !ok {
+		return links
+	}
+	at := func(offset int) response.Link {
+		href, err := url.Parse(self.Href)
+		if err != nil {
+			return self
+		}
+		params := href.Query()
+		if page.Limit != 0 {
+			params.Set("limit", strconv.Itoa(page.Limit))
+		}
+		params.Del("offset")
+		if offset != 0 {
+			params.Set("offset", strconv.Itoa(offset))
+		}
+		href.RawQuery = params.Encode()
+		return response.Link{Href: href.String()}
+	}
+
+	limit := page.PageLimit()
+	links["self"] = at(page.Offset)
+	if hasNext {
+		links["next"] = at(page.Offset + limit)
+	}
+	if page.Offset > 0 {
+		prev := page.Offset - limit
+		if prev < 0 {
+			prev = 0
+		}
+		links["prev"] = at(prev)
+	}
+	return links
+}
@@ -0,0 +1,49 @@
+package action
+
+import (
+	"fmt"
+	"net/http"
+	"series/usecase"
+	"strconv"
+	"strings"
+)
+
+// queryList parses a comma separated query parameter.
+// ok is false when the parameter is absent.
+func queryList(r *http.Request, name string) (values []string, ok bool) {
+	raw, ok := r.URL.Query()[name]
+	if !ok {
+		return nil, false
+	}
+	values = []string{}
+	for _, item := range raw {
+		for _, value := range strings.Split(item, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values, true
+}
+
+// queryPage parses the limit and offset query parameters of a list,
+// absent ones are left zero. Their range is up to the validator.
+func queryPage(r *http.Request) (usecase.PageInput, error) {
+	var page usecase.PageInput
+	query := r.URL.Query()
+	for name, value := range map[string]*int{
+		"limit":  &page.Limit,
+		"offset": &page.Offset,
+	} {
+		raw := query.Get(name)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return usecase.PageInput{}, fmt.Errorf("%s must be a number", name)
+		}
+		*value = n
+	}
+	return page, nil
+}
@@ -0,0 +1,56 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryPage(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		URL         string
+		Expected    usecase.PageInput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "No limit nor offset",
+			URL:         "/series?q=query",
+			Expected:    usecase.PageInput{},
+		},
+		{
+			Description: "Limit and offset",
+			URL:         "/series?q=query&limit=10&offset=30",
+			Expected:    usecase.PageInput{Limit: 10, Offset: 30},
+		},
+		{
+			Description: "Limit that isn't a number",
+			URL:         "/series?q=query&limit=ten",
+			ExpectedErr: errors.New("limit must be a number"),
+		},
+		{
+			Description: "Offset that isn't a number",
+			URL:         "/series?q=query&offset=1.5",
+			ExpectedErr: errors.New("offset must be a number"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, test.URL, nil)
+			assert.Nil(err)
+
+			page, err := queryPage(req)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, page)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"series/adapter/api/response"
+	"series/domain"
+	"series/usecase"
+	"time"
+)
+
+// StreamReviewsBySeriesAction pushes the reviews created for a series as
+// server-sent events. The review id is the event id, so clients resume
+// where they left off by sending it back in Last-Event-ID.
+type StreamReviewsBySeriesAction struct {
+	uc        usecase.StreamReviewsBySeriesUseCase
+	heartbeat time.Duration
+}
+
+func NewStreamReviewsBySeriesAction(
+	uc usecase.StreamReviewsBySeriesUseCase,
+	heartbeat time.Duration,
+) StreamReviewsBySeriesAction {
+	return StreamReviewsBySeriesAction{
+		uc:        uc,
+		heartbeat: heartbeat,
+	}
+}
+
+func (a StreamReviewsBySeriesAction) Execute(w http.ResponseWriter, r *http.Request) {
+	seriesID, ok := r.Context().Value(CtxKeySeriesID).(string)
+	if !ok || !domain.IsValidUUID(seriesID) {
+		response.NewError(http.StatusBadRequest, "invalid or missing series id").Send(w, r)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		response.NewError(http.StatusInternalServerError, "streaming is not supported").Send(w, r)
+		return
+	}
+
+	outputs, err := a.uc.Execute(r.Context(), usecase.StreamReviewsBySeriesInput{
+		SeriesID:    domain.SeriesID(seriesID),
+		LastEventID: domain.ReviewID(r.Header.Get("Last-Event-ID")),
+	})
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		response.NewError(http.StatusNotFound, err.Error()).Send(w, r)
+		return
+	case err != nil:
+		response.NewError(http.StatusInternalServerError).Send(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", response.MediaTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(a.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case output, ok := <-outputs:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(output)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: review\ndata: %s\n\n", output.ID, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			// Comments keep proxies from closing an idle connection.
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,138 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStreamReviewsBySeriesUseCase struct {
+	outputs []usecase.StreamReviewsBySeriesOutput
+	// open keeps the stream open after outputs were sent.
+	open  bool
+	input *usecase.StreamReviewsBySeriesInput
+	err   error
+}
+
+func (uc mockStreamReviewsBySeriesUseCase) Execute(
+	ctx context.Context,
+	input usecase.StreamReviewsBySeriesInput,
+) (<-chan usecase.StreamReviewsBySeriesOutput, error) {
+	*uc.input = input
+	if uc.err != nil {
+		return nil, uc.err
+	}
+	outputs := make(chan usecase.StreamReviewsBySeriesOutput, len(uc.outputs))
+	for _, output := range uc.outputs {
+		outputs <- output
+	}
+	if !uc.open {
+		close(outputs)
+	}
+	return outputs, nil
+}
+
+func TestStreamReviewsBySeries(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           mockStreamReviewsBySeriesUseCase
+		LastEventID  string
+		ExpectedCode int
+		ExpectedBody string
+	}
+	tests := []Test{
+		{
+			Description: "Reviews are sent as events",
+			UC: mockStreamReviewsBySeriesUseCase{
+				outputs: []usecase.StreamReviewsBySeriesOutput{
+					{ID: "R1", Text: "First"},
+					{ID: "R2", Text: "Second"},
+				},
+			},
+			LastEventID:  "R0",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: "id: R1\nevent: review\n" +
+				`data: {"id":"R1","author_id":"","text":"First","version":0,"created_at":"","updated_at":""}` +
+				"\n\n" +
+				"id: R2\nevent: review\n" +
+				`data: {"id":"R2","author_id":"","text":"Second","version":0,"created_at":"","updated_at":""}` +
+				"\n\n",
+		},
+
+		{
+			Description: "Idle streams get heartbeats",
+			UC: mockStreamReviewsBySeriesUseCase{
+				open: true,
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: ": heartbeat\n\n",
+		},
+
+		{
+			Description: "Streaming reviews for series that does not exist",
+			UC: mockStreamReviewsBySeriesUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockStreamReviewsBySeriesUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req.Header.Set("Last-Event-ID", test.LastEventID)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			// Open streams only end when the client goes away.
+			ctx, cancel := context.WithTimeout(ctx, 75*time.Millisecond)
+			defer cancel()
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			test.UC.input = &usecase.StreamReviewsBySeriesInput{}
+			action := NewStreamReviewsBySeriesAction(test.UC, 50*time.Millisecond)
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			assert.Equal(
+				domain.ReviewID(test.LastEventID),
+				test.UC.input.LastEventID,
+			)
+			if recorder.Code >= http.StatusInternalServerError {
+				return
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal([]string{domain.ErrSeriesNotFound.Error()}, output.Errors)
+			} else {
+				assert.Equal("text/event-stream", recorder.Header().Get("Content-Type"))
+				assert.Equal(test.ExpectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type UpdateReviewAction struct {
+	uc        usecase.UpdateReviewUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewUpdateReviewAction(
+	uc usecase.UpdateReviewUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) UpdateReviewAction {
+	return UpdateReviewAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a UpdateReviewAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	reviewID, ok := r.Context().Value(CtxKeyReviewID).(string)
+	if !ok || !domain.IsValidUUID(reviewID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing review id")
+		return
+	}
+
+	input := usecase.UpdateReviewInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.ID = reviewID
+
+	version, conditional, err := ifMatchVersion(r)
+	if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	if conditional {
+		input.Version = version
+	}
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrReviewNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification) && conditional:
+		res = response.NewError(http.StatusPreconditionFailed, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification):
+		res = response.NewError(http.StatusConflict, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithHeader("ETag", etag(output.Version)).
+			WithLinks(reviewLinks(a.urls, output.ID, output.SeriesID, output.EpisodeID))
+	}
+}
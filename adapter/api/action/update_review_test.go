@@ -0,0 +1,147 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateReviewUseCase struct {
+	output usecase.UpdateReviewOutput
+	err    error
+}
+
+func (uc mockUpdateReviewUseCase) Execute(
+	context.Context,
+	usecase.UpdateReviewInput,
+) (usecase.UpdateReviewOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateReviewAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateReviewUseCase
+		IfMatch      string
+		ExpectedCode int
+		ExpectedETag string
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateReviewUseCase{
+				output: usecase.UpdateReviewOutput{
+					ID:       "ID",
+					SeriesID: "SeriesID",
+					AuthorID: "AuthorID",
+					Text:     "Text",
+					Version:  4,
+				},
+				err: nil,
+			},
+			IfMatch:      `"3"`,
+			ExpectedCode: http.StatusOK,
+			ExpectedETag: `"4"`,
+			ExpectedBody: usecase.UpdateReviewOutput{
+				ID:       "ID",
+				SeriesID: "SeriesID",
+				AuthorID: "AuthorID",
+				Text:     "Text",
+				Version:  4,
+			},
+		},
+
+		{
+			Description: "Updating review that does not exist",
+			UC: mockUpdateReviewUseCase{
+				err: domain.ErrReviewNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrReviewNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Stale version in body",
+			UC: mockUpdateReviewUseCase{
+				err: domain.ErrConcurrentModification,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrConcurrentModification.Error()},
+			},
+		},
+
+		{
+			Description: "Stale version in If-Match",
+			UC: mockUpdateReviewUseCase{
+				err: domain.ErrConcurrentModification,
+			},
+			IfMatch:      `"1"`,
+			ExpectedCode: http.StatusPreconditionFailed,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrConcurrentModification.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateReviewUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateReviewInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			if test.IfMatch != "" {
+				req.Header.Set("If-Match", test.IfMatch)
+			}
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeyReviewID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateReviewAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateReviewOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+				assert.Equal(test.ExpectedETag, recorder.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesAliasesUseCase struct {
+	output usecase.UpdateSeriesAliasesOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesAliasesUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesAliasesInput,
+) (usecase.UpdateSeriesAliasesOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesAliasesAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesAliasesUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesAliasesUseCase{
+				output: usecase.UpdateSeriesAliasesOutput{
+					SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Aliases:  []string{"La casa de papel"},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateSeriesAliasesOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Aliases:  []string{"La casa de papel"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockUpdateSeriesAliasesUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesAliasesUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesAliasesInput{Aliases: []string{"La casa de papel"}})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesAliasesAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesAliasesOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesExternalIDsUseCase struct {
+	output usecase.UpdateSeriesExternalIDsOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesExternalIDsUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesExternalIDsInput,
+) (usecase.UpdateSeriesExternalIDsOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesExternalIDsAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesExternalIDsUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesExternalIDsUseCase{
+				output: usecase.UpdateSeriesExternalIDsOutput{
+					SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					ExternalIDs: usecase.ExternalIDsOutput{IMDb: "tt0903747"},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateSeriesExternalIDsOutput{
+				SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				ExternalIDs: usecase.ExternalIDsOutput{IMDb: "tt0903747"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockUpdateSeriesExternalIDsUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "External ID of another series",
+			UC: mockUpdateSeriesExternalIDsUseCase{
+				err: domain.ErrExternalIDExists,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrExternalIDExists.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesExternalIDsUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesExternalIDsInput{IMDb: "tt0903747"})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesExternalIDsAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesExternalIDsOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
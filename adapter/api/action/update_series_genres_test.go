@@ -0,0 +1,134 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesGenresUseCase struct {
+	output usecase.UpdateSeriesGenresOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesGenresUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesGenresInput,
+) (usecase.UpdateSeriesGenresOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesGenresAction(t *testing.T) {
+	t.Parallel()
+
+	unknown := fmt.Errorf("%w: Western", domain.ErrGenreNotFound)
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesGenresUseCase
+		SeriesID     string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesGenresUseCase{
+				output: usecase.UpdateSeriesGenresOutput{
+					SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Genres:   []string{"Drama"},
+				},
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateSeriesGenresOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Genres:   []string{"Drama"},
+			},
+		},
+
+		{
+			Description:  "Invalid series id",
+			UC:           mockUpdateSeriesGenresUseCase{},
+			SeriesID:     "SeriesID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing series id"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockUpdateSeriesGenresUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Unknown genre",
+			UC: mockUpdateSeriesGenresUseCase{
+				err: unknown,
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{unknown.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesGenresUseCase{
+				err: errors.New("error"),
+			},
+			SeriesID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesGenresInput{Genres: []string{"Drama"}})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(req.Context(), CtxKeySeriesID, test.SeriesID)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesGenresAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesGenresOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
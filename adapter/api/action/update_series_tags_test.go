@@ -0,0 +1,109 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesTagsUseCase struct {
+	output usecase.UpdateSeriesTagsOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesTagsUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesTagsInput,
+) (usecase.UpdateSeriesTagsOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesTagsAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesTagsUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesTagsUseCase{
+				output: usecase.UpdateSeriesTagsOutput{
+					SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Tags:     []string{"anthology"},
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateSeriesTagsOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Tags:     []string{"anthology"},
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockUpdateSeriesTagsUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesTagsUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesTagsInput{Tags: []string{"Anthology"}})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesTagsAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesTagsOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
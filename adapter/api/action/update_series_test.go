@@ -0,0 +1,195 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesUseCase struct {
+	output usecase.UpdateSeriesOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesInput,
+) (usecase.UpdateSeriesOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesUseCase
+		IfMatch      string
+		ExpectedCode int
+		ExpectedETag string
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesUseCase{
+				output: usecase.UpdateSeriesOutput{
+					ID:          "ID",
+					Title:       "Title",
+					Description: "Description",
+					Episodes:    20,
+					BeginYear:   1970,
+					EndYear:     1980,
+					Creator:     "Creator",
+					Version:     2,
+				},
+				err: nil,
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedETag: `"2"`,
+			ExpectedBody: usecase.UpdateSeriesOutput{
+				ID:          "ID",
+				Title:       "Title",
+				Description: "Description",
+				Episodes:    20,
+				BeginYear:   1970,
+				EndYear:     1980,
+				Creator:     "Creator",
+				Version:     2,
+			},
+		},
+
+		{
+			Description: "Updating series that does not exist",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Network not found",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrNetworkNotFound,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrNetworkNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Creator not found",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrPersonNotFound,
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrPersonNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Slug taken by another series meanwhile",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrSlugTaken,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSlugTaken.Error()},
+			},
+		},
+
+		{
+			Description: "Stale version in body",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrConcurrentModification,
+			},
+			ExpectedCode: http.StatusConflict,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrConcurrentModification.Error()},
+			},
+		},
+
+		{
+			Description: "Stale version in If-Match",
+			UC: mockUpdateSeriesUseCase{
+				err: domain.ErrConcurrentModification,
+			},
+			IfMatch:      `"1"`,
+			ExpectedCode: http.StatusPreconditionFailed,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrConcurrentModification.Error()},
+			},
+		},
+
+		{
+			Description:  "Malformed If-Match",
+			UC:           mockUpdateSeriesUseCase{},
+			IfMatch:      "version",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{errInvalidIfMatch.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			if test.IfMatch != "" {
+				req.Header.Set("If-Match", test.IfMatch)
+			}
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+				assert.Equal(test.ExpectedETag, recorder.Header().Get("ETag"))
+			}
+		})
+	}
+}
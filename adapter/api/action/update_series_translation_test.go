@@ -0,0 +1,117 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesTranslationUseCase struct {
+	output usecase.UpdateSeriesTranslationOutput
+	err    error
+}
+
+func (uc mockUpdateSeriesTranslationUseCase) Execute(
+	context.Context,
+	usecase.UpdateSeriesTranslationInput,
+) (usecase.UpdateSeriesTranslationOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateSeriesTranslationAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateSeriesTranslationUseCase
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateSeriesTranslationUseCase{
+				output: usecase.UpdateSeriesTranslationOutput{
+					SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					Language:    "pt",
+					Title:       "Irmãos de Guerra",
+					Description: "Série sobre a Easy Company",
+				},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateSeriesTranslationOutput{
+				SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Language:    "pt",
+				Title:       "Irmãos de Guerra",
+				Description: "Série sobre a Easy Company",
+			},
+		},
+
+		{
+			Description: "Series not found",
+			UC: mockUpdateSeriesTranslationUseCase{
+				err: domain.ErrSeriesNotFound,
+			},
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrSeriesNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateSeriesTranslationUseCase{
+				err: errors.New("error"),
+			},
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateSeriesTranslationInput{
+				Title:       "Irmãos de Guerra",
+				Description: "Série sobre a Easy Company",
+			})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			ctx := context.WithValue(
+				req.Context(),
+				CtxKeySeriesID,
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			)
+			ctx = context.WithValue(ctx, CtxKeyLanguage, "pt")
+			req = req.WithContext(ctx)
+
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateSeriesTranslationAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateSeriesTranslationOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"series/adapter/api/request"
+	"series/adapter/api/response"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+)
+
+type UpdateWebhookAction struct {
+	uc        usecase.UpdateWebhookUseCase
+	validator validator.Validator
+	urls      URLBuilder
+}
+
+func NewUpdateWebhookAction(
+	uc usecase.UpdateWebhookUseCase,
+	validator validator.Validator,
+	urls URLBuilder,
+) UpdateWebhookAction {
+	return UpdateWebhookAction{
+		uc:        uc,
+		validator: validator,
+		urls:      urls,
+	}
+}
+
+func (a UpdateWebhookAction) Execute(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	defer func() { res.Send(w, r) }()
+
+	webhookID, ok := r.Context().Value(CtxKeyWebhookID).(string)
+	if !ok || !domain.IsValidUUID(webhookID) {
+		res = response.NewError(http.StatusBadRequest, "invalid or missing webhook id")
+		return
+	}
+
+	input := usecase.UpdateWebhookInput{}
+	if err := request.Decode(r, &input); errors.Is(err, request.ErrUnsupportedMediaType) {
+		res = response.NewError(http.StatusUnsupportedMediaType, err.Error())
+		return
+	} else if err != nil {
+		res = response.NewError(http.StatusBadRequest, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	input.ID = webhookID
+
+	if err := a.validator.Validate(input); err != nil {
+		res = response.NewError(http.StatusBadRequest, a.validator.Messages(err)...)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		res = response.NewError(http.StatusNotFound, err.Error())
+	case err != nil:
+		res = response.NewError(http.StatusInternalServerError)
+	default:
+		res = response.NewSuccess(http.StatusOK, output).
+			WithLinks(webhookLinks(a.urls, output.ID))
+	}
+}
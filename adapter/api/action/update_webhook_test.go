@@ -0,0 +1,123 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateWebhookUseCase struct {
+	output usecase.UpdateWebhookOutput
+	err    error
+}
+
+func (uc mockUpdateWebhookUseCase) Execute(
+	context.Context,
+	usecase.UpdateWebhookInput,
+) (usecase.UpdateWebhookOutput, error) {
+	return uc.output, uc.err
+}
+
+func TestUpdateWebhookAction(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		UC           usecase.UpdateWebhookUseCase
+		WebhookID    string
+		ExpectedCode int
+		ExpectedBody any
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			UC: mockUpdateWebhookUseCase{
+				output: usecase.UpdateWebhookOutput{
+					ID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					URL:    "http://localhost/hook",
+					Events: []string{"review.created"},
+				},
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: usecase.UpdateWebhookOutput{
+				ID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				URL:    "http://localhost/hook",
+				Events: []string{"review.created"},
+			},
+		},
+
+		{
+			Description:  "Invalid webhook id",
+			UC:           mockUpdateWebhookUseCase{},
+			WebhookID:    "ID",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: errorResponse{
+				Errors: []string{"invalid or missing webhook id"},
+			},
+		},
+
+		{
+			Description: "Updating webhook that does not exist",
+			UC: mockUpdateWebhookUseCase{
+				err: domain.ErrWebhookNotFound,
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusNotFound,
+			ExpectedBody: errorResponse{
+				Errors: []string{domain.ErrWebhookNotFound.Error()},
+			},
+		},
+
+		{
+			Description: "Generic error",
+			UC: mockUpdateWebhookUseCase{
+				err: errors.New("error"),
+			},
+			WebhookID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedBody: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input, err := json.Marshal(usecase.UpdateWebhookInput{})
+			assert.Nil(err)
+			req, err := http.NewRequest(http.MethodPut, "", bytes.NewReader(input))
+			assert.Nil(err)
+			req = req.WithContext(context.WithValue(
+				req.Context(),
+				CtxKeyWebhookID,
+				test.WebhookID,
+			))
+			recorder := httptest.NewRecorder()
+
+			action := NewUpdateWebhookAction(test.UC, mockValidator{}, mockURLBuilder{})
+			action.Execute(recorder, req)
+
+			assert.Equal(test.ExpectedCode, recorder.Code)
+			if recorder.Code >= http.StatusInternalServerError {
+				assert.Equal(test.ExpectedCode, recorder.Code)
+			} else if recorder.Code >= http.StatusBadRequest {
+				output := errorResponse{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			} else {
+				output := usecase.UpdateWebhookOutput{}
+				assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &output))
+				assert.Equal(test.ExpectedBody, output)
+			}
+		})
+	}
+}
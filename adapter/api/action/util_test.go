@@ -13,3 +13,13 @@ func (v mockValidator) Messages(err error) []string {
 type errorResponse struct {
 	Errors []string `json:"errors"`
 }
+
+type mockURLBuilder struct{}
+
+func (b mockURLBuilder) URL(route string, pairs ...string) (string, error) {
+	url := "/" + route
+	for i := 1; i < len(pairs); i += 2 {
+		url += "/" + pairs[i]
+	}
+	return url, nil
+}
@@ -0,0 +1,32 @@
+package action
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header")
+
+// ifMatchVersion extracts the expected entity version from the If-Match
+// header. ok is false when the request is not conditional.
+func ifMatchVersion(r *http.Request) (version int, ok bool, err error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, false, nil
+	}
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, false, errInvalidIfMatch
+	}
+	version, err = strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, false, errInvalidIfMatch
+	}
+	return version, true, nil
+}
+
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
@@ -0,0 +1,68 @@
+package graphql
+
+import (
+	"errors"
+	"series/domain"
+	"strings"
+)
+
+const (
+	codeValidationFailed = "GRAPHQL_VALIDATION_FAILED"
+	codeBadUserInput     = "BAD_USER_INPUT"
+	codeNotFound         = "NOT_FOUND"
+	codeConflict         = "CONFLICT"
+	codeInternal         = "INTERNAL_SERVER_ERROR"
+)
+
+// resolverError carries a machine readable code in the
+// "extensions" of the GraphQL error it is reported as.
+type resolverError struct {
+	code     string
+	messages []string
+}
+
+func newError(code string, messages ...string) *resolverError {
+	return &resolverError{
+		code:     code,
+		messages: messages,
+	}
+}
+
+func (e *resolverError) Error() string {
+	return strings.Join(e.messages, "; ")
+}
+
+func (e *resolverError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+// resolverErr maps use case errors the same way the REST actions do.
+// Unexpected errors are not leaked to the client.
+func resolverErr(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrEpisodeNotFound),
+		errors.Is(err, domain.ErrReviewNotFound):
+		return newError(codeNotFound, err.Error())
+	case errors.Is(err, domain.ErrNetworkNotFound),
+		errors.Is(err, domain.ErrPersonNotFound),
+		errors.Is(err, domain.ErrStatusEndYear):
+		return newError(codeBadUserInput, err.Error())
+	case errors.Is(err, domain.ErrAlreadyReviewed),
+		errors.Is(err, domain.ErrConcurrentModification),
+		errors.Is(err, domain.ErrSlugTaken):
+		return newError(codeConflict, err.Error())
+	default:
+		return newError(codeInternal, "internal server error")
+	}
+}
+
+// createReviewErr maps the errors of creating a review, where a missing
+// series or episode is bad input as REST answers 400 Bad Request.
+func createReviewErr(err error) error {
+	if errors.Is(err, domain.ErrSeriesNotFound) ||
+		errors.Is(err, domain.ErrEpisodeNotFound) {
+		return newError(codeBadUserInput, err.Error())
+	}
+	return resolverErr(err)
+}
@@ -0,0 +1,96 @@
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"series/adapter/validator"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed schema.graphql
+var schema string
+
+type Handler struct {
+	schema *gql.Schema
+	// parsedSchema types the fields of queries to estimate their
+	// complexity, graphql-go doesn't expose its own.
+	parsedSchema *ast.Schema
+	uc           UseCases
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func NewHandler(uc UseCases, validator validator.Validator) *Handler {
+	return &Handler{
+		schema: gql.MustParseSchema(
+			schema,
+			&resolver{uc: uc, validator: validator},
+			gql.MaxDepth(MaxDepth),
+		),
+		parsedSchema: gqlparser.MustLoadSchema(&ast.Source{Input: schema}),
+		uc:           uc,
+	}
+}
+
+// ServeHTTP executes a query sent as JSON. Queries longer than
+// MaxQueryLength, deeper than MaxDepth or more complex than
+// MaxComplexity are rejected before any resolver runs.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid GraphQL request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	if len(req.Query) > MaxQueryLength {
+		err := errors.Errorf("query is longer than %d bytes", MaxQueryLength)
+		h.write(w, &gql.Response{Errors: []*errors.QueryError{err}})
+		return
+	}
+	if err := h.checkComplexity(req.Query, req.Variables); err != nil {
+		h.write(w, &gql.Response{Errors: []*errors.QueryError{err}})
+		return
+	}
+
+	ctx := withReviewsLoader(r.Context(), newReviewsLoader(h.uc.FindReviewsBySeriesIDs))
+	h.write(w, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+// checkComplexity checks every operation of the query. Invalid
+// queries are left to the schema, which reports their errors.
+func (h *Handler) checkComplexity(query string, variables map[string]any) *errors.QueryError {
+	doc, errs := gqlparser.LoadQuery(h.parsedSchema, query)
+	if len(errs) > 0 {
+		return nil
+	}
+	for _, op := range doc.Operations {
+		if err := checkComplexity(op, variables); err != nil {
+			return errors.Errorf("%s", err)
+		}
+	}
+	return nil
+}
+
+// write sends the response. Errors without a code are the ones of
+// parsing and validating the query, resolvers give theirs one.
+func (h *Handler) write(w http.ResponseWriter, res *gql.Response) {
+	for _, err := range res.Errors {
+		if err.Extensions == nil {
+			err.Extensions = map[string]any{"code": codeValidationFailed}
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
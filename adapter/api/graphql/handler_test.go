@@ -0,0 +1,312 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"series/usecase"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockValidator struct {
+	err error
+}
+
+func (v mockValidator) Validate(any) error {
+	return v.err
+}
+
+func (v mockValidator) Messages(err error) []string {
+	return []string{err.Error()}
+}
+
+type mockFindSeriesByIDUseCase struct {
+	output usecase.FindSeriesByIDOutput
+	err    error
+}
+
+func (uc mockFindSeriesByIDUseCase) Execute(
+	context.Context,
+	usecase.FindSeriesByIDInput,
+) (usecase.FindSeriesByIDOutput, error) {
+	return uc.output, uc.err
+}
+
+type mockFindSeriesByTitleUseCase struct {
+	output usecase.FindSeriesByTitleOutput
+}
+
+func (uc mockFindSeriesByTitleUseCase) Execute(
+	context.Context,
+	usecase.FindSeriesByTitleInput,
+) (usecase.FindSeriesByTitleOutput, error) {
+	return uc.output, nil
+}
+
+type mockCreateSeriesUseCase struct {
+	output usecase.CreateSeriesOutput
+}
+
+func (uc mockCreateSeriesUseCase) Execute(
+	context.Context,
+	usecase.CreateSeriesInput,
+) (usecase.CreateSeriesOutput, error) {
+	return uc.output, nil
+}
+
+type mockFindReviewsBySeriesIDsUseCase struct {
+	mu      *sync.Mutex
+	calls   *[][]domain.SeriesID
+	reviews []usecase.FindReviewsBySeriesIDsReview
+}
+
+func (uc mockFindReviewsBySeriesIDsUseCase) Execute(
+	_ context.Context,
+	seriesIDs []domain.SeriesID,
+) (usecase.FindReviewsBySeriesIDsOutput, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	*uc.calls = append(*uc.calls, seriesIDs)
+	return usecase.FindReviewsBySeriesIDsOutput{Reviews: uc.reviews}, nil
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, handler *Handler, query string) graphQLResponse {
+	body, err := json.Marshal(request{Query: query})
+	assert.Nil(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	assert.Nil(t, err)
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	res := graphQLResponse{}
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	return res
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	endYear, rating := 1990, 8
+	genres, tags := []string{"Drama"}, []string{}
+	seriesReviews := []usecase.FindSeriesByIDReview{
+		{
+			ID:       "R1",
+			AuthorID: "A1",
+			Text:     "First",
+			Rating:   &rating,
+			Author:   &usecase.FindSeriesByIDAuthor{ID: "A1", Reviews: 3},
+		},
+		{ID: "R3", AuthorID: "A2", Text: "Third"},
+	}
+	reviews := []usecase.FindReviewsBySeriesIDsReview{
+		{ID: "R1", SeriesID: "S1", Text: "First"},
+		{ID: "R2", SeriesID: "S2", Text: "Second"},
+		{ID: "R3", SeriesID: "S1", Text: "Third"},
+	}
+
+	type Test struct {
+		Description   string
+		UC            UseCases
+		Validator     mockValidator
+		Query         string
+		ExpectedData  string
+		ExpectedCodes []string
+	}
+	tests := []Test{
+		{
+			Description: "Series with reviews",
+			UC: UseCases{
+				FindSeriesByID: mockFindSeriesByIDUseCase{
+					output: usecase.FindSeriesByIDOutput{
+						ID:      "S1",
+						Title:   "Title",
+						EndYear: &endYear,
+						Reviews: &seriesReviews,
+					},
+				},
+			},
+			Query:        `{ series(id: "S1") { title endYear reviews { id seriesId text } } }`,
+			ExpectedData: `{"series":{"title":"Title","endYear":1990,"reviews":[{"id":"R1","seriesId":"S1","text":"First"},{"id":"R3","seriesId":"S1","text":"Third"}]}}`,
+		},
+		{
+			Description: "Series with ratings, genres, tags and review authors",
+			UC: UseCases{
+				FindSeriesByID: mockFindSeriesByIDUseCase{
+					output: usecase.FindSeriesByIDOutput{
+						ID:            "S1",
+						Rating:        &usecase.RatingOutput{Average: 8, Count: 1},
+						EpisodeRating: &usecase.RatingOutput{},
+						Genres:        &genres,
+						Tags:          &tags,
+						Reviews:       &seriesReviews,
+					},
+				},
+			},
+			Query: `{ series(id: "S1") {
+				rating { average count } episodeRating { average count } genres tags
+				reviews { id rating author { id reviews } }
+			} }`,
+			ExpectedData: `{"series":{
+				"rating":{"average":8,"count":1},"episodeRating":{"average":0,"count":0},
+				"genres":["Drama"],"tags":[],
+				"reviews":[{"id":"R1","rating":8,"author":{"id":"A1","reviews":3}},{"id":"R3","rating":null,"author":null}]
+			}}`,
+		},
+		{
+			Description: "Created series without ratings, genres nor tags",
+			UC: UseCases{
+				CreateSeries: mockCreateSeriesUseCase{
+					output: usecase.CreateSeriesOutput{ID: "S1", Title: "Title"},
+				},
+			},
+			Query: `mutation {
+				createSeries(input: {title: "Title", description: "", beginYear: 1980, creatorIds: ["P1"]}) {
+					title rating { count } genres tags reviews { id author { id } }
+				}
+			}`,
+			ExpectedData: `{"createSeries":{
+				"title":"Title","rating":null,"genres":null,"tags":null,
+				"reviews":[{"id":"R1","author":null},{"id":"R3","author":null}]
+			}}`,
+		},
+		{
+			Description: "Series that does not exist is null",
+			UC: UseCases{
+				FindSeriesByID: mockFindSeriesByIDUseCase{
+					err: domain.ErrSeriesNotFound,
+				},
+			},
+			Query:        `{ series(id: "S1") { title } }`,
+			ExpectedData: `{"series":null}`,
+		},
+		{
+			Description: "Search results get their own reviews",
+			UC: UseCases{
+				FindSeriesByTitle: mockFindSeriesByTitleUseCase{
+					output: usecase.FindSeriesByTitleOutput{
+						Series: []usecase.FindSeriesByTitleSeries{
+							{ID: "S1"}, {ID: "S2"}, {ID: "S3"},
+						},
+					},
+				},
+			},
+			Query:        `{ searchSeries(title: "t") { id reviews { id } } }`,
+			ExpectedData: `{"searchSeries":[{"id":"S1","reviews":[{"id":"R1"},{"id":"R3"}]},{"id":"S2","reviews":[{"id":"R2"}]},{"id":"S3","reviews":[]}]}`,
+		},
+		{
+			Description: "Unknown field",
+			Query:       `{ series(id: "S1") { seasons } }`,
+			ExpectedCodes: []string{
+				codeValidationFailed,
+			},
+		},
+		{
+			Description: "Invalid input",
+			Validator:   mockValidator{err: errors.New("text is required")},
+			Query:       `mutation { updateReview(id: "R1", version: 1, text: "") { id } }`,
+			ExpectedCodes: []string{
+				codeBadUserInput,
+			},
+		},
+		{
+			Description:   "Query longer than the limit",
+			Query:         "{ " + strings.Repeat(`s: series(id: "S1") { id } `, MaxQueryLength/20) + "}",
+			ExpectedCodes: []string{codeValidationFailed},
+		},
+		{
+			Description: "Query deeper than the limit",
+			Query: `{ __schema { types { fields { type { ofType { ofType { ofType { ofType { ofType {
+				ofType { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } } } } } } }`,
+			ExpectedCodes: []string{codeValidationFailed},
+		},
+		{
+			Description:   "Query more complex than the limit",
+			Query:         `{ searchSeries(title: "t", limit: 100) { id title reviews { id text } } }`,
+			ExpectedCodes: []string{codeValidationFailed},
+		},
+		{
+			Description:  "Introspection within the limit",
+			Query:        `{ __schema { types { name fields { name type { name ofType { name ofType { name ofType { name } } } } } } } }`,
+			ExpectedData: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			calls := [][]domain.SeriesID{}
+			test.UC.FindReviewsBySeriesIDs = mockFindReviewsBySeriesIDsUseCase{
+				mu:      &sync.Mutex{},
+				calls:   &calls,
+				reviews: reviews,
+			}
+			handler := NewHandler(test.UC, test.Validator)
+
+			res := execute(t, handler, test.Query)
+
+			codes := []string{}
+			for _, err := range res.Errors {
+				code, _ := err.Extensions["code"].(string)
+				codes = append(codes, code)
+			}
+			if test.ExpectedCodes == nil {
+				assert.Empty(res.Errors)
+			} else {
+				assert.Equal(test.ExpectedCodes, codes)
+			}
+			if test.ExpectedData != "" {
+				assert.JSONEq(test.ExpectedData, string(res.Data))
+			}
+			assert.LessOrEqual(len(calls), 1, "reviews should be loaded in one batch")
+		})
+	}
+}
+
+func TestReviewsAreBatched(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+
+	calls := [][]domain.SeriesID{}
+	handler := NewHandler(UseCases{
+		FindSeriesByTitle: mockFindSeriesByTitleUseCase{
+			output: usecase.FindSeriesByTitleOutput{
+				Series: []usecase.FindSeriesByTitleSeries{
+					{ID: "S1"}, {ID: "S2"}, {ID: "S3"},
+				},
+			},
+		},
+		FindReviewsBySeriesIDs: mockFindReviewsBySeriesIDsUseCase{
+			mu:    &sync.Mutex{},
+			calls: &calls,
+		},
+	}, mockValidator{})
+
+	res := execute(t, handler, `{ searchSeries(title: "t") { reviews { id } } }`)
+
+	assert.Empty(res.Errors)
+	assert.Len(calls, 1)
+	ids := calls[0]
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	assert.Equal([]domain.SeriesID{"S1", "S2", "S3"}, ids)
+}
@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"fmt"
+	"series/usecase"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// MaxDepth is how deeply fields may be nested in a query, enforced
+	// by the schema. The types of the API nest a few levels at most,
+	// introspection ones indefinitely through ofType: the limit leaves
+	// room for the introspection query of tools like GraphiQL.
+	MaxDepth = 15
+	// MaxQueryLength bounds the size of a query in bytes.
+	MaxQueryLength = 8 << 10
+	// MaxComplexity bounds the estimated cost of a query, see complexity.
+	MaxComplexity = 2000
+	// listMultiplier is the number of items a list field without a
+	// limit argument is assumed to return.
+	listMultiplier = 10
+)
+
+// checkComplexity rejects operations above MaxComplexity.
+func checkComplexity(op *ast.OperationDefinition, variables map[string]any) error {
+	if cost := complexity(op.SelectionSet, variables); cost > MaxComplexity {
+		return fmt.Errorf(
+			"query has complexity %d that exceeds max complexity %d",
+			cost, MaxComplexity,
+		)
+	}
+	return nil
+}
+
+// complexity counts every selected field once, aliases of a field
+// as many times as they select it, and everything selected below a
+// list as many times as the list has items, see multiplier.
+// Introspection fields are not counted so that tools can still fetch
+// the whole schema.
+func complexity(selections ast.SelectionSet, variables map[string]any) int {
+	cost := 0
+	for _, field := range fields(selections) {
+		children := complexity(field.SelectionSet, variables)
+		cost += 1 + children*multiplier(field, variables)
+	}
+	return cost
+}
+
+// multiplier is the number of items a field returns: 1 unless it's a
+// list, the limit argument of paginated lists, listMultiplier for the
+// others.
+func multiplier(field *ast.Field, variables map[string]any) int {
+	if field.Definition == nil || field.Definition.Type.Elem == nil {
+		return 1
+	}
+	if field.Definition.Arguments.ForName("limit") == nil {
+		return listMultiplier
+	}
+	limit := 0
+	if arg := field.Arguments.ForName("limit"); arg != nil {
+		// Literals are parsed as int64, variables decoded from JSON as
+		// float64.
+		switch value, _ := arg.Value.Value(variables); value := value.(type) {
+		case int64:
+			limit = int(value)
+		case float64:
+			limit = int(value)
+		}
+	}
+	// Limits out of range are rejected by the resolver, they mustn't
+	// lower the cost of the rest of the query in the meantime.
+	if limit < 1 {
+		return usecase.DefaultPageLimit
+	}
+	return limit
+}
+
+// fields flattens fragments into the fields they select,
+// leaving out introspection fields.
+func fields(selections ast.SelectionSet) []*ast.Field {
+	var result []*ast.Field
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(selection.Name, "__") {
+				result = append(result, selection)
+			}
+		case *ast.InlineFragment:
+			result = append(result, fields(selection.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				result = append(result, fields(selection.Definition.SelectionSet)...)
+			}
+		}
+	}
+	return result
+}
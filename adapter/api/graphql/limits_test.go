@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestCheckComplexity(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node: Node! nodes: [Node!]! page(limit: Int): [Node!]! }
+		type Node { id: ID! child: Node! children: [Node!]! }
+	`})
+
+	type Test struct {
+		Description        string
+		Query              string
+		Variables          map[string]any
+		ExpectedComplexity int
+		ExpectedErr        bool
+	}
+	tests := []Test{
+		{
+			Description:        "Scalar fields",
+			Query:              `{ node { id } }`,
+			ExpectedComplexity: 2,
+		},
+		{
+			Description:        "Aliases count every time",
+			Query:              `{ a: node { id } b: node { id } c: node { a: id b: id } }`,
+			ExpectedComplexity: 2 + 2 + 3,
+		},
+		{
+			Description:        "Lists multiply what they select",
+			Query:              `{ nodes { id children { id } } }`,
+			ExpectedComplexity: 1 + (1+1+1*listMultiplier)*listMultiplier,
+		},
+		{
+			Description:        "Limit argument multiplies what the list selects",
+			Query:              `{ page(limit: 50) { id child { id } } }`,
+			ExpectedComplexity: 1 + 3*50,
+		},
+		{
+			Description:        "Limit given as a variable",
+			Query:              `query($limit: Int) { page(limit: $limit) { id } }`,
+			Variables:          map[string]any{"limit": float64(100)},
+			ExpectedComplexity: 1 + 100,
+		},
+		{
+			Description:        "Default or invalid limit",
+			Query:              `{ a: page { id } b: page(limit: -1000) { id } }`,
+			ExpectedComplexity: 2 * (1 + usecase.DefaultPageLimit),
+		},
+		{
+			Description:        "Fragments are expanded",
+			Query:              `{ node { ...F ... on Node { id } } } fragment F on Node { child { id } }`,
+			ExpectedComplexity: 4,
+		},
+		{
+			Description:        "Introspection is not counted",
+			Query:              `{ __typename node { id __typename } }`,
+			ExpectedComplexity: 2,
+		},
+		{
+			Description:        "Too complex",
+			Query:              `{ nodes { children { children { children { id } } } } }`,
+			ExpectedComplexity: 11111,
+			ExpectedErr:        true,
+		},
+		{
+			Description: "Too many aliases",
+			Query: `{
+				a: page(limit: 100) { id child { id } } b: page(limit: 100) { id child { id } }
+				c: page(limit: 100) { id child { id } } d: page(limit: 100) { id child { id } }
+				e: page(limit: 100) { id child { id } } f: page(limit: 100) { id child { id } }
+				g: page(limit: 100) { id child { id } }
+			}`,
+			ExpectedComplexity: 7 * (1 + 3*100),
+			ExpectedErr:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			doc, errs := gqlparser.LoadQuery(schema, test.Query)
+			assert.Empty(errs)
+			op := doc.Operations[0]
+			assert.Equal(test.ExpectedComplexity, complexity(op.SelectionSet, test.Variables))
+			assert.Equal(test.ExpectedErr, checkComplexity(op, test.Variables) != nil)
+		})
+	}
+}
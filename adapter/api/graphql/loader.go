@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"series/usecase"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+type ctxKey string
+
+const ctxKeyReviewsLoader ctxKey = "reviews_loader"
+
+// newReviewsLoader batches the reviews of all series resolved in the
+// same request into a single use case call. A loader caches its results
+// so it must not outlive the request it was created for.
+func newReviewsLoader(uc usecase.FindReviewsBySeriesIDsUseCase) *dataloader.Loader {
+	batch := func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		seriesIDs := make([]domain.SeriesID, len(keys))
+		for i, key := range keys {
+			seriesIDs[i] = domain.SeriesID(key.String())
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		output, err := uc.Execute(ctx, seriesIDs)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result{Error: resolverErr(err)}
+			}
+			return results
+		}
+
+		bySeries := make(map[string][]*reviewResolver, len(keys))
+		for _, review := range output.Reviews {
+			bySeries[review.SeriesID] = append(
+				bySeries[review.SeriesID], &reviewResolver{review: review},
+			)
+		}
+		for i, key := range keys {
+			reviews := bySeries[key.String()]
+			if reviews == nil {
+				reviews = []*reviewResolver{}
+			}
+			results[i] = &dataloader.Result{Data: reviews}
+		}
+		return results
+	}
+	return dataloader.NewBatchedLoader(batch)
+}
+
+func withReviewsLoader(ctx context.Context, loader *dataloader.Loader) context.Context {
+	return context.WithValue(ctx, ctxKeyReviewsLoader, loader)
+}
+
+func loadReviews(ctx context.Context, seriesID string) ([]*reviewResolver, error) {
+	loader, ok := ctx.Value(ctxKeyReviewsLoader).(*dataloader.Loader)
+	if !ok {
+		return nil, errors.New("reviews loader missing from context")
+	}
+	data, err := loader.Load(ctx, dataloader.StringKey(seriesID))()
+	if err != nil {
+		return nil, err
+	}
+	return data.([]*reviewResolver), nil
+}
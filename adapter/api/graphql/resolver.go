@@ -0,0 +1,344 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"series/adapter/validator"
+	"series/domain"
+	"series/usecase"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// UseCases are the interactors the resolvers delegate to,
+// the GraphQL layer never talks to the repositories itself.
+type UseCases struct {
+	FindSeriesByID         usecase.FindSeriesByIDUseCase
+	FindSeriesByTitle      usecase.FindSeriesByTitleUseCase
+	FindReviewsBySeriesIDs usecase.FindReviewsBySeriesIDsUseCase
+	CreateSeries           usecase.CreateSeriesUseCase
+	UpdateSeries           usecase.UpdateSeriesUseCase
+	CreateReview           usecase.CreateReviewUseCase
+	UpdateReview           usecase.UpdateReviewUseCase
+}
+
+type resolver struct {
+	uc        UseCases
+	validator validator.Validator
+}
+
+type seriesInput struct {
+	Title       string
+	Description string
+	BeginYear   int32
+	EndYear     *int32
+	CreatorIDs  []gql.ID
+	NetworkID   *gql.ID
+	Country     *string
+	Language    *string
+}
+
+type createReviewInput struct {
+	SeriesID  gql.ID
+	EpisodeID *gql.ID
+	AuthorID  gql.ID
+	Text      string
+	Rating    *int32
+}
+
+func (r *resolver) Series(
+	ctx context.Context,
+	args struct{ ID gql.ID },
+) (*seriesResolver, error) {
+	input := usecase.FindSeriesByIDInput{
+		ID:      domain.SeriesID(args.ID),
+		Include: []string{usecase.FindSeriesByIDIncludeReviewAuthors},
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.FindSeriesByID.Execute(ctx, input)
+	if errors.Is(err, domain.ErrSeriesNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, resolverErr(err)
+	}
+	var (
+		episodes, endYear int
+		creatorIDs        []string
+	)
+	if output.EndYear != nil {
+		endYear = *output.EndYear
+	}
+	if output.Episodes != nil {
+		episodes = *output.Episodes
+	}
+	if output.CreatorIDs != nil {
+		creatorIDs = *output.CreatorIDs
+	}
+	reviews := []*reviewResolver{}
+	if output.Reviews != nil {
+		for _, review := range *output.Reviews {
+			var rating int
+			if review.Rating != nil {
+				rating = *review.Rating
+			}
+			reviews = append(reviews, &reviewResolver{
+				review: usecase.FindReviewsBySeriesIDsReview{
+					ID:        review.ID,
+					SeriesID:  output.ID,
+					AuthorID:  review.AuthorID,
+					Text:      review.Text,
+					Rating:    rating,
+					Version:   review.Version,
+					CreatedAt: review.CreatedAt,
+					UpdatedAt: review.UpdatedAt,
+				},
+				author: review.Author,
+			})
+		}
+	}
+	return &seriesResolver{
+		id:            output.ID,
+		slug:          output.Slug,
+		title:         output.Title,
+		description:   output.Description,
+		episodes:      episodes,
+		beginYear:     output.BeginYear,
+		endYear:       endYear,
+		creator:       output.Creator,
+		creatorIDs:    creatorIDs,
+		status:        output.Status,
+		rating:        output.Rating,
+		episodeRating: output.EpisodeRating,
+		genres:        output.Genres,
+		tags:          output.Tags,
+		networkID:     output.NetworkID,
+		network:       output.Network,
+		country:       output.Country,
+		language:      output.Language,
+		version:       output.Version,
+		createdAt:     output.CreatedAt,
+		updatedAt:     output.UpdatedAt,
+		reviews:       &reviews,
+	}, nil
+}
+
+func (r *resolver) SearchSeries(
+	ctx context.Context,
+	args struct {
+		Title, Genre, Tag, Network, Country, Language, Status *string
+		Limit, Offset                                         *int32
+	},
+) ([]*seriesSummaryResolver, error) {
+	input := usecase.FindSeriesByTitleInput{
+		Query:    stringValue(args.Title),
+		Genre:    stringValue(args.Genre),
+		Tag:      stringValue(args.Tag),
+		Network:  stringValue(args.Network),
+		Country:  stringValue(args.Country),
+		Language: stringValue(args.Language),
+		Status:   stringValue(args.Status),
+		PageInput: usecase.PageInput{
+			Limit:  intValue(args.Limit),
+			Offset: intValue(args.Offset),
+		},
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.FindSeriesByTitle.Execute(ctx, input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	series := make([]*seriesSummaryResolver, len(output.Series))
+	for i, s := range output.Series {
+		series[i] = &seriesSummaryResolver{s}
+	}
+	return series, nil
+}
+
+func (r *resolver) CreateSeries(
+	ctx context.Context,
+	args struct{ Input seriesInput },
+) (*seriesResolver, error) {
+	input := usecase.CreateSeriesInput{
+		Title:       args.Input.Title,
+		Description: args.Input.Description,
+		BeginYear:   int(args.Input.BeginYear),
+		EndYear:     intValue(args.Input.EndYear),
+		CreatorIDs:  idValues(args.Input.CreatorIDs),
+		NetworkID:   idValue(args.Input.NetworkID),
+		Country:     stringValue(args.Input.Country),
+		Language:    stringValue(args.Input.Language),
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.CreateSeries.Execute(ctx, input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return &seriesResolver{
+		id:          output.ID,
+		slug:        output.Slug,
+		title:       output.Title,
+		description: output.Description,
+		episodes:    output.Episodes,
+		beginYear:   output.BeginYear,
+		endYear:     output.EndYear,
+		creator:     output.Creator,
+		creatorIDs:  output.CreatorIDs,
+		status:      output.Status,
+		networkID:   output.NetworkID,
+		network:     output.Network,
+		country:     output.Country,
+		language:    output.Language,
+		version:     output.Version,
+		createdAt:   output.CreatedAt,
+		updatedAt:   output.UpdatedAt,
+	}, nil
+}
+
+func (r *resolver) UpdateSeries(
+	ctx context.Context,
+	args struct {
+		ID      gql.ID
+		Version int32
+		Input   seriesInput
+	},
+) (*seriesResolver, error) {
+	input := usecase.UpdateSeriesInput{
+		ID:          string(args.ID),
+		Version:     int(args.Version),
+		Title:       args.Input.Title,
+		Description: args.Input.Description,
+		BeginYear:   int(args.Input.BeginYear),
+		EndYear:     intValue(args.Input.EndYear),
+		CreatorIDs:  idValues(args.Input.CreatorIDs),
+		NetworkID:   idValue(args.Input.NetworkID),
+		Country:     stringValue(args.Input.Country),
+		Language:    stringValue(args.Input.Language),
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.UpdateSeries.Execute(ctx, input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return &seriesResolver{
+		id:          output.ID,
+		slug:        output.Slug,
+		title:       output.Title,
+		description: output.Description,
+		episodes:    output.Episodes,
+		beginYear:   output.BeginYear,
+		endYear:     output.EndYear,
+		creator:     output.Creator,
+		creatorIDs:  output.CreatorIDs,
+		status:      output.Status,
+		networkID:   output.NetworkID,
+		network:     output.Network,
+		country:     output.Country,
+		language:    output.Language,
+		version:     output.Version,
+		createdAt:   output.CreatedAt,
+		updatedAt:   output.UpdatedAt,
+	}, nil
+}
+
+func (r *resolver) CreateReview(
+	ctx context.Context,
+	args struct{ Input createReviewInput },
+) (*reviewResolver, error) {
+	input := usecase.CreateReviewInput{
+		SeriesID: string(args.Input.SeriesID),
+		AuthorID: string(args.Input.AuthorID),
+		Text:     args.Input.Text,
+	}
+	if args.Input.EpisodeID != nil {
+		input.EpisodeID = string(*args.Input.EpisodeID)
+	}
+	if args.Input.Rating != nil {
+		input.Rating = int(*args.Input.Rating)
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.CreateReview.Execute(ctx, input)
+	if err != nil {
+		return nil, createReviewErr(err)
+	}
+	return &reviewResolver{review: usecase.FindReviewsBySeriesIDsReview(output)}, nil
+}
+
+func (r *resolver) UpdateReview(
+	ctx context.Context,
+	args struct {
+		ID      gql.ID
+		Version int32
+		Text    string
+		Rating  *int32
+	},
+) (*reviewResolver, error) {
+	input := usecase.UpdateReviewInput{
+		ID:      string(args.ID),
+		Version: int(args.Version),
+		Text:    args.Text,
+	}
+	if args.Rating != nil {
+		rating := int(*args.Rating)
+		input.Rating = &rating
+	}
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	output, err := r.uc.UpdateReview.Execute(ctx, input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return &reviewResolver{review: usecase.FindReviewsBySeriesIDsReview(output)}, nil
+}
+
+func (r *resolver) validate(input any) error {
+	if err := r.validator.Validate(input); err != nil {
+		return newError(codeBadUserInput, r.validator.Messages(err)...)
+	}
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func idValue(id *gql.ID) string {
+	if id == nil {
+		return ""
+	}
+	return string(*id)
+}
+
+func idValues(ids []gql.ID) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = string(id)
+	}
+	return values
+}
+
+func intValue(i *int32) int {
+	if i == nil {
+		return 0
+	}
+	return int(*i)
+}
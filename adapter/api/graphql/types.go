@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"context"
+	"series/usecase"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// seriesResolver has the rating, episodeRating, genres, tags and
+// reviews of the series when it was found by its ID. Mutations don't
+// return them, their reviews are loaded instead.
+type seriesResolver struct {
+	id            string
+	slug          string
+	title         string
+	description   string
+	episodes      int
+	beginYear     int
+	endYear       int
+	creator       string
+	creatorIDs    []string
+	status        string
+	rating        *usecase.RatingOutput
+	episodeRating *usecase.RatingOutput
+	genres        *[]string
+	tags          *[]string
+	networkID     string
+	network       string
+	country       string
+	language      string
+	version       int
+	createdAt     string
+	updatedAt     string
+	reviews       *[]*reviewResolver
+}
+
+func (s *seriesResolver) ID() gql.ID          { return gql.ID(s.id) }
+func (s *seriesResolver) Slug() string        { return s.slug }
+func (s *seriesResolver) Title() string       { return s.title }
+func (s *seriesResolver) Description() string { return s.description }
+func (s *seriesResolver) Episodes() int32     { return int32(s.episodes) }
+func (s *seriesResolver) BeginYear() int32    { return int32(s.beginYear) }
+func (s *seriesResolver) EndYear() int32      { return int32(s.endYear) }
+func (s *seriesResolver) Creator() string     { return s.creator }
+func (s *seriesResolver) Status() string      { return s.status }
+func (s *seriesResolver) Version() int32      { return int32(s.version) }
+func (s *seriesResolver) CreatedAt() string   { return s.createdAt }
+func (s *seriesResolver) UpdatedAt() string   { return s.updatedAt }
+
+func (s *seriesResolver) CreatorIDs() []gql.ID {
+	ids := make([]gql.ID, len(s.creatorIDs))
+	for i, id := range s.creatorIDs {
+		ids[i] = gql.ID(id)
+	}
+	return ids
+}
+
+func (s *seriesResolver) Rating() *ratingResolver        { return optionalRating(s.rating) }
+func (s *seriesResolver) EpisodeRating() *ratingResolver { return optionalRating(s.episodeRating) }
+func (s *seriesResolver) Genres() *[]string              { return s.genres }
+func (s *seriesResolver) Tags() *[]string                { return s.tags }
+
+func (s *seriesResolver) NetworkID() *gql.ID { return optionalID(s.networkID) }
+func (s *seriesResolver) Network() *string   { return optionalString(s.network) }
+func (s *seriesResolver) Country() *string   { return optionalString(s.country) }
+func (s *seriesResolver) Language() *string  { return optionalString(s.language) }
+
+func (s *seriesResolver) Reviews(ctx context.Context) ([]*reviewResolver, error) {
+	if s.reviews != nil {
+		return *s.reviews, nil
+	}
+	return loadReviews(ctx, s.id)
+}
+
+type ratingResolver struct {
+	rating usecase.RatingOutput
+}
+
+func (r *ratingResolver) Average() float64 { return r.rating.Average }
+func (r *ratingResolver) Count() int32     { return int32(r.rating.Count) }
+
+func optionalRating(rating *usecase.RatingOutput) *ratingResolver {
+	if rating == nil {
+		return nil
+	}
+	return &ratingResolver{*rating}
+}
+
+type seriesSummaryResolver struct {
+	series usecase.FindSeriesByTitleSeries
+}
+
+func (s *seriesSummaryResolver) ID() gql.ID        { return gql.ID(s.series.ID) }
+func (s *seriesSummaryResolver) Slug() string      { return s.series.Slug }
+func (s *seriesSummaryResolver) Title() string     { return s.series.Title }
+func (s *seriesSummaryResolver) BeginYear() int32  { return int32(s.series.BeginYear) }
+func (s *seriesSummaryResolver) EndYear() int32    { return int32(s.series.EndYear) }
+func (s *seriesSummaryResolver) Creator() string   { return s.series.Creator }
+func (s *seriesSummaryResolver) Status() string    { return s.series.Status }
+func (s *seriesSummaryResolver) Genres() []string  { return s.series.Genres }
+func (s *seriesSummaryResolver) Tags() []string    { return s.series.Tags }
+func (s *seriesSummaryResolver) CreatedAt() string { return s.series.CreatedAt }
+func (s *seriesSummaryResolver) UpdatedAt() string { return s.series.UpdatedAt }
+
+func (s *seriesSummaryResolver) NetworkID() *gql.ID    { return optionalID(s.series.NetworkID) }
+func (s *seriesSummaryResolver) Network() *string      { return optionalString(s.series.Network) }
+func (s *seriesSummaryResolver) Country() *string      { return optionalString(s.series.Country) }
+func (s *seriesSummaryResolver) Language() *string     { return optionalString(s.series.Language) }
+func (s *seriesSummaryResolver) MatchedAlias() *string { return optionalString(s.series.MatchedAlias) }
+
+func (s *seriesSummaryResolver) Reviews(ctx context.Context) ([]*reviewResolver, error) {
+	return loadReviews(ctx, s.series.ID)
+}
+
+type reviewResolver struct {
+	review usecase.FindReviewsBySeriesIDsReview
+	author *usecase.FindSeriesByIDAuthor
+}
+
+func (r *reviewResolver) ID() gql.ID        { return gql.ID(r.review.ID) }
+func (r *reviewResolver) SeriesID() gql.ID  { return gql.ID(r.review.SeriesID) }
+func (r *reviewResolver) AuthorID() gql.ID  { return gql.ID(r.review.AuthorID) }
+func (r *reviewResolver) Text() string      { return r.review.Text }
+func (r *reviewResolver) Version() int32    { return int32(r.review.Version) }
+func (r *reviewResolver) CreatedAt() string { return r.review.CreatedAt }
+func (r *reviewResolver) UpdatedAt() string { return r.review.UpdatedAt }
+
+func (r *reviewResolver) EpisodeID() *gql.ID {
+	return optionalID(r.review.EpisodeID)
+}
+
+func (r *reviewResolver) Author() *authorResolver {
+	if r.author == nil {
+		return nil
+	}
+	return &authorResolver{*r.author}
+}
+
+func (r *reviewResolver) Rating() *int32 {
+	if r.review.Rating == 0 {
+		return nil
+	}
+	rating := int32(r.review.Rating)
+	return &rating
+}
+
+type authorResolver struct {
+	author usecase.FindSeriesByIDAuthor
+}
+
+func (a *authorResolver) ID() gql.ID     { return gql.ID(a.author.ID) }
+func (a *authorResolver) Reviews() int32 { return int32(a.author.Reviews) }
+
+// optionalID and optionalString resolve the empty string,
+// an unknown value, to null.
+func optionalID(id string) *gql.ID {
+	if id == "" {
+		return nil
+	}
+	gid := gql.ID(id)
+	return &gid
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
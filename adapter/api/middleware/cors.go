@@ -6,6 +6,26 @@ import (
 	"github.com/rs/cors"
 )
 
+var corsOptions = cors.Options{
+	AllowedOrigins: []string{"*"},
+	AllowedMethods: []string{
+		http.MethodHead,
+		http.MethodGet,
+		http.MethodPost,
+		http.MethodPut,
+		http.MethodDelete,
+	},
+	AllowedHeaders: []string{
+		"Origin",
+		"Accept",
+		"Content-Type",
+		"X-Requested-With",
+		"If-Match",
+		HeaderRequestID,
+	},
+	ExposedHeaders: []string{"ETag", HeaderRequestID},
+}
+
 func CORS(next http.Handler) http.Handler {
-	return cors.Default().Handler(next)
+	return cors.New(corsOptions).Handler(next)
 }
@@ -1,71 +1,56 @@
 package middleware
 
 import (
-	"bytes"
-	"io"
 	"net/http"
 	"series/adapter/logger"
 	"time"
 )
 
-type loggedResponseWriter struct {
+type statusResponseWriter struct {
+	http.ResponseWriter
 	code int
-	w    http.ResponseWriter
-	body *bytes.Buffer
-	mw   io.Writer
 }
 
-func (l *loggedResponseWriter) Write(b []byte) (int, error) {
-	return l.mw.Write(b)
+func (s *statusResponseWriter) WriteHeader(code int) {
+	s.code = code
+	s.ResponseWriter.WriteHeader(code)
 }
 
-func (l *loggedResponseWriter) WriteHeader(code int) {
-	l.code = code
-	l.w.WriteHeader(code)
-}
-
-func (l *loggedResponseWriter) Header() http.Header {
-	return l.w.Header()
-}
-
-func newLoggedResponseWriter(w http.ResponseWriter) *loggedResponseWriter {
-	buff := &bytes.Buffer{}
-	return &loggedResponseWriter{
-		w:    w,
-		body: buff,
-		mw:   io.MultiWriter(w, buff),
+func (s *statusResponseWriter) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
 	}
 }
 
-func Logging(log logger.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
-			log.Infof("%s Method: %s Path: %s", start.Format(time.RFC822), r.Method, r.URL)
-
-			lw := newLoggedResponseWriter(w)
-			next.ServeHTTP(lw, r)
+func newStatusResponseWriter(w http.ResponseWriter) *statusResponseWriter {
+	return &statusResponseWriter{ResponseWriter: w, code: http.StatusOK}
+}
 
-			end := time.Now()
+// Logging logs every request once it is served, with the logger
+// of the request context set by RequestID.
+func Logging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
 
-			var logFn func(string, ...any)
-			switch {
-			case lw.code >= http.StatusInternalServerError:
-				logFn = log.Errorf
-			case lw.code >= http.StatusBadRequest:
-				logFn = log.Warnf
-			default:
-				logFn = log.Infof
-			}
+		sw := newStatusResponseWriter(w)
+		next.ServeHTTP(sw, r)
 
-			logFn(
-				"%s Method: %s Path: %s Status: %s Elapsed time: %s",
-				end.Format(time.RFC822),
-				r.Method,
-				r.URL,
-				http.StatusText(lw.code),
-				end.Sub(start),
-			)
+		log := logger.FromContext(r.Context()).WithFields(logger.Fields{
+			"method":     r.Method,
+			"path":       r.URL.Path,
+			"status":     sw.code,
+			"elapsed_ms": time.Since(start).Milliseconds(),
 		})
-	}
+
+		var logFn func(string, ...any)
+		switch {
+		case sw.code >= http.StatusInternalServerError:
+			logFn = log.Errorf
+		case sw.code >= http.StatusBadRequest:
+			logFn = log.Warnf
+		default:
+			logFn = log.Infof
+		}
+		logFn("%s %s %s", r.Method, r.URL.Path, http.StatusText(sw.code))
+	})
 }
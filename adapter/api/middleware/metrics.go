@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+	"series/adapter/metrics"
+	"time"
+)
+
+// Metrics observes the requests served by next. route returns the template
+// of the route a request matched, or "" when it matched none.
+func Metrics(
+	m metrics.Metrics,
+	route func(*http.Request) string,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			sw := newStatusResponseWriter(w)
+			next.ServeHTTP(sw, r)
+
+			m.ObserveRequest(r.Method, route(r), sw.code, time.Since(start))
+		})
+	}
+}
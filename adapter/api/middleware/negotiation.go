@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"net/http"
+	"series/adapter/api/response"
+)
+
+// Negotiation rejects requests whose Accept header matches no registered
+// encoder before any action runs, so nothing is created on the way to a 406.
+func Negotiation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := response.Negotiate(r.Header.Get("Accept")); err != nil {
+			response.NewError(http.StatusNotAcceptable, err.Error()).Send(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"series/adapter/api/response"
+	"series/adapter/logger"
+	"series/adapter/metrics"
+	"series/adapter/reporter"
+	"time"
+)
+
+// recoveryResponseWriter tells whether the response has started, once it
+// has a panic can't be turned into a response anymore.
+type recoveryResponseWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (s *recoveryResponseWriter) WriteHeader(code int) {
+	s.started = true
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *recoveryResponseWriter) Write(b []byte) (int, error) {
+	s.started = true
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *recoveryResponseWriter) Flush() {
+	s.started = true
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Recovery turns a panic of next into a 500 problem response. The panic is
+// logged with its stack by the logger of the request context, counted and
+// reported. When the response has already started, the connection is
+// aborted instead, as net/http does, so clients don't take a truncated
+// response for a complete one. route returns the template of the route a
+// request matched, or "" when it matched none.
+func Recovery(
+	m metrics.Metrics,
+	r reporter.ErrorReporter,
+	route func(*http.Request) string,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			rw := &recoveryResponseWriter{ResponseWriter: w}
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				// Handlers panic with it on purpose to abort the response.
+				if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(rec)
+				}
+
+				ctx := req.Context()
+				crash := reporter.Crash{
+					Time:      time.Now(),
+					RequestID: RequestIDFromContext(ctx),
+					Method:    req.Method,
+					Path:      req.URL.Path,
+					Panic:     fmt.Sprint(rec),
+					Stack:     string(debug.Stack()),
+				}
+
+				log := logger.FromContext(ctx)
+				log.WithFields(logger.Fields{
+					"panic": crash.Panic,
+					"stack": crash.Stack,
+				}).Errorf("Recovered from a panic serving %s %s", req.Method, req.URL.Path)
+				m.IncPanic(route(req))
+				if err := r.Report(ctx, crash); err != nil {
+					log.WithFields(logger.Fields{"error": err}).
+						Errorf("Failed to report a crash")
+				}
+
+				if rw.started {
+					panic(http.ErrAbortHandler)
+				}
+				_ = response.NewProblem(
+					http.StatusInternalServerError,
+					"The server failed to serve the request.",
+				).WithRequestID(crash.RequestID).Send(w, req)
+			}()
+			next.ServeHTTP(rw, req)
+		})
+	}
+}
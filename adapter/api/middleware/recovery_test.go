@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"series/adapter/metrics"
+	"series/adapter/reporter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMetrics struct {
+	metrics.Metrics
+	panics *[]string
+}
+
+func (m mockMetrics) IncPanic(route string) {
+	*m.panics = append(*m.panics, route)
+}
+
+type mockReporter struct {
+	crashes *[]reporter.Crash
+	err     error
+}
+
+func (r mockReporter) Report(_ context.Context, crash reporter.Crash) error {
+	*r.crashes = append(*r.crashes, crash)
+	return r.err
+}
+
+func TestRecovery(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Handler     http.HandlerFunc
+		ReportErr   error
+		// Aborted is true when the response can't be replaced anymore.
+		Aborted      bool
+		ExpectedCode int
+	}
+	tests := []Test{
+		{
+			Description: "Panic turns into a problem",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				panic("Panic")
+			},
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		{
+			Description: "Panic turns into a problem when reporting fails",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				panic(errors.New("Panic"))
+			},
+			ReportErr:    errors.New("Error"),
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		{
+			Description: "Panic after the response started aborts it",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte("data: {}\n\n"))
+				panic("Panic")
+			},
+			Aborted:      true,
+			ExpectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			panics := []string{}
+			crashes := []reporter.Crash{}
+			handler := Recovery(
+				mockMetrics{panics: &panics},
+				mockReporter{crashes: &crashes, err: test.ReportErr},
+				func(*http.Request) string { return "/v1/series/{id}" },
+			)(test.Handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/series/1", nil)
+			req = req.WithContext(NewRequestIDContext(req.Context(), "RequestID"))
+			rec := httptest.NewRecorder()
+			if test.Aborted {
+				assert.PanicsWithValue(http.ErrAbortHandler, func() {
+					handler.ServeHTTP(rec, req)
+				})
+			} else {
+				handler.ServeHTTP(rec, req)
+				assert.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+				assert.Contains(rec.Body.String(), `"request_id":"RequestID"`)
+			}
+
+			assert.Equal(test.ExpectedCode, rec.Code)
+			assert.Equal([]string{"/v1/series/{id}"}, panics)
+			assert.Len(crashes, 1)
+			assert.Equal("RequestID", crashes[0].RequestID)
+			assert.Equal("Panic", crashes[0].Panic)
+			assert.Equal("/v1/series/1", crashes[0].Path)
+			assert.Contains(crashes[0].Stack, "TestRecovery")
+		})
+	}
+
+	t.Run("Aborted handler is not recovered", func(t *testing.T) {
+		panics := []string{}
+		crashes := []reporter.Crash{}
+		handler := Recovery(
+			mockMetrics{panics: &panics},
+			mockReporter{crashes: &crashes},
+			func(*http.Request) string { return "" },
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/series/1", nil)
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		})
+		assert.Empty(t, panics)
+		assert.Empty(t, crashes)
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"series/adapter/logger"
+	"series/domain"
+)
+
+const (
+	HeaderRequestID = "X-Request-ID"
+
+	// maxRequestIDLength bounds the ids taken from clients, which end
+	// up in every log entry of their requests.
+	maxRequestIDLength = 128
+)
+
+type ctxKeyRequestID struct{}
+
+// RequestID identifies every request with the X-Request-ID header it came
+// with, or a new UUID, and echoes it in the response. The request context
+// carries the id, see RequestIDFromContext, and a logger adding it to the
+// entries, see logger.FromContext.
+func RequestID(log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(HeaderRequestID)
+			if !IsValidRequestID(id) {
+				id = domain.NewUUID()
+			}
+			w.Header().Set(HeaderRequestID, id)
+
+			ctx := NewRequestIDContext(r.Context(), id)
+			ctx = logger.NewContext(
+				ctx,
+				log.WithFields(logger.Fields{"request_id": id}),
+			)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// NewRequestIDContext returns a copy of ctx carrying the request id.
+func NewRequestIDContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKeyRequestID{}, id)
+}
+
+// RequestIDFromContext returns the id RequestID gave to the request
+// served with ctx, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKeyRequestID{}).(string)
+	return id
+}
+
+// IsValidRequestID reports whether a request id sent by a client is kept.
+func IsValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		// Printable ASCII but the space.
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
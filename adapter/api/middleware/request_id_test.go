@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLogger struct {
+	logger.Logger
+	fields logger.Fields
+}
+
+func (l mockLogger) WithFields(fields logger.Fields) logger.Logger {
+	merged := logger.Fields{}
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return mockLogger{fields: merged}
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Header      string
+		// Generated is true when the id of the client is replaced.
+		Generated bool
+	}
+	tests := []Test{
+		{
+			Description: "Id of the client is kept",
+			Header:      "f7a8c1d2-upstream",
+			Generated:   false,
+		},
+		{
+			Description: "Missing id is generated",
+			Header:      "",
+			Generated:   true,
+		},
+		{
+			Description: "Id with spaces is replaced",
+			Header:      "not an id",
+			Generated:   true,
+		},
+		{
+			Description: "Id that is too long is replaced",
+			Header:      strings.Repeat("a", maxRequestIDLength+1),
+			Generated:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var (
+				fields logger.Fields
+				ctxID  string
+			)
+			handler := RequestID(mockLogger{})(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					fields = logger.FromContext(r.Context()).(mockLogger).fields
+					ctxID = RequestIDFromContext(r.Context())
+				},
+			))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/series", nil)
+			if test.Header != "" {
+				req.Header.Set(HeaderRequestID, test.Header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			id := rec.Header().Get(HeaderRequestID)
+			if test.Generated {
+				assert.True(domain.IsValidUUID(id))
+			} else {
+				assert.Equal(test.Header, id)
+			}
+			assert.Equal(logger.Fields{"request_id": id}, fields)
+			assert.Equal(id, ctxID)
+		})
+	}
+}
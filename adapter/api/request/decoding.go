@@ -0,0 +1,71 @@
+package request
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+var ErrUnsupportedMediaType = errors.New("unsupported request content type")
+
+type Decoder interface {
+	Decode(r io.Reader, v any) error
+}
+
+type DecoderFunc func(r io.Reader, v any) error
+
+func (f DecoderFunc) Decode(r io.Reader, v any) error {
+	return f(r, v)
+}
+
+var decoders = map[string]Decoder{
+	"application/json":        DecoderFunc(decodeJSON),
+	"application/xml":         DecoderFunc(decodeXML),
+	"text/xml":                DecoderFunc(decodeXML),
+	"application/msgpack":     DecoderFunc(decodeMsgPack),
+	"application/x-msgpack":   DecoderFunc(decodeMsgPack),
+	"application/vnd.msgpack": DecoderFunc(decodeMsgPack),
+}
+
+// RegisterDecoder makes a request body format available to Decode.
+// It is not safe to call concurrently with request handling.
+func RegisterDecoder(mediaType string, decoder Decoder) {
+	decoders[mediaType] = decoder
+}
+
+// Decode reads the request body into v using the decoder registered for
+// its Content-Type. Requests without Content-Type are decoded as JSON.
+func Decode(r *http.Request, v any) error {
+	mediaType := "application/json"
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		var err error
+		mediaType, _, err = mime.ParseMediaType(contentType)
+		if err != nil {
+			return ErrUnsupportedMediaType
+		}
+	}
+	decoder, ok := decoders[mediaType]
+	if !ok {
+		return ErrUnsupportedMediaType
+	}
+	return decoder.Decode(r.Body, v)
+}
+
+func decodeJSON(r io.Reader, v any) error {
+	return json.NewDecoder(r).Decode(v)
+}
+
+func decodeXML(r io.Reader, v any) error {
+	return xml.NewDecoder(r).Decode(v)
+}
+
+func decodeMsgPack(r io.Reader, v any) error {
+	dec := msgpack.NewDecoder(r)
+	dec.SetCustomStructTag("json")
+	return dec.Decode(v)
+}
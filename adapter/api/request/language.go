@@ -0,0 +1,53 @@
+package request
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Languages returns the languages of the Accept-Language header of the
+// request, as ISO 639-1 codes in order of preference. Regional variants
+// are reduced to their language, "pt-BR" is "pt", and the "*" wildcard
+// is left out since any language is already the fallback.
+func Languages(r *http.Request) []string {
+	type acceptedLanguage struct {
+		language string
+		q        float64
+	}
+	var accepted []acceptedLanguage
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		language, _, _ := strings.Cut(tag, "-")
+		if language == "" || language == "*" {
+			continue
+		}
+		q := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			var err error
+			if q, err = strconv.ParseFloat(strings.TrimPrefix(params, "q="), 64); err != nil {
+				continue
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptedLanguage{language, q})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool {
+		return accepted[i].q > accepted[j].q
+	})
+
+	seen := map[string]bool{}
+	languages := []string{}
+	for _, a := range accepted {
+		if seen[a.language] {
+			continue
+		}
+		seen[a.language] = true
+		languages = append(languages, a.language)
+	}
+	return languages
+}
@@ -0,0 +1,55 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguages(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description    string
+		AcceptLanguage string
+		Expected       []string
+	}
+	tests := []Test{
+		{
+			Description:    "No header",
+			AcceptLanguage: "",
+			Expected:       []string{},
+		},
+		{
+			Description:    "Single language",
+			AcceptLanguage: "fr",
+			Expected:       []string{"fr"},
+		},
+		{
+			Description:    "Ordered by quality",
+			AcceptLanguage: "en;q=0.5, pt-BR, de;q=0.8",
+			Expected:       []string{"pt", "de", "en"},
+		},
+		{
+			Description:    "Regional variants reduced to their language once",
+			AcceptLanguage: "fr-CH, fr;q=0.9, EN-us;q=0.8",
+			Expected:       []string{"fr", "en"},
+		},
+		{
+			Description:    "Wildcard, refused and malformed languages left out",
+			AcceptLanguage: "*, es;q=0, it;q=high, nl;q=0.1",
+			Expected:       []string{"nl"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			assert.Nil(err)
+			req.Header.Set("Accept-Language", test.AcceptLanguage)
+			assert.Equal(test.Expected, Languages(req))
+		})
+	}
+}
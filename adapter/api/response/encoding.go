@@ -0,0 +1,264 @@
+package response
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	MediaTypeJSON    = "application/json"
+	MediaTypeXML     = "application/xml"
+	MediaTypeCSV     = "text/csv"
+	MediaTypeMsgPack = "application/msgpack"
+
+	MediaTypeEventStream = "text/event-stream"
+)
+
+var ErrNotAcceptable = errors.New("none of the accepted media types is supported")
+
+type Encoder interface {
+	Encode(w io.Writer, v any) error
+}
+
+type EncoderFunc func(w io.Writer, v any) error
+
+func (f EncoderFunc) Encode(w io.Writer, v any) error {
+	return f(w, v)
+}
+
+type registeredEncoder struct {
+	mediaType string
+	encoder   Encoder
+}
+
+// encoders is ordered by preference, the first one is used
+// when the client accepts any media type.
+var encoders = []registeredEncoder{
+	{MediaTypeJSON, EncoderFunc(encodeJSON)},
+	{MediaTypeHAL, EncoderFunc(encodeJSON)},
+	{MediaTypeXML, EncoderFunc(encodeXML)},
+	{"text/xml", EncoderFunc(encodeXML)},
+	{MediaTypeCSV, EncoderFunc(encodeCSV)},
+	{MediaTypeMsgPack, EncoderFunc(encodeMsgPack)},
+	{"application/x-msgpack", EncoderFunc(encodeMsgPack)},
+	{"application/vnd.msgpack", EncoderFunc(encodeMsgPack)},
+	{MediaTypeEventStream, EncoderFunc(encodeEvent)},
+}
+
+// RegisterEncoder makes an encoder available for content negotiation.
+// It is not safe to call concurrently with request handling.
+func RegisterEncoder(mediaType string, encoder Encoder) {
+	for i, e := range encoders {
+		if e.mediaType == mediaType {
+			encoders[i].encoder = encoder
+			return
+		}
+	}
+	encoders = append(encoders, registeredEncoder{mediaType, encoder})
+}
+
+// Negotiate picks the encoder for the value of an Accept header.
+// An empty header is treated as "*/*".
+func Negotiate(accept string) (string, Encoder, error) {
+	if strings.TrimSpace(accept) == "" {
+		accept = "*/*"
+	}
+
+	type acceptedRange struct {
+		mediaType string
+		q         float64
+	}
+	var ranges []acceptedRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		ranges = append(ranges, acceptedRange{mediaType, q})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].q > ranges[j].q
+	})
+
+	for _, r := range ranges {
+		for _, e := range encoders {
+			if matchMediaType(r.mediaType, e.mediaType) {
+				return e.mediaType, e.encoder, nil
+			}
+		}
+	}
+	return "", nil, ErrNotAcceptable
+}
+
+func matchMediaType(mediaRange, mediaType string) bool {
+	if mediaRange == "*/*" || mediaRange == mediaType {
+		return true
+	}
+	if strings.HasSuffix(mediaRange, "/*") {
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*"))
+	}
+	return false
+}
+
+func encodeJSON(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+func encodeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).EncodeElement(v, xml.StartElement{
+		Name: xml.Name{Local: "response"},
+	})
+}
+
+func encodeMsgPack(w io.Writer, v any) error {
+	enc := msgpack.NewEncoder(w)
+	enc.SetCustomStructTag("json")
+	return enc.Encode(v)
+}
+
+// encodeEvent writes v as a single server-sent event, it is used for
+// responses that are not a stream, like errors of streaming endpoints.
+func encodeEvent(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+// encodeCSV writes list outputs as a table. A struct holding a single
+// slice is written as one row per element, any other struct as one row.
+// Nested slices and structs are not representable and are skipped.
+func encodeCSV(w io.Writer, v any) error {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: unsupported value of type %T", v)
+	}
+
+	rows, header := []reflect.Value{value}, csvHeader(value.Type())
+	if field, name, ok := singleSliceField(value); ok {
+		rows = make([]reflect.Value, field.Len())
+		for i := range rows {
+			rows[i] = reflect.Indirect(field.Index(i))
+		}
+		elem := field.Type().Elem()
+		if elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct {
+			header = csvHeader(elem)
+		} else {
+			header = []string{name}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := cw.Write(csvRecord(row)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func singleSliceField(value reflect.Value) (reflect.Value, string, bool) {
+	var (
+		found reflect.Value
+		name  string
+		count int
+	)
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() || fieldName(field) == "-" {
+			continue
+		}
+		count++
+		if value.Field(i).Kind() == reflect.Slice {
+			found, name = value.Field(i), fieldName(field)
+		}
+	}
+	return found, name, count == 1 && found.IsValid()
+}
+
+func csvColumns(t reflect.Type) []int {
+	var columns []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || fieldName(field) == "-" {
+			continue
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		switch fieldType.Kind() {
+		case reflect.Slice, reflect.Struct, reflect.Map, reflect.Pointer:
+			continue
+		}
+		columns = append(columns, i)
+	}
+	return columns
+}
+
+func csvHeader(t reflect.Type) []string {
+	var header []string
+	for _, i := range csvColumns(t) {
+		header = append(header, fieldName(t.Field(i)))
+	}
+	return header
+}
+
+func csvRecord(value reflect.Value) []string {
+	if value.Kind() != reflect.Struct {
+		return []string{fmt.Sprint(value.Interface())}
+	}
+	var record []string
+	for _, i := range csvColumns(value.Type()) {
+		field := value.Field(i)
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				record = append(record, "")
+				continue
+			}
+			field = field.Elem()
+		}
+		record = append(record, fmt.Sprint(field.Interface()))
+	}
+	return record
+}
+
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,130 @@
+package response
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Accept      string
+		Expected    string
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Missing header defaults to JSON",
+			Accept:      "",
+			Expected:    MediaTypeJSON,
+		},
+		{
+			Description: "Wildcard defaults to JSON",
+			Accept:      "*/*",
+			Expected:    MediaTypeJSON,
+		},
+		{
+			Description: "Exact match",
+			Accept:      "text/csv",
+			Expected:    MediaTypeCSV,
+		},
+		{
+			Description: "Highest quality wins",
+			Accept:      "application/json;q=0.5, application/xml",
+			Expected:    MediaTypeXML,
+		},
+		{
+			Description: "Type wildcard",
+			Accept:      "text/html, text/*;q=0.8",
+			Expected:    "text/xml",
+		},
+		{
+			Description: "Unsupported types are skipped",
+			Accept:      "text/html, application/msgpack;q=0.1",
+			Expected:    MediaTypeMsgPack,
+		},
+		{
+			Description: "Nothing acceptable",
+			Accept:      "text/html, application/json;q=0",
+			ExpectedErr: ErrNotAcceptable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			got, _, err := Negotiate(test.Accept)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
+
+func TestSuccessSend(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		ID    string `json:"id"    xml:"id"`
+		Title string `json:"title" xml:"title"`
+	}
+	type List struct {
+		Items []Item `json:"items" xml:"items>item"`
+	}
+	result := List{
+		Items: []Item{
+			{ID: "1", Title: "Title, with comma"},
+			{ID: "2", Title: "Title"},
+		},
+	}
+
+	type Test struct {
+		Description         string
+		Accept              string
+		ExpectedContentType string
+		ExpectedBody        string
+	}
+	tests := []Test{
+		{
+			Description:         "JSON",
+			Accept:              "application/json",
+			ExpectedContentType: MediaTypeJSON,
+			ExpectedBody: `{"items":[{"id":"1","title":"Title, with comma"},` +
+				`{"id":"2","title":"Title"}]}` + "\n",
+		},
+		{
+			Description:         "XML",
+			Accept:              "application/xml",
+			ExpectedContentType: MediaTypeXML,
+			ExpectedBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<response><items><item><id>1</id><title>Title, with comma</title></item>` +
+				`<item><id>2</id><title>Title</title></item></items></response>`,
+		},
+		{
+			Description:         "CSV",
+			Accept:              "text/csv",
+			ExpectedContentType: MediaTypeCSV,
+			ExpectedBody:        "id,title\n1,\"Title, with comma\"\n2,Title\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", bytes.NewReader(nil))
+			assert.Nil(err)
+			req.Header.Set("Accept", test.Accept)
+			recorder := httptest.NewRecorder()
+
+			assert.Nil(NewSuccess(http.StatusOK, result).Send(recorder, req))
+			assert.Equal(test.ExpectedContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(test.ExpectedBody, recorder.Body.String())
+		})
+	}
+}
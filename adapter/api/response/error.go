@@ -1,7 +1,6 @@
 package response
 
 import (
-	"encoding/json"
 	"net/http"
 )
 
@@ -10,6 +9,10 @@ type Error struct {
 	errors     []string
 }
 
+type errorBody struct {
+	Errors []string `json:"errors" xml:"error"`
+}
+
 func NewError(code int, errors ...string) *Error {
 	return &Error{
 		statusCode: code,
@@ -17,15 +20,12 @@ func NewError(code int, errors ...string) *Error {
 	}
 }
 
-func (e *Error) Send(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (e *Error) Send(w http.ResponseWriter, r *http.Request) error {
+	mediaType, encoder := encoderFor(r)
+	w.Header().Set("Content-Type", mediaType)
 	w.WriteHeader(e.statusCode)
 	if len(e.errors) == 0 {
 		return nil
 	}
-	return json.NewEncoder(w).Encode(
-		map[string][]string{
-			"errors": e.errors,
-		},
-	)
+	return encoder.Encode(w, errorBody{Errors: e.errors})
 }
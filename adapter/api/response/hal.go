@@ -0,0 +1,36 @@
+package response
+
+import "encoding/json"
+
+const MediaTypeHAL = "application/hal+json"
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+// Links maps a relation name such as "self" to its target.
+type Links map[string]Link
+
+// WithLinks attaches hypermedia links to the result. They are only
+// written when the client negotiated application/hal+json.
+func (s *Success) WithLinks(links Links) *Success {
+	s.links = links
+	return s
+}
+
+// withLinks adds links to the JSON object result as "_links".
+// Results that don't encode to an object are returned unchanged.
+func withLinks(result any, links Links) (any, error) {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &document); err != nil || document == nil {
+		return result, nil
+	}
+	if document["_links"], err = json.Marshal(links); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
@@ -0,0 +1,77 @@
+package response
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuccessWithLinks(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		ID string `json:"id" xml:"id"`
+	}
+	links := Links{
+		"self":    {Href: "/v1/series/1"},
+		"reviews": {Href: "/v1/series/1/reviews"},
+	}
+
+	type Test struct {
+		Description         string
+		Accept              string
+		Result              any
+		ExpectedContentType string
+		ExpectedBody        string
+	}
+	tests := []Test{
+		{
+			Description:         "HAL adds links",
+			Accept:              "application/hal+json",
+			Result:              Item{ID: "1"},
+			ExpectedContentType: MediaTypeHAL,
+			ExpectedBody: `{"_links":{"reviews":{"href":"/v1/series/1/reviews"},` +
+				`"self":{"href":"/v1/series/1"}},"id":"1"}` + "\n",
+		},
+		{
+			Description:         "Plain JSON leaves links out",
+			Accept:              "application/json",
+			Result:              Item{ID: "1"},
+			ExpectedContentType: MediaTypeJSON,
+			ExpectedBody:        `{"id":"1"}` + "\n",
+		},
+		{
+			Description:         "Wildcard prefers plain JSON",
+			Accept:              "application/*",
+			Result:              Item{ID: "1"},
+			ExpectedContentType: MediaTypeJSON,
+			ExpectedBody:        `{"id":"1"}` + "\n",
+		},
+		{
+			Description:         "Results that are not objects are unchanged",
+			Accept:              "application/hal+json",
+			Result:              []Item{{ID: "1"}},
+			ExpectedContentType: MediaTypeHAL,
+			ExpectedBody:        `[{"id":"1"}]` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			req, err := http.NewRequest(http.MethodGet, "", bytes.NewReader(nil))
+			assert.Nil(err)
+			req.Header.Set("Accept", test.Accept)
+			recorder := httptest.NewRecorder()
+
+			res := NewSuccess(http.StatusOK, test.Result).WithLinks(links)
+			assert.Nil(res.Send(recorder, req))
+			assert.Equal(test.ExpectedContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(test.ExpectedBody, recorder.Body.String())
+		})
+	}
+}
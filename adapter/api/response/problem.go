@@ -0,0 +1,44 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const MediaTypeProblem = "application/problem+json"
+
+// Problem is an RFC 7807 problem details response. It is always sent as
+// JSON, as it is meant for failures that happen before or after content
+// negotiation, like a panic.
+type Problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func NewProblem(code int, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(code),
+		Status: code,
+		Detail: detail,
+	}
+}
+
+// WithRequestID lets clients quote the request when reporting the problem.
+func (p *Problem) WithRequestID(id string) *Problem {
+	p.RequestID = id
+	return p
+}
+
+func (p *Problem) Send(w http.ResponseWriter, r *http.Request) error {
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", MediaTypeProblem)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblem(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	req := httptest.NewRequest(http.MethodGet, "/v1/series/1", nil)
+	req.Header.Set("Accept", MediaTypeXML)
+	rec := httptest.NewRecorder()
+
+	err := NewProblem(http.StatusInternalServerError, "The server failed to serve the request.").
+		WithRequestID("RequestID").
+		Send(rec, req)
+	assert.Nil(err)
+	assert.Equal(http.StatusInternalServerError, rec.Code)
+	assert.Equal(MediaTypeProblem, rec.Header().Get("Content-Type"))
+	assert.JSONEq(`{
+		"type": "about:blank",
+		"title": "Internal Server Error",
+		"status": 500,
+		"detail": "The server failed to serve the request.",
+		"instance": "/v1/series/1",
+		"request_id": "RequestID"
+	}`, rec.Body.String())
+}
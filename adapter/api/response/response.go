@@ -3,5 +3,16 @@ package response
 import "net/http"
 
 type Response interface {
-	Send(w http.ResponseWriter) error
+	Send(w http.ResponseWriter, r *http.Request) error
+}
+
+// encoderFor negotiates the response encoding for r. Clients are expected
+// to be rejected earlier if nothing they accept is supported, so JSON
+// is used as a last resort.
+func encoderFor(r *http.Request) (string, Encoder) {
+	mediaType, encoder, err := Negotiate(r.Header.Get("Accept"))
+	if err != nil {
+		return MediaTypeJSON, EncoderFunc(encodeJSON)
+	}
+	return mediaType, encoder
 }
@@ -1,27 +1,45 @@
 package response
 
 import (
-	"encoding/json"
 	"net/http"
 )
 
 type Success struct {
 	statusCode int
 	result     any
+	headers    http.Header
+	links      Links
 }
 
 func NewSuccess(code int, result any) *Success {
 	return &Success{
 		statusCode: code,
 		result:     result,
+		headers:    http.Header{},
 	}
 }
 
-func (s *Success) Send(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (s *Success) WithHeader(key, value string) *Success {
+	s.headers.Set(key, value)
+	return s
+}
+
+func (s *Success) Send(w http.ResponseWriter, r *http.Request) error {
+	mediaType, encoder := encoderFor(r)
+	result := s.result
+	if mediaType == MediaTypeHAL && result != nil && len(s.links) > 0 {
+		var err error
+		if result, err = withLinks(result, s.links); err != nil {
+			return err
+		}
+	}
+	for key, values := range s.headers {
+		w.Header()[key] = values
+	}
+	w.Header().Set("Content-Type", mediaType)
 	w.WriteHeader(s.statusCode)
-	if s.result != nil {
-		return json.NewEncoder(w).Encode(s.result)
+	if result != nil {
+		return encoder.Encode(w, result)
 	}
 	return nil
 }
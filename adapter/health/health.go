@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout bounds every dependency check.
+const checkTimeout = 2 * time.Second
+
+const (
+	StatusOK       = "ok"
+	StatusFailing  = "failing"
+	StatusDraining = "draining"
+)
+
+var ErrDraining = errors.New("server is shutting down")
+
+type (
+	// Dependency is a service requests can't be served without,
+	// Check returns nil when it is usable.
+	Dependency struct {
+		Name  string
+		Check func(context.Context) error
+	}
+
+	// Build identifies the running binary.
+	Build struct {
+		Version string
+		Commit  string
+	}
+
+	Status struct {
+		Status       string             `json:"status"       xml:"status"`
+		Version      string             `json:"version"      xml:"version"`
+		Commit       string             `json:"commit"       xml:"commit"`
+		StartedAt    string             `json:"started_at"   xml:"started_at"`
+		Uptime       string             `json:"uptime"       xml:"uptime"`
+		Dependencies []DependencyStatus `json:"dependencies" xml:"dependencies>dependency"`
+	}
+
+	DependencyStatus struct {
+		Name      string  `json:"name"            xml:"name"`
+		Status    string  `json:"status"          xml:"status"`
+		LatencyMS float64 `json:"latency_ms"      xml:"latency_ms"`
+		Error     string  `json:"error,omitempty" xml:"error,omitempty"`
+	}
+
+	// Monitor tells whether the server can take requests, that is whether
+	// its dependencies are usable and it isn't shutting down.
+	Monitor struct {
+		build        Build
+		dependencies []Dependency
+		startedAt    time.Time
+		now          func() time.Time
+		draining     int32
+	}
+)
+
+func NewMonitor(build Build, dependencies ...Dependency) *Monitor {
+	return &Monitor{
+		build:        build,
+		dependencies: dependencies,
+		startedAt:    time.Now(),
+		now:          time.Now,
+	}
+}
+
+// Drain makes the server report itself not ready from now on, so that
+// load balancers stop sending it requests before it shuts down.
+func (m *Monitor) Drain() {
+	atomic.StoreInt32(&m.draining, 1)
+}
+
+func (m *Monitor) isDraining() bool {
+	return atomic.LoadInt32(&m.draining) == 1
+}
+
+// Ready returns ErrDraining once Drain was called, otherwise the error
+// of the first failing dependency, if any.
+func (m *Monitor) Ready(ctx context.Context) error {
+	if m.isDraining() {
+		return ErrDraining
+	}
+	for _, dep := range m.check(ctx) {
+		if dep.Error != "" {
+			return fmt.Errorf("%s: %s", dep.Name, dep.Error)
+		}
+	}
+	return nil
+}
+
+// Status checks every dependency and reports how long each check took.
+func (m *Monitor) Status(ctx context.Context) Status {
+	deps := m.check(ctx)
+
+	status := StatusOK
+	for _, dep := range deps {
+		if dep.Error != "" {
+			status = StatusFailing
+		}
+	}
+	if m.isDraining() {
+		status = StatusDraining
+	}
+
+	return Status{
+		Status:       status,
+		Version:      m.build.Version,
+		Commit:       m.build.Commit,
+		StartedAt:    m.startedAt.UTC().Format(time.RFC3339),
+		Uptime:       m.now().Sub(m.startedAt).Truncate(time.Second).String(),
+		Dependencies: deps,
+	}
+}
+
+// check runs the checks of the dependencies concurrently.
+func (m *Monitor) check(ctx context.Context) []DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	statuses := make([]DependencyStatus, len(m.dependencies))
+	var wg sync.WaitGroup
+	for i, dep := range m.dependencies {
+		wg.Add(1)
+		go func(i int, dep Dependency) {
+			defer wg.Done()
+			start := m.now()
+			err := dep.Check(ctx)
+			statuses[i] = DependencyStatus{
+				Name:      dep.Name,
+				Status:    StatusOK,
+				LatencyMS: float64(m.now().Sub(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				statuses[i].Status = StatusFailing
+				statuses[i].Error = err.Error()
+			}
+		}(i, dep)
+	}
+	wg.Wait()
+	return statuses
+}
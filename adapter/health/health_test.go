@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitor(t *testing.T) {
+	t.Parallel()
+
+	ok := Dependency{
+		Name:  "postgres",
+		Check: func(context.Context) error { return nil },
+	}
+	failing := Dependency{
+		Name:  "schema",
+		Check: func(context.Context) error { return errors.New("missing tables: outbox") },
+	}
+
+	type Test struct {
+		Description      string
+		Dependencies     []Dependency
+		Drain            bool
+		ExpectedReadyErr error
+		ExpectedStatus   string
+	}
+	tests := []Test{
+		{
+			Description:      "Ready",
+			Dependencies:     []Dependency{ok},
+			ExpectedReadyErr: nil,
+			ExpectedStatus:   StatusOK,
+		},
+		{
+			Description:      "Failing dependency",
+			Dependencies:     []Dependency{ok, failing},
+			ExpectedReadyErr: errors.New("schema: missing tables: outbox"),
+			ExpectedStatus:   StatusFailing,
+		},
+		{
+			Description:      "Draining",
+			Dependencies:     []Dependency{ok},
+			Drain:            true,
+			ExpectedReadyErr: ErrDraining,
+			ExpectedStatus:   StatusDraining,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			m := NewMonitor(Build{Version: "1.2.0", Commit: "abc123"}, test.Dependencies...)
+			m.startedAt = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+			m.now = func() time.Time { return m.startedAt.Add(90 * time.Minute) }
+			if test.Drain {
+				m.Drain()
+			}
+
+			assert.Equal(test.ExpectedReadyErr, m.Ready(context.TODO()))
+
+			status := m.Status(context.TODO())
+			assert.Equal(test.ExpectedStatus, status.Status)
+			assert.Equal("1.2.0", status.Version)
+			assert.Equal("abc123", status.Commit)
+			assert.Equal("2022-10-01T12:00:00Z", status.StartedAt)
+			assert.Equal("1h30m0s", status.Uptime)
+			assert.Len(status.Dependencies, len(test.Dependencies))
+			for i, dep := range status.Dependencies {
+				assert.Equal(test.Dependencies[i].Name, dep.Name)
+			}
+		})
+	}
+}
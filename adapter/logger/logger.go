@@ -1,5 +1,10 @@
 package logger
 
+import "context"
+
+// Fields are the key/value pairs a structured logger adds to its entries.
+type Fields map[string]any
+
 type Logger interface {
 	Debugf(string, ...any)
 	Errorf(string, ...any)
@@ -7,4 +12,35 @@ type Logger interface {
 	Infof(string, ...any)
 	Printf(string, ...any)
 	Warnf(string, ...any)
+	// WithFields returns a logger adding fields to every entry.
+	WithFields(Fields) Logger
+}
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying log, like a logger whose
+// entries hold the id of the request being served.
+func NewContext(ctx context.Context, log Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, log)
 }
+
+// FromContext returns the logger carried by ctx, or Discard if there is none.
+func FromContext(ctx context.Context) Logger {
+	if log, ok := ctx.Value(ctxKey{}).(Logger); ok {
+		return log
+	}
+	return Discard
+}
+
+// Discard drops every entry.
+var Discard Logger = discard{}
+
+type discard struct{}
+
+func (discard) Debugf(string, ...any)      {}
+func (discard) Errorf(string, ...any)      {}
+func (discard) Fatalf(string, ...any)      {}
+func (discard) Infof(string, ...any)       {}
+func (discard) Printf(string, ...any)      {}
+func (discard) Warnf(string, ...any)       {}
+func (d discard) WithFields(Fields) Logger { return d }
@@ -0,0 +1,16 @@
+package metrics
+
+import (
+	"series/usecase"
+	"time"
+)
+
+type Metrics interface {
+	usecase.Metrics
+	// ObserveRequest records a served HTTP request. route is the template
+	// of the matched route, like /v1/series/{id}, so that the number of
+	// label values doesn't grow with the number of resources.
+	ObserveRequest(method, route string, status int, elapsed time.Duration)
+	// IncPanic counts a panic recovered while serving a request of route.
+	IncPanic(route string)
+}
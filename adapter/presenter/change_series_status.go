@@ -0,0 +1,25 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type changeSeriesStatusPresenter struct{}
+
+func NewChangeSeriesStatusPresenter() usecase.ChangeSeriesStatusPresenter {
+	return changeSeriesStatusPresenter{}
+}
+
+func (changeSeriesStatusPresenter) Output(
+	series domain.Series,
+) usecase.ChangeSeriesStatusOutput {
+	return usecase.ChangeSeriesStatusOutput{
+		ID:        series.ID().String(),
+		Status:    series.Status().String(),
+		BeginYear: series.BeginYear(),
+		EndYear:   series.EndYear(),
+		Version:   series.Version(),
+		UpdatedAt: formatTime(series.UpdatedAt()),
+	}
+}
@@ -0,0 +1,50 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSeriesStatusPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Series
+		Want        usecase.ChangeSeriesStatusOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewSeries(
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"Title",
+				"Description",
+				1980,
+				1990,
+			).WithStatus(domain.SeriesCancelled).
+				WithVersion(3).
+				WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.ChangeSeriesStatusOutput{
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Status:    "cancelled",
+				BeginYear: 1980,
+				EndYear:   1990,
+				Version:   3,
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewChangeSeriesStatusPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createCreditPresenter struct{}
+
+func NewCreateCreditPresenter() usecase.CreateCreditPresenter {
+	return createCreditPresenter{}
+}
+
+func (createCreditPresenter) Output(credit domain.Credit) usecase.CreateCreditOutput {
+	return usecase.CreateCreditOutput{
+		ID:        credit.ID().String(),
+		SeriesID:  credit.SeriesID().String(),
+		PersonID:  credit.PersonID().String(),
+		Name:      credit.PersonName(),
+		Role:      credit.Role().String(),
+		Character: credit.Character(),
+		Episodes:  credit.Episodes(),
+		CreatedAt: formatTime(credit.CreatedAt()),
+		UpdatedAt: formatTime(credit.UpdatedAt()),
+	}
+}
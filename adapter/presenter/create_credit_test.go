@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCreditPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Credit
+		Want        usecase.CreateCreditOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewCredit(
+				domain.CreditID("5d3c7b1e-2f4a-4e6b-8c9d-0a1b2c3d4e5f"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.PersonID("8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90"),
+				domain.CreditActor,
+				"Jimmy McGill",
+				63,
+			).WithNames("Bob Odenkirk", "Better Call Saul").
+				WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateCreditOutput{
+				ID:        "5d3c7b1e-2f4a-4e6b-8c9d-0a1b2c3d4e5f",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				PersonID:  "8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90",
+				Name:      "Bob Odenkirk",
+				Role:      "actor",
+				Character: "Jimmy McGill",
+				Episodes:  63,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewCreateCreditPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createEpisodePresenter struct{}
+
+func NewCreateEpisodePresenter() usecase.CreateEpisodePresenter {
+	return createEpisodePresenter{}
+}
+
+func (createEpisodePresenter) Output(
+	season domain.Season,
+	episode domain.Episode,
+) usecase.CreateEpisodeOutput {
+	return usecase.CreateEpisodeOutput{
+		ID:        episode.ID().String(),
+		SeriesID:  episode.SeriesID().String(),
+		Season:    season.Number(),
+		Number:    episode.Number(),
+		Title:     episode.Title(),
+		AirDate:   formatDate(episode.AirDate()),
+		Runtime:   episode.Runtime(),
+		Synopsis:  episode.Synopsis(),
+		CreatedAt: formatTime(episode.CreatedAt()),
+		UpdatedAt: formatTime(episode.UpdatedAt()),
+	}
+}
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateEpisodePresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Season      domain.Season
+		Input       domain.Episode
+		Want        usecase.CreateEpisodeOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Season: domain.NewSeason(
+				domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				1,
+				"Season 1",
+				testAirDate,
+				"Synopsis",
+			),
+			Input: domain.NewEpisode(
+				domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19"),
+				domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				1,
+				"Pilot",
+				testAirDate,
+				58,
+				"Synopsis",
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateEpisodeOutput{
+				ID:        "2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Season:    1,
+				Number:    1,
+				Title:     "Pilot",
+				AirDate:   "2008-01-20",
+				Runtime:   58,
+				Synopsis:  "Synopsis",
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewCreateEpisodePresenter()
+			got := presenter.Output(test.Season, test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createGenrePresenter struct{}
+
+func NewCreateGenrePresenter() usecase.CreateGenrePresenter {
+	return createGenrePresenter{}
+}
+
+func (createGenrePresenter) Output(genre domain.Genre) usecase.CreateGenreOutput {
+	return usecase.CreateGenreOutput{
+		ID:        genre.ID().String(),
+		Name:      genre.Name(),
+		CreatedAt: formatTime(genre.CreatedAt()),
+		UpdatedAt: formatTime(genre.UpdatedAt()),
+	}
+}
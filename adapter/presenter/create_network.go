@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createNetworkPresenter struct{}
+
+func NewCreateNetworkPresenter() usecase.CreateNetworkPresenter {
+	return createNetworkPresenter{}
+}
+
+func (createNetworkPresenter) Output(network domain.Network) usecase.CreateNetworkOutput {
+	return usecase.CreateNetworkOutput{
+		ID:        network.ID().String(),
+		Name:      network.Name(),
+		Country:   network.Country(),
+		CreatedAt: formatTime(network.CreatedAt()),
+		UpdatedAt: formatTime(network.UpdatedAt()),
+	}
+}
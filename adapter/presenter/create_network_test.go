@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNetworkPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Network
+		Want        usecase.CreateNetworkOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewNetwork(
+				domain.NetworkID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"HBO",
+				"US",
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateNetworkOutput{
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Name:      "HBO",
+				Country:   "US",
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewCreateNetworkPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createPersonPresenter struct{}
+
+func NewCreatePersonPresenter() usecase.CreatePersonPresenter {
+	return createPersonPresenter{}
+}
+
+func (createPersonPresenter) Output(person domain.Person) usecase.CreatePersonOutput {
+	return usecase.CreatePersonOutput{
+		ID:        person.ID().String(),
+		Name:      person.Name(),
+		CreatedAt: formatTime(person.CreatedAt()),
+		UpdatedAt: formatTime(person.UpdatedAt()),
+	}
+}
@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePersonPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Person
+		Want        usecase.CreatePersonOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewPerson(
+				domain.PersonID("8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90"),
+				"Vince Gilligan",
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreatePersonOutput{
+				ID:        "8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90",
+				Name:      "Vince Gilligan",
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewCreatePersonPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -13,9 +13,14 @@ func NewCreateReviewPresenter() usecase.CreateReviewPresenter {
 
 func (createReviewPresenter) Output(review domain.Review) usecase.CreateReviewOutput {
 	return usecase.CreateReviewOutput{
-		ID:       review.ID().String(),
-		SeriesID: review.SeriesID().String(),
-		AuthorID: review.AuthorID().String(),
-		Text:     review.Text(),
+		ID:        review.ID().String(),
+		SeriesID:  review.SeriesID().String(),
+		EpisodeID: review.EpisodeID().String(),
+		AuthorID:  review.AuthorID().String(),
+		Text:      review.Text(),
+		Rating:    review.Rating(),
+		Version:   review.Version(),
+		CreatedAt: formatTime(review.CreatedAt()),
+		UpdatedAt: formatTime(review.UpdatedAt()),
 	}
 }
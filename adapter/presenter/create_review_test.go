@@ -24,12 +24,38 @@ func TestCreateReviewPresenterOutput(t *testing.T) {
 				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 				domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 				"Review text",
-			),
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
 			Want: usecase.CreateReviewOutput{
-				ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-				AuthorID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-				Text:     "Review text",
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Text:      "Review text",
+				Version:   1,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+		{
+			Description: "Rated episode review",
+			Input: domain.NewReview(
+				domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"Review text",
+			).
+				WithEpisode(domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19")).
+				WithRating(10).
+				WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateReviewOutput{
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				EpisodeID: "2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19",
+				AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Text:      "Review text",
+				Rating:    10,
+				Version:   1,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
 			},
 		},
 	}
@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createSeasonPresenter struct{}
+
+func NewCreateSeasonPresenter() usecase.CreateSeasonPresenter {
+	return createSeasonPresenter{}
+}
+
+func (createSeasonPresenter) Output(season domain.Season) usecase.CreateSeasonOutput {
+	return usecase.CreateSeasonOutput{
+		ID:        season.ID().String(),
+		SeriesID:  season.SeriesID().String(),
+		Number:    season.Number(),
+		Title:     season.Title(),
+		AirDate:   formatDate(season.AirDate()),
+		Synopsis:  season.Synopsis(),
+		Episodes:  season.Episodes(),
+		CreatedAt: formatTime(season.CreatedAt()),
+		UpdatedAt: formatTime(season.UpdatedAt()),
+	}
+}
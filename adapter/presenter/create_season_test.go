@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSeasonPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Season
+		Want        usecase.CreateSeasonOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewSeason(
+				domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				1,
+				"Season 1",
+				testAirDate,
+				"Synopsis",
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateSeasonOutput{
+				ID:        "9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Number:    1,
+				Title:     "Season 1",
+				AirDate:   "2008-01-20",
+				Synopsis:  "Synopsis",
+				Episodes:  0,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+		{
+			Description: "Unknown air date is left out",
+			Input: domain.NewSeason(
+				domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				2,
+				"",
+				time.Time{},
+				"",
+			).WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.CreateSeasonOutput{
+				ID:        "9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Number:    2,
+				AirDate:   "",
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewCreateSeasonPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
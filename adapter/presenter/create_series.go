@@ -14,11 +14,21 @@ func NewCreateSeriesPresenter() usecase.CreateSeriesPresenter {
 func (createSeriesPresenter) Output(series domain.Series) usecase.CreateSeriesOutput {
 	return usecase.CreateSeriesOutput{
 		ID:          series.ID().String(),
+		Slug:        series.Slug(),
 		Title:       series.Title(),
 		Description: series.Description(),
 		Episodes:    series.Episodes(),
 		BeginYear:   series.BeginYear(),
 		EndYear:     series.EndYear(),
 		Creator:     series.Creator(),
+		CreatorIDs:  formatCreatorIDs(series.Creators()),
+		Status:      series.Status().String(),
+		NetworkID:   series.NetworkID().String(),
+		Network:     series.Network(),
+		Country:     series.Country(),
+		Language:    series.Language(),
+		Version:     series.Version(),
+		CreatedAt:   formatTime(series.CreatedAt()),
+		UpdatedAt:   formatTime(series.UpdatedAt()),
 	}
 }
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type createWebhookPresenter struct{}
+
+func NewCreateWebhookPresenter() usecase.CreateWebhookPresenter {
+	return createWebhookPresenter{}
+}
+
+func (createWebhookPresenter) Output(webhook domain.Webhook) usecase.CreateWebhookOutput {
+	return usecase.CreateWebhookOutput{
+		ID:        webhook.ID().String(),
+		URL:       webhook.URL(),
+		Events:    eventNames(webhook.Events()),
+		Secret:    webhook.Secret(),
+		CreatedAt: formatTime(webhook.CreatedAt()),
+		UpdatedAt: formatTime(webhook.UpdatedAt()),
+	}
+}
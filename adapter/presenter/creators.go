@@ -0,0 +1,13 @@
+package presenter
+
+import "series/domain"
+
+// formatCreatorIDs lists the IDs of the creators of a series in the
+// order they are credited.
+func formatCreatorIDs(creators []domain.Person) []string {
+	IDs := make([]string, len(creators))
+	for i, creator := range creators {
+		IDs[i] = creator.ID().String()
+	}
+	return IDs
+}
@@ -0,0 +1,11 @@
+package presenter
+
+import "series/domain"
+
+func eventNames(events []domain.EventType) []string {
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = event.String()
+	}
+	return names
+}
@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+// formatExternalIDs sets the ID of each provider a series has one in.
+func formatExternalIDs(externalIDs []domain.ExternalID) usecase.ExternalIDsOutput {
+	output := usecase.ExternalIDsOutput{}
+	for _, externalID := range externalIDs {
+		switch externalID.Provider() {
+		case domain.ProviderIMDb:
+			output.IMDb = externalID.ID()
+		case domain.ProviderTVDB:
+			output.TVDB = externalID.ID()
+		case domain.ProviderTMDB:
+			output.TMDB = externalID.ID()
+		}
+	}
+	return output
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findCreditsByPersonPresenter struct{}
+
+func NewFindCreditsByPersonPresenter() usecase.FindCreditsByPersonPresenter {
+	return findCreditsByPersonPresenter{}
+}
+
+func (findCreditsByPersonPresenter) Output(
+	person domain.Person,
+	credits []domain.Credit,
+) usecase.FindCreditsByPersonOutput {
+	output := usecase.FindCreditsByPersonOutput{
+		ID:      person.ID().String(),
+		Name:    person.Name(),
+		Credits: make([]usecase.FindCreditsByPersonCredit, len(credits)),
+	}
+
+	for i, credit := range credits {
+		output.Credits[i] = usecase.FindCreditsByPersonCredit{
+			ID:        credit.ID().String(),
+			SeriesID:  credit.SeriesID().String(),
+			Title:     credit.SeriesTitle(),
+			Role:      credit.Role().String(),
+			Character: credit.Character(),
+			Episodes:  credit.Episodes(),
+		}
+	}
+	return output
+}
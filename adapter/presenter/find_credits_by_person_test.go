@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCreditsByPersonPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Person      domain.Person
+		Credits     []domain.Credit
+		Want        usecase.FindCreditsByPersonOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Person: domain.NewPerson(
+				domain.PersonID("2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"),
+				"Bob Odenkirk",
+			),
+			Credits: []domain.Credit{
+				domain.NewCredit(
+					domain.CreditID("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.PersonID("2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"),
+					domain.CreditActor,
+					"Jimmy McGill",
+					63,
+				).WithNames("Bob Odenkirk", "Better Call Saul"),
+			},
+			Want: usecase.FindCreditsByPersonOutput{
+				ID:   "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+				Name: "Bob Odenkirk",
+				Credits: []usecase.FindCreditsByPersonCredit{
+					{
+						ID:        "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b",
+						SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Title:     "Better Call Saul",
+						Role:      "actor",
+						Character: "Jimmy McGill",
+						Episodes:  63,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindCreditsByPersonPresenter()
+			got := presenter.Output(test.Person, test.Credits)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findCreditsBySeriesPresenter struct{}
+
+func NewFindCreditsBySeriesPresenter() usecase.FindCreditsBySeriesPresenter {
+	return findCreditsBySeriesPresenter{}
+}
+
+func (findCreditsBySeriesPresenter) Output(
+	credits []domain.Credit,
+) usecase.FindCreditsBySeriesOutput {
+	output := usecase.FindCreditsBySeriesOutput{
+		Credits: make([]usecase.FindCreditsBySeriesCredit, len(credits)),
+	}
+
+	for i, credit := range credits {
+		output.Credits[i] = usecase.FindCreditsBySeriesCredit{
+			ID:        credit.ID().String(),
+			PersonID:  credit.PersonID().String(),
+			Name:      credit.PersonName(),
+			Role:      credit.Role().String(),
+			Character: credit.Character(),
+			Episodes:  credit.Episodes(),
+		}
+	}
+	return output
+}
@@ -0,0 +1,76 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCreditsBySeriesPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       []domain.Credit
+		Want        usecase.FindCreditsBySeriesOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Credit{
+				domain.NewCredit(
+					domain.CreditID("5d3c7b1e-2f4a-4e6b-8c9d-0a1b2c3d4e5f"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.PersonID("8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90"),
+					domain.CreditCreator,
+					"",
+					0,
+				).WithNames("Vince Gilligan", "Better Call Saul"),
+				domain.NewCredit(
+					domain.CreditID("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.PersonID("2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"),
+					domain.CreditActor,
+					"Jimmy McGill",
+					63,
+				).WithNames("Bob Odenkirk", "Better Call Saul"),
+			},
+			Want: usecase.FindCreditsBySeriesOutput{
+				Credits: []usecase.FindCreditsBySeriesCredit{
+					{
+						ID:       "5d3c7b1e-2f4a-4e6b-8c9d-0a1b2c3d4e5f",
+						PersonID: "8a0f1b7e-4c1d-4b5e-9d0a-3f6c2e1b7a90",
+						Name:     "Vince Gilligan",
+						Role:     "creator",
+					},
+					{
+						ID:        "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b",
+						PersonID:  "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+						Name:      "Bob Odenkirk",
+						Role:      "actor",
+						Character: "Jimmy McGill",
+						Episodes:  63,
+					},
+				},
+			},
+		},
+		{
+			Description: "No credits",
+			Input:       []domain.Credit{},
+			Want: usecase.FindCreditsBySeriesOutput{
+				Credits: []usecase.FindCreditsBySeriesCredit{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindCreditsBySeriesPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findDeliveriesByWebhookPresenter struct{}
+
+func NewFindDeliveriesByWebhookPresenter() usecase.FindDeliveriesByWebhookPresenter {
+	return findDeliveriesByWebhookPresenter{}
+}
+
+func (findDeliveriesByWebhookPresenter) Output(
+	deliveries []domain.Delivery,
+) usecase.FindDeliveriesByWebhookOutput {
+	output := usecase.FindDeliveriesByWebhookOutput{
+		Deliveries: make([]usecase.FindDeliveriesByWebhookDelivery, len(deliveries)),
+	}
+
+	for i, delivery := range deliveries {
+		var nextAttemptAt string
+		if !delivery.NextAttemptAt().IsZero() {
+			nextAttemptAt = formatTime(delivery.NextAttemptAt())
+		}
+		output.Deliveries[i] = usecase.FindDeliveriesByWebhookDelivery{
+			ID:             delivery.ID().String(),
+			Event:          delivery.Event().String(),
+			Status:         delivery.Status().String(),
+			Attempts:       delivery.Attempts(),
+			NextAttemptAt:  nextAttemptAt,
+			LastStatusCode: delivery.LastStatusCode(),
+			LastError:      delivery.LastError(),
+			CreatedAt:      formatTime(delivery.CreatedAt()),
+			UpdatedAt:      formatTime(delivery.UpdatedAt()),
+		}
+	}
+	return output
+}
@@ -0,0 +1,90 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDeliveriesByWebhookPresenter(t *testing.T) {
+	t.Parallel()
+
+	delivery := domain.NewDelivery(
+		domain.DeliveryID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		domain.WebhookID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		domain.NewEvent(
+			domain.EventID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+			domain.EventSeriesCreated,
+			"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			[]byte(`{}`),
+		),
+	).WithTimestamps(testCreatedAt, testUpdatedAt)
+
+	type Test struct {
+		Description string
+		Input       []domain.Delivery
+		Want        usecase.FindDeliveriesByWebhookOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Delivery{
+				delivery.Succeeded(200),
+				delivery.Failed(500, "unexpected status 500", testUpdatedAt.Add(time.Minute)),
+				delivery.Failed(0, "connection refused", time.Time{}),
+			},
+			Want: usecase.FindDeliveriesByWebhookOutput{
+				Deliveries: []usecase.FindDeliveriesByWebhookDelivery{
+					{
+						ID:             "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Event:          "series.created",
+						Status:         "succeeded",
+						Attempts:       1,
+						LastStatusCode: 200,
+						CreatedAt:      "2022-10-01T12:00:00Z",
+						UpdatedAt:      "2022-10-02T08:30:00Z",
+					},
+					{
+						ID:             "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Event:          "series.created",
+						Status:         "pending",
+						Attempts:       1,
+						NextAttemptAt:  "2022-10-02T08:31:00Z",
+						LastStatusCode: 500,
+						LastError:      "unexpected status 500",
+						CreatedAt:      "2022-10-01T12:00:00Z",
+						UpdatedAt:      "2022-10-02T08:30:00Z",
+					},
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Event:     "series.created",
+						Status:    "dead",
+						Attempts:  1,
+						LastError: "connection refused",
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No deliveries means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindDeliveriesByWebhookOutput{
+				Deliveries: []usecase.FindDeliveriesByWebhookDelivery{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindDeliveriesByWebhookPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
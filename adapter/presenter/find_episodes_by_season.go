@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findEpisodesBySeasonPresenter struct{}
+
+func NewFindEpisodesBySeasonPresenter() usecase.FindEpisodesBySeasonPresenter {
+	return findEpisodesBySeasonPresenter{}
+}
+
+func (findEpisodesBySeasonPresenter) Output(
+	season domain.Season,
+	episodes []domain.Episode,
+) usecase.FindEpisodesBySeasonOutput {
+	output := usecase.FindEpisodesBySeasonOutput{
+		Season:   season.Number(),
+		Rating:   formatRating(season.Rating()),
+		Episodes: make([]usecase.FindEpisodesBySeasonEpisode, len(episodes)),
+	}
+
+	for i, episode := range episodes {
+		output.Episodes[i] = usecase.FindEpisodesBySeasonEpisode{
+			ID:        episode.ID().String(),
+			Number:    episode.Number(),
+			Title:     episode.Title(),
+			AirDate:   formatDate(episode.AirDate()),
+			Runtime:   episode.Runtime(),
+			Synopsis:  episode.Synopsis(),
+			Rating:    formatRating(episode.Rating()),
+			CreatedAt: formatTime(episode.CreatedAt()),
+			UpdatedAt: formatTime(episode.UpdatedAt()),
+		}
+	}
+	return output
+}
@@ -0,0 +1,100 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindEpisodesBySeasonPresenter(t *testing.T) {
+	t.Parallel()
+
+	season := domain.NewSeason(
+		domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+		domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		2,
+		"Season 2",
+		time.Time{},
+		"",
+	).WithRating(domain.NewRating(8.5, 2))
+
+	type Test struct {
+		Description string
+		Input       []domain.Episode
+		Want        usecase.FindEpisodesBySeasonOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Episode{
+				domain.NewEpisode(
+					domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19"),
+					domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					1,
+					"Seven Thirty-Seven",
+					testAirDate,
+					47,
+					"Synopsis",
+				).
+					WithRating(domain.NewRating(8.5, 2)).
+					WithTimestamps(testCreatedAt, testUpdatedAt),
+				domain.NewEpisode(
+					domain.EpisodeID("4b7d6e0a-2f1c-4e8e-9a51-0c3d2b6f7e19"),
+					domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					2,
+					"Grilled",
+					time.Time{},
+					0,
+					"",
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindEpisodesBySeasonOutput{
+				Season: 2,
+				Rating: usecase.RatingOutput{Average: 8.5, Count: 2},
+				Episodes: []usecase.FindEpisodesBySeasonEpisode{
+					{
+						ID:        "2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19",
+						Number:    1,
+						Title:     "Seven Thirty-Seven",
+						AirDate:   "2008-01-20",
+						Runtime:   47,
+						Synopsis:  "Synopsis",
+						Rating:    usecase.RatingOutput{Average: 8.5, Count: 2},
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+					{
+						ID:        "4b7d6e0a-2f1c-4e8e-9a51-0c3d2b6f7e19",
+						Number:    2,
+						Title:     "Grilled",
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No episodes means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindEpisodesBySeasonOutput{
+				Season:   2,
+				Rating:   usecase.RatingOutput{Average: 8.5, Count: 2},
+				Episodes: []usecase.FindEpisodesBySeasonEpisode{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindEpisodesBySeasonPresenter()
+			got := presenter.Output(season, test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findGenresPresenter struct{}
+
+func NewFindGenresPresenter() usecase.FindGenresPresenter {
+	return findGenresPresenter{}
+}
+
+func (findGenresPresenter) Output(
+	genres []domain.Genre,
+) usecase.FindGenresOutput {
+	output := usecase.FindGenresOutput{
+		Genres: make([]usecase.FindGenresGenre, len(genres)),
+	}
+
+	for i, genre := range genres {
+		output.Genres[i] = usecase.FindGenresGenre{
+			ID:        genre.ID().String(),
+			Name:      genre.Name(),
+			CreatedAt: formatTime(genre.CreatedAt()),
+			UpdatedAt: formatTime(genre.UpdatedAt()),
+		}
+	}
+	return output
+}
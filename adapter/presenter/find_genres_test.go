@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindGenresPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       []domain.Genre
+		Want        usecase.FindGenresOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Genre{
+				domain.NewGenre(
+					domain.GenreID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Drama",
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindGenresOutput{
+				Genres: []usecase.FindGenresGenre{
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Name:      "Drama",
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No genres means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindGenresOutput{
+				Genres: []usecase.FindGenresGenre{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindGenresPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
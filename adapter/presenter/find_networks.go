@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findNetworksPresenter struct{}
+
+func NewFindNetworksPresenter() usecase.FindNetworksPresenter {
+	return findNetworksPresenter{}
+}
+
+func (findNetworksPresenter) Output(
+	networks []domain.Network,
+) usecase.FindNetworksOutput {
+	output := usecase.FindNetworksOutput{
+		Networks: make([]usecase.FindNetworksNetwork, len(networks)),
+	}
+
+	for i, network := range networks {
+		output.Networks[i] = usecase.FindNetworksNetwork{
+			ID:        network.ID().String(),
+			Name:      network.Name(),
+			Country:   network.Country(),
+			CreatedAt: formatTime(network.CreatedAt()),
+			UpdatedAt: formatTime(network.UpdatedAt()),
+		}
+	}
+	return output
+}
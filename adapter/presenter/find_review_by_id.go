@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findReviewByIDPresenter struct{}
+
+func NewFindReviewByIDPresenter() usecase.FindReviewByIDPresenter {
+	return findReviewByIDPresenter{}
+}
+
+func (findReviewByIDPresenter) Output(review domain.Review) usecase.FindReviewByIDOutput {
+	return usecase.FindReviewByIDOutput{
+		ID:        review.ID().String(),
+		SeriesID:  review.SeriesID().String(),
+		EpisodeID: review.EpisodeID().String(),
+		AuthorID:  review.AuthorID().String(),
+		Text:      review.Text(),
+		Rating:    review.Rating(),
+		Version:   review.Version(),
+		CreatedAt: formatTime(review.CreatedAt()),
+		UpdatedAt: formatTime(review.UpdatedAt()),
+	}
+}
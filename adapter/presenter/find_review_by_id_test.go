@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindReviewByIDPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Review
+		Want        usecase.FindReviewByIDOutput
+	}
+	tests := []Test{
+		{
+			Description: "Review of an episode",
+			Input: domain.NewReview(
+				domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"Review text",
+			).WithEpisode("8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11").
+				WithRating(9).
+				WithTimestamps(testCreatedAt, testUpdatedAt),
+			Want: usecase.FindReviewByIDOutput{
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				EpisodeID: "8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11",
+				AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Text:      "Review text",
+				Rating:    9,
+				Version:   1,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindReviewByIDPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findReviewsByEpisodePresenter struct{}
+
+func NewFindReviewsByEpisodePresenter() usecase.FindReviewsByEpisodePresenter {
+	return findReviewsByEpisodePresenter{}
+}
+
+func (findReviewsByEpisodePresenter) Output(
+	episode domain.Episode,
+	reviews []domain.Review,
+) usecase.FindReviewsByEpisodeOutput {
+	output := usecase.FindReviewsByEpisodeOutput{
+		SeriesID: episode.SeriesID().String(),
+		Rating:   formatRating(episode.Rating()),
+		Reviews:  make([]usecase.FindReviewsByEpisodeReview, len(reviews)),
+	}
+
+	for i, review := range reviews {
+		output.Reviews[i] = usecase.FindReviewsByEpisodeReview{
+			ID:        review.ID().String(),
+			AuthorID:  review.AuthorID().String(),
+			Text:      review.Text(),
+			Rating:    review.Rating(),
+			Version:   review.Version(),
+			CreatedAt: formatTime(review.CreatedAt()),
+			UpdatedAt: formatTime(review.UpdatedAt()),
+		}
+	}
+	return output
+}
@@ -0,0 +1,96 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindReviewsByEpisodePresenter(t *testing.T) {
+	t.Parallel()
+
+	episode := domain.NewEpisode(
+		domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19"),
+		domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+		domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		14,
+		"Ozymandias",
+		time.Time{},
+		0,
+		"",
+	).WithRating(domain.NewRating(9.5, 2))
+
+	type Test struct {
+		Description string
+		Input       []domain.Review
+		Want        usecase.FindReviewsByEpisodeOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Review{
+				domain.NewReview(
+					domain.ReviewID("3c5d7e11-9a1c-4a47-8c3e-2f0b6f7e3c1b"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Review text",
+				).
+					WithEpisode(domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19")).
+					WithRating(10).
+					WithTimestamps(testCreatedAt, testUpdatedAt),
+				domain.NewReview(
+					domain.ReviewID("7e113c5d-9a1c-4a47-8c3e-2f0b6f7e3c1b"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.AuthorID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+					"Unrated review text",
+				).
+					WithEpisode(domain.EpisodeID("2f1c6e0a-4b7d-4e8e-9a51-0c3d2b6f7e19")).
+					WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindReviewsByEpisodeOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Rating:   usecase.RatingOutput{Average: 9.5, Count: 2},
+				Reviews: []usecase.FindReviewsByEpisodeReview{
+					{
+						ID:        "3c5d7e11-9a1c-4a47-8c3e-2f0b6f7e3c1b",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Rating:    10,
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+					{
+						ID:        "7e113c5d-9a1c-4a47-8c3e-2f0b6f7e3c1b",
+						AuthorID:  "9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11",
+						Text:      "Unrated review text",
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No reviews means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindReviewsByEpisodeOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Rating:   usecase.RatingOutput{Average: 9.5, Count: 2},
+				Reviews:  []usecase.FindReviewsByEpisodeReview{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindReviewsByEpisodePresenter()
+			got := presenter.Output(episode, test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -20,9 +20,13 @@ func (findReviewsBySeriesPresenter) Output(
 
 	for i, review := range reviews {
 		output.Reviews[i] = usecase.FindReviewsBySeriesReview{
-			ID:       review.ID().String(),
-			AuthorID: review.AuthorID().String(),
-			Text:     review.Text(),
+			ID:        review.ID().String(),
+			AuthorID:  review.AuthorID().String(),
+			Text:      review.Text(),
+			Rating:    review.Rating(),
+			Version:   review.Version(),
+			CreatedAt: formatTime(review.CreatedAt()),
+			UpdatedAt: formatTime(review.UpdatedAt()),
 		}
 	}
 	return output
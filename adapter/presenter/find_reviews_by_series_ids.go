@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findReviewsBySeriesIDsPresenter struct{}
+
+func NewFindReviewsBySeriesIDsPresenter() usecase.FindReviewsBySeriesIDsPresenter {
+	return findReviewsBySeriesIDsPresenter{}
+}
+
+func (findReviewsBySeriesIDsPresenter) Output(
+	reviews []domain.Review,
+) usecase.FindReviewsBySeriesIDsOutput {
+	output := usecase.FindReviewsBySeriesIDsOutput{
+		Reviews: make([]usecase.FindReviewsBySeriesIDsReview, len(reviews)),
+	}
+
+	for i, review := range reviews {
+		output.Reviews[i] = usecase.FindReviewsBySeriesIDsReview{
+			ID:        review.ID().String(),
+			SeriesID:  review.SeriesID().String(),
+			EpisodeID: review.EpisodeID().String(),
+			AuthorID:  review.AuthorID().String(),
+			Text:      review.Text(),
+			Rating:    review.Rating(),
+			Version:   review.Version(),
+			CreatedAt: formatTime(review.CreatedAt()),
+			UpdatedAt: formatTime(review.UpdatedAt()),
+		}
+	}
+	return output
+}
@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindReviewsBySeriesIDsPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       []domain.Review
+		Want        usecase.FindReviewsBySeriesIDsOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Review{
+				domain.NewReview(
+					domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					domain.SeriesID("4f4a0b0a-6a4c-4d0c-8f5e-0d6a3c1f6a11"),
+					domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Review text",
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindReviewsBySeriesIDsOutput{
+				Reviews: []usecase.FindReviewsBySeriesIDsReview{
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						SeriesID:  "4f4a0b0a-6a4c-4d0c-8f5e-0d6a3c1f6a11",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No reviews means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindReviewsBySeriesIDsOutput{
+				Reviews: []usecase.FindReviewsBySeriesIDsReview{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindReviewsBySeriesIDsPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
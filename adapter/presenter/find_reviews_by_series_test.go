@@ -25,25 +25,31 @@ func TestFindReviewsBySeriesPresenter(t *testing.T) {
 					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					"Review text",
-				),
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
 				domain.NewReview(
 					domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					"Review text",
-				),
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
 			},
 			Want: usecase.FindReviewsBySeriesOutput{
 				Reviews: []usecase.FindReviewsBySeriesReview{
 					{
-						ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						AuthorID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						Text:     "Review text",
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
 					},
 					{
-						ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						AuthorID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						Text:     "Review text",
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
 					},
 				},
 			},
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findSeasonsBySeriesPresenter struct{}
+
+func NewFindSeasonsBySeriesPresenter() usecase.FindSeasonsBySeriesPresenter {
+	return findSeasonsBySeriesPresenter{}
+}
+
+func (findSeasonsBySeriesPresenter) Output(
+	seasons []domain.Season,
+) usecase.FindSeasonsBySeriesOutput {
+	output := usecase.FindSeasonsBySeriesOutput{
+		Seasons: make([]usecase.FindSeasonsBySeriesSeason, len(seasons)),
+	}
+
+	for i, season := range seasons {
+		output.Seasons[i] = usecase.FindSeasonsBySeriesSeason{
+			ID:        season.ID().String(),
+			Number:    season.Number(),
+			Title:     season.Title(),
+			AirDate:   formatDate(season.AirDate()),
+			Synopsis:  season.Synopsis(),
+			Episodes:  season.Episodes(),
+			Rating:    formatRating(season.Rating()),
+			CreatedAt: formatTime(season.CreatedAt()),
+			UpdatedAt: formatTime(season.UpdatedAt()),
+		}
+	}
+	return output
+}
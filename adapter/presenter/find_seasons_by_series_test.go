@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindSeasonsBySeriesPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       []domain.Season
+		Want        usecase.FindSeasonsBySeriesOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Season{
+				domain.NewSeason(
+					domain.SeasonID("9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11"),
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					1,
+					"Season 1",
+					testAirDate,
+					"Synopsis",
+				).WithEpisodes(7).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindSeasonsBySeriesOutput{
+				Seasons: []usecase.FindSeasonsBySeriesSeason{
+					{
+						ID:        "9a1c6f7e-3c1b-4a47-8c3e-2f0b3c5d7e11",
+						Number:    1,
+						Title:     "Season 1",
+						AirDate:   "2008-01-20",
+						Synopsis:  "Synopsis",
+						Episodes:  7,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No seasons means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindSeasonsBySeriesOutput{
+				Seasons: []usecase.FindSeasonsBySeriesSeason{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindSeasonsBySeriesPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
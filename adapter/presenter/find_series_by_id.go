@@ -5,34 +5,131 @@ import (
 	"series/usecase"
 )
 
-type findSeriesByIDPresenter struct{}
+type findSeriesByIDPresenter struct {
+	languages []string
+}
 
-func NewFindSeriesByIDPresenter() usecase.FindSeriesByIDPresenter {
-	return findSeriesByIDPresenter{}
+// NewFindSeriesByIDPresenter translates the series in the first of the
+// accepted languages it has a translation in.
+func NewFindSeriesByIDPresenter(languages []string) usecase.FindSeriesByIDPresenter {
+	return findSeriesByIDPresenter{languages: languages}
 }
 
-func (findSeriesByIDPresenter) Output(
+func (p findSeriesByIDPresenter) Output(
 	series domain.Series,
 	reviews []domain.Review,
+	input usecase.FindSeriesByIDInput,
 ) usecase.FindSeriesByIDOutput {
-	output := usecase.FindSeriesByIDOutput{
-		ID:          series.ID().String(),
-		Title:       series.Title(),
-		Description: series.Description(),
-		Episodes:    series.Episodes(),
-		BeginYear:   series.BeginYear(),
-		EndYear:     series.EndYear(),
-		Creator:     series.Creator(),
-		Reviews:     make([]usecase.FindSeriesByIDReview, len(reviews)),
+	output := usecase.FindSeriesByIDOutput{}
+	title, description := translate(series, p.languages)
+
+	if input.Selects("id") {
+		output.ID = series.ID().String()
+	}
+	if input.Selects("slug") {
+		output.Slug = series.Slug()
+	}
+	if input.Selects("title") {
+		output.Title = title
+	}
+	if input.Selects("description") {
+		output.Description = description
+	}
+	if input.Selects("episodes") {
+		episodes := series.Episodes()
+		output.Episodes = &episodes
+	}
+	if input.Selects("begin_year") {
+		output.BeginYear = series.BeginYear()
+	}
+	if input.Selects("end_year") {
+		endYear := series.EndYear()
+		output.EndYear = &endYear
+	}
+	if input.Selects("creator") {
+		output.Creator = series.Creator()
+	}
+	if input.Selects("creator_ids") {
+		creatorIDs := formatCreatorIDs(series.Creators())
+		output.CreatorIDs = &creatorIDs
+	}
+	if input.Selects("status") {
+		output.Status = series.Status().String()
+	}
+	if input.Selects("rating") {
+		rating := formatRating(series.Rating())
+		output.Rating = &rating
+	}
+	if input.Selects("episode_rating") {
+		rating := formatRating(series.EpisodeRating())
+		output.EpisodeRating = &rating
+	}
+	if input.Selects("genres") {
+		genres := formatList(series.Genres())
+		output.Genres = &genres
+	}
+	if input.Selects("tags") {
+		tags := formatList(series.Tags())
+		output.Tags = &tags
+	}
+	if input.Selects("network_id") {
+		output.NetworkID = series.NetworkID().String()
+	}
+	if input.Selects("network") {
+		output.Network = series.Network()
+	}
+	if input.Selects("country") {
+		output.Country = series.Country()
+	}
+	if input.Selects("language") {
+		output.Language = series.Language()
+	}
+	if input.Selects("translations") {
+		translations := formatTranslations(series)
+		output.Translations = &translations
+	}
+	if input.Selects("aliases") {
+		aliases := formatList(series.Aliases())
+		output.Aliases = &aliases
+	}
+	if input.Selects("external_ids") {
+		externalIDs := formatExternalIDs(series.ExternalIDs())
+		output.ExternalIDs = &externalIDs
+	}
+	if input.Selects("version") {
+		output.Version = series.Version()
+	}
+	if input.Selects("created_at") {
+		output.CreatedAt = formatTime(series.CreatedAt())
+	}
+	if input.Selects("updated_at") {
+		output.UpdatedAt = formatTime(series.UpdatedAt())
 	}
 
+	if !input.Includes(usecase.FindSeriesByIDIncludeReviews) {
+		return output
+	}
+
+	outputReviews := make([]usecase.FindSeriesByIDReview, len(reviews))
 	for i, review := range reviews {
-		output.Reviews[i] = usecase.FindSeriesByIDReview{
-			ID:       review.ID().String(),
-			AuthorID: review.AuthorID().String(),
-			Text:     review.Text(),
+		outputReviews[i] = usecase.FindSeriesByIDReview{
+			ID:        review.ID().String(),
+			AuthorID:  review.AuthorID().String(),
+			Text:      review.Text(),
+			Rating:    formatReviewRating(review.Rating()),
+			Version:   review.Version(),
+			CreatedAt: formatTime(review.CreatedAt()),
+			UpdatedAt: formatTime(review.UpdatedAt()),
+		}
+		if input.Includes(usecase.FindSeriesByIDIncludeReviewAuthors) {
+			author := review.Author()
+			outputReviews[i].Author = &usecase.FindSeriesByIDAuthor{
+				ID:      review.AuthorID().String(),
+				Reviews: author.Reviews(),
+			}
 		}
 	}
+	output.Reviews = &outputReviews
 
 	return output
 }
@@ -1,6 +1,7 @@
 package presenter
 
 import (
+	"encoding/json"
 	"series/domain"
 	"series/usecase"
 	"testing"
@@ -11,9 +12,13 @@ import (
 func TestFindSeriesByIDPresenter(t *testing.T) {
 	t.Parallel()
 
+	endYear, episodes, rating := 1990, 20, 8
+
 	type Input struct {
-		Series  domain.Series
-		Reviews []domain.Review
+		Series    domain.Series
+		Reviews   []domain.Review
+		Request   usecase.FindSeriesByIDInput
+		Languages []string
 	}
 	type Test struct {
 		Description string
@@ -28,44 +33,89 @@ func TestFindSeriesByIDPresenter(t *testing.T) {
 					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					"Title",
 					"Description",
-					20,
 					1980,
 					1990,
-					"Creator",
-				),
+				).WithCreators(testCreators).WithEpisodes(20).
+					WithRatings(domain.NewRating(8.5, 2), domain.NewRating(7.25, 4)).
+					WithGenres([]string{"Drama", "Thriller"}).
+					WithTags([]string{"anthology"}).
+					WithNetwork("8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11", "HBO").
+					WithOrigin("US", "en").
+					WithAliases([]string{"Other Title"}).
+					WithSlug("title-1980").
+					WithExternalIDs([]domain.ExternalID{
+						domain.NewExternalID(domain.ProviderIMDb, "tt0185906"),
+					}).
+					WithTimestamps(testCreatedAt, testUpdatedAt),
 				Reviews: []domain.Review{
 					domain.NewReview(
 						domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						"Review text",
-					),
+					).WithRating(8).WithTimestamps(testCreatedAt, testUpdatedAt),
 					domain.NewReview(
 						domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 						"Review text",
-					),
+					).WithTimestamps(testCreatedAt, testUpdatedAt),
+				},
+				Request: usecase.FindSeriesByIDInput{
+					Include: []string{"reviews"},
 				},
 			},
 			Want: usecase.FindSeriesByIDOutput{
 				ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Slug:        "title-1980",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
-				Creator:     "Creator",
-				Reviews: []usecase.FindSeriesByIDReview{
+				EndYear:     &endYear,
+				Creator:     "Vince Gilligan & Peter Gould",
+				CreatorIDs: &[]string{
+					"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c",
+					"0d7e1b52-9c3a-4f6e-8a2b-1c4d5e6f7a8b",
+				},
+				Status: "ended",
+				Rating: &usecase.RatingOutput{
+					Average: 8.5,
+					Count:   2,
+				},
+				EpisodeRating: &usecase.RatingOutput{
+					Average: 7.25,
+					Count:   4,
+				},
+				Genres:       &[]string{"Drama", "Thriller"},
+				Tags:         &[]string{"anthology"},
+				NetworkID:    "8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11",
+				Network:      "HBO",
+				Country:      "US",
+				Language:     "en",
+				Translations: &[]string{},
+				Aliases:      &[]string{"Other Title"},
+				ExternalIDs:  &usecase.ExternalIDsOutput{IMDb: "tt0185906"},
+				Version:      1,
+				CreatedAt:    "2022-10-01T12:00:00Z",
+				UpdatedAt:    "2022-10-02T08:30:00Z",
+				Reviews: &[]usecase.FindSeriesByIDReview{
 					{
-						ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						AuthorID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						Text:     "Review text",
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Rating:    &rating,
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
 					},
 					{
-						ID:       "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						AuthorID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
-						Text:     "Review text",
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Text:      "Review text",
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
 					},
 				},
 			},
@@ -77,22 +127,148 @@ func TestFindSeriesByIDPresenter(t *testing.T) {
 					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					"Title",
 					"Description",
-					20,
 					1980,
 					1990,
-					"Creator",
-				),
+				).WithCreators(testCreators).WithEpisodes(20).WithTimestamps(testCreatedAt, testUpdatedAt),
 				Reviews: nil,
+				Request: usecase.FindSeriesByIDInput{
+					Include: []string{"reviews"},
+				},
 			},
 			Want: usecase.FindSeriesByIDOutput{
 				ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
-				Creator:     "Creator",
-				Reviews:     []usecase.FindSeriesByIDReview{},
+				EndYear:     &endYear,
+				Creator:     "Vince Gilligan & Peter Gould",
+				CreatorIDs: &[]string{
+					"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c",
+					"0d7e1b52-9c3a-4f6e-8a2b-1c4d5e6f7a8b",
+				},
+				Status:        "ended",
+				Rating:        &usecase.RatingOutput{},
+				EpisodeRating: &usecase.RatingOutput{},
+				Genres:        &[]string{},
+				Tags:          &[]string{},
+				Translations:  &[]string{},
+				Aliases:       &[]string{},
+				ExternalIDs:   &usecase.ExternalIDsOutput{},
+				Version:       1,
+				CreatedAt:     "2022-10-01T12:00:00Z",
+				UpdatedAt:     "2022-10-02T08:30:00Z",
+				Reviews:       &[]usecase.FindSeriesByIDReview{},
+			},
+		},
+		{
+			Description: "Sparse fieldset without reviews",
+			Input: Input{
+				Series: domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithCreators(testCreators).WithEpisodes(20).WithTimestamps(testCreatedAt, testUpdatedAt),
+				Reviews: nil,
+				Request: usecase.FindSeriesByIDInput{
+					Fields: []string{"id", "title", "end_year"},
+				},
+			},
+			Want: usecase.FindSeriesByIDOutput{
+				ID:      "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Title:   "Title",
+				EndYear: new(int),
+			},
+		},
+		{
+			Description: "Reviews with their authors",
+			Input: Input{
+				Series: domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithCreators(testCreators),
+				Reviews: []domain.Review{
+					domain.NewReview(
+						domain.ReviewID("26efa50a-953e-4aeb-befb-ccc14058989b"),
+						domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+						domain.AuthorID("635c4bb1-41b1-46e9-a87e-292a9d63b9e3"),
+						"Review text",
+					).WithAuthor(domain.NewAuthor("635c4bb1-41b1-46e9-a87e-292a9d63b9e3", 3)).
+						WithTimestamps(testCreatedAt, testUpdatedAt),
+				},
+				Request: usecase.FindSeriesByIDInput{
+					Fields:  []string{"id"},
+					Include: []string{"reviews.author"},
+				},
+			},
+			Want: usecase.FindSeriesByIDOutput{
+				ID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Reviews: &[]usecase.FindSeriesByIDReview{
+					{
+						ID:       "26efa50a-953e-4aeb-befb-ccc14058989b",
+						AuthorID: "635c4bb1-41b1-46e9-a87e-292a9d63b9e3",
+						Text:     "Review text",
+						Author: &usecase.FindSeriesByIDAuthor{
+							ID:      "635c4bb1-41b1-46e9-a87e-292a9d63b9e3",
+							Reviews: 3,
+						},
+						Version:   1,
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "Translated in the first accepted language with a translation",
+			Input: Input{
+				Series: domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithCreators(testCreators).WithTranslations([]domain.Translation{
+					domain.NewTranslation("de", "Der Titel", "Die Beschreibung"),
+					domain.NewTranslation("fr", "Le Titre", "La description"),
+				}),
+				Request: usecase.FindSeriesByIDInput{
+					Fields: []string{"title", "description", "translations"},
+				},
+				Languages: []string{"it", "fr", "de"},
+			},
+			Want: usecase.FindSeriesByIDOutput{
+				Title:        "Le Titre",
+				Description:  "La description",
+				Translations: &[]string{"de", "fr"},
+			},
+		},
+		{
+			Description: "Default language before the translations",
+			Input: Input{
+				Series: domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithCreators(testCreators).WithTranslations([]domain.Translation{
+					domain.NewTranslation("de", "Der Titel", "Die Beschreibung"),
+					domain.NewTranslation("fr", "Le Titre", "La description"),
+				}),
+				Request: usecase.FindSeriesByIDInput{
+					Fields: []string{"title", "description"},
+				},
+				Languages: []string{"en", "fr"},
+			},
+			Want: usecase.FindSeriesByIDOutput{
+				Title:       "Title",
+				Description: "Description",
 			},
 		},
 	}
@@ -100,9 +276,58 @@ func TestFindSeriesByIDPresenter(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Description, func(t *testing.T) {
 			assert := assert.New(t)
-			presenter := NewFindSeriesByIDPresenter()
-			got := presenter.Output(test.Input.Series, test.Input.Reviews)
+			presenter := NewFindSeriesByIDPresenter(test.Input.Languages)
+			got := presenter.Output(
+				test.Input.Series,
+				test.Input.Reviews,
+				test.Input.Request,
+			)
 			assert.Equal(test.Want, got)
 		})
 	}
 }
+
+func TestFindSeriesByIDPresenterZeroValues(t *testing.T) {
+	t.Parallel()
+
+	series := domain.NewSeries(
+		domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		"Title",
+		"Description",
+		2022,
+		0,
+	)
+	reviews := []domain.Review{
+		domain.NewReview(
+			domain.ReviewID("26efa50a-953e-4aeb-befb-ccc14058989b"),
+			domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+			domain.AuthorID("635c4bb1-41b1-46e9-a87e-292a9d63b9e3"),
+			"Review text",
+		),
+	}
+	output := NewFindSeriesByIDPresenter(nil).Output(
+		series,
+		reviews,
+		usecase.FindSeriesByIDInput{
+			Fields:  []string{"episodes", "end_year", "rating"},
+			Include: []string{"reviews"},
+		},
+	)
+
+	raw, err := json.Marshal(output)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"episodes": 0,
+		"end_year": 0,
+		"rating": {"average": 0, "count": 0},
+		"reviews": [{
+			"id": "26efa50a-953e-4aeb-befb-ccc14058989b",
+			"author_id": "635c4bb1-41b1-46e9-a87e-292a9d63b9e3",
+			"text": "Review text",
+			"rating": null,
+			"version": 1,
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		}]
+	}`, string(raw))
+}
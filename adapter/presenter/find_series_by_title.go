@@ -5,24 +5,40 @@ import (
 	"series/usecase"
 )
 
-type findSeriesByTitlePresenter struct{}
+type findSeriesByTitlePresenter struct {
+	languages []string
+}
 
-func NewFindSeriesByTitlePresenter() usecase.FindSeriesByTitlePresenter {
-	return findSeriesByTitlePresenter{}
+// NewFindSeriesByTitlePresenter translates the series in the first of
+// the accepted languages they have a translation in.
+func NewFindSeriesByTitlePresenter(languages []string) usecase.FindSeriesByTitlePresenter {
+	return findSeriesByTitlePresenter{languages: languages}
 }
 
-func (findSeriesByTitlePresenter) Output(series []domain.Series) usecase.FindSeriesByTitleOutput {
+func (p findSeriesByTitlePresenter) Output(series []domain.Series) usecase.FindSeriesByTitleOutput {
 	output := usecase.FindSeriesByTitleOutput{
 		Series: make([]usecase.FindSeriesByTitleSeries, len(series)),
 	}
 
 	for i, series := range series {
+		title, _ := translate(series, p.languages)
 		output.Series[i] = usecase.FindSeriesByTitleSeries{
-			ID:        series.ID().String(),
-			Title:     series.Title(),
-			BeginYear: series.BeginYear(),
-			EndYear:   series.EndYear(),
-			Creator:   series.Creator(),
+			ID:           series.ID().String(),
+			Slug:         series.Slug(),
+			Title:        title,
+			BeginYear:    series.BeginYear(),
+			EndYear:      series.EndYear(),
+			Creator:      series.Creator(),
+			Status:       series.Status().String(),
+			Genres:       formatList(series.Genres()),
+			Tags:         formatList(series.Tags()),
+			NetworkID:    series.NetworkID().String(),
+			Network:      series.Network(),
+			Country:      series.Country(),
+			Language:     series.Language(),
+			MatchedAlias: series.MatchedAlias(),
+			CreatedAt:    formatTime(series.CreatedAt()),
+			UpdatedAt:    formatTime(series.UpdatedAt()),
 		}
 	}
 	return output
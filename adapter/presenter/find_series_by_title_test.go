@@ -14,6 +14,7 @@ func TestFindSeriesByTitlePresenter(t *testing.T) {
 	type Test struct {
 		Description string
 		Input       []domain.Series
+		Languages   []string
 		Want        usecase.FindSeriesByTitleOutput
 	}
 	tests := []Test{
@@ -24,20 +25,123 @@ func TestFindSeriesByTitlePresenter(t *testing.T) {
 					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 					"Title",
 					"Description",
-					20,
 					1980,
 					1990,
-					"Creator",
-				),
+				).WithCreators(testCreators).WithEpisodes(20).
+					WithGenres([]string{"Drama"}).
+					WithTags([]string{"anthology", "period"}).
+					WithSlug("title-1980").
+					WithNetwork("8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11", "HBO").
+					WithOrigin("US", "en").
+					WithTimestamps(testCreatedAt, testUpdatedAt),
 			},
 			Want: usecase.FindSeriesByTitleOutput{
 				Series: []usecase.FindSeriesByTitleSeries{
 					{
 						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Slug:      "title-1980",
 						Title:     "Title",
 						BeginYear: 1980,
 						EndYear:   1990,
-						Creator:   "Creator",
+						Creator:   "Vince Gilligan & Peter Gould",
+						Status:    "ended",
+						Genres:    []string{"Drama"},
+						Tags:      []string{"anthology", "period"},
+						NetworkID: "8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11",
+						Network:   "HBO",
+						Country:   "US",
+						Language:  "en",
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No genres or tags means empty slices, not nil",
+			Input: []domain.Series{
+				domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					1990,
+				).WithCreators(testCreators).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindSeriesByTitleOutput{
+				Series: []usecase.FindSeriesByTitleSeries{
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Title:     "Title",
+						BeginYear: 1980,
+						EndYear:   1990,
+						Creator:   "Vince Gilligan & Peter Gould",
+						Status:    "ended",
+						Genres:    []string{},
+						Tags:      []string{},
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "Translated title",
+			Input: []domain.Series{
+				domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithCreators(testCreators).WithTranslations([]domain.Translation{
+					domain.NewTranslation("fr", "Le Titre", "La description"),
+				}).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Languages: []string{"fr", "en"},
+			Want: usecase.FindSeriesByTitleOutput{
+				Series: []usecase.FindSeriesByTitleSeries{
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Title:     "Le Titre",
+						BeginYear: 1980,
+						Creator:   "Vince Gilligan & Peter Gould",
+						Status:    "airing",
+						Genres:    []string{},
+						Tags:      []string{},
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "Matched alias",
+			Input: []domain.Series{
+				domain.NewSeries(
+					domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"Money Heist",
+					"Description",
+					2017,
+					2021,
+				).WithCreators(testCreators).WithAliases([]string{"La casa de papel"}).
+					WithMatchedAlias("La casa de papel").
+					WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindSeriesByTitleOutput{
+				Series: []usecase.FindSeriesByTitleSeries{
+					{
+						ID:           "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						Title:        "Money Heist",
+						BeginYear:    2017,
+						EndYear:      2021,
+						Creator:      "Vince Gilligan & Peter Gould",
+						Status:       "ended",
+						Genres:       []string{},
+						Tags:         []string{},
+						MatchedAlias: "La casa de papel",
+						CreatedAt:    "2022-10-01T12:00:00Z",
+						UpdatedAt:    "2022-10-02T08:30:00Z",
 					},
 				},
 			},
@@ -54,7 +158,7 @@ func TestFindSeriesByTitlePresenter(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Description, func(t *testing.T) {
 			assert := assert.New(t)
-			presenter := NewFindSeriesByTitlePresenter()
+			presenter := NewFindSeriesByTitlePresenter(test.Languages)
 			got := presenter.Output(test.Input)
 			assert.Equal(test.Want, got, test.Description)
 		})
@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findWebhookByIDPresenter struct{}
+
+func NewFindWebhookByIDPresenter() usecase.FindWebhookByIDPresenter {
+	return findWebhookByIDPresenter{}
+}
+
+func (findWebhookByIDPresenter) Output(webhook domain.Webhook) usecase.FindWebhookByIDOutput {
+	return usecase.FindWebhookByIDOutput{
+		ID:        webhook.ID().String(),
+		URL:       webhook.URL(),
+		Events:    eventNames(webhook.Events()),
+		CreatedAt: formatTime(webhook.CreatedAt()),
+		UpdatedAt: formatTime(webhook.UpdatedAt()),
+	}
+}
@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type findWebhooksPresenter struct{}
+
+func NewFindWebhooksPresenter() usecase.FindWebhooksPresenter {
+	return findWebhooksPresenter{}
+}
+
+func (findWebhooksPresenter) Output(
+	webhooks []domain.Webhook,
+) usecase.FindWebhooksOutput {
+	output := usecase.FindWebhooksOutput{
+		Webhooks: make([]usecase.FindWebhooksWebhook, len(webhooks)),
+	}
+
+	for i, webhook := range webhooks {
+		output.Webhooks[i] = usecase.FindWebhooksWebhook{
+			ID:        webhook.ID().String(),
+			URL:       webhook.URL(),
+			Events:    eventNames(webhook.Events()),
+			CreatedAt: formatTime(webhook.CreatedAt()),
+			UpdatedAt: formatTime(webhook.UpdatedAt()),
+		}
+	}
+	return output
+}
@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindWebhooksPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       []domain.Webhook
+		Want        usecase.FindWebhooksOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: []domain.Webhook{
+				domain.NewWebhook(
+					domain.WebhookID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+					"http://localhost/hook",
+					"0123456789abcdef",
+					[]domain.EventType{domain.EventSeriesCreated},
+				).WithTimestamps(testCreatedAt, testUpdatedAt),
+			},
+			Want: usecase.FindWebhooksOutput{
+				Webhooks: []usecase.FindWebhooksWebhook{
+					{
+						ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+						URL:       "http://localhost/hook",
+						Events:    []string{"series.created"},
+						CreatedAt: "2022-10-01T12:00:00Z",
+						UpdatedAt: "2022-10-02T08:30:00Z",
+					},
+				},
+			},
+		},
+		{
+			Description: "No webhooks means empty slice, not nil",
+			Input:       nil,
+			Want: usecase.FindWebhooksOutput{
+				Webhooks: []usecase.FindWebhooksWebhook{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewFindWebhooksPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
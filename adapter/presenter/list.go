@@ -0,0 +1,10 @@
+package presenter
+
+// formatList keeps lists that are always part of an
+// output from being encoded as null when empty.
+func formatList(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+func formatRating(rating domain.Rating) usecase.RatingOutput {
+	return usecase.RatingOutput{
+		Average: rating.Average(),
+		Count:   rating.Count(),
+	}
+}
+
+// formatReviewRating returns nil for an unrated review, whose rating
+// is 0.
+func formatReviewRating(rating int) *int {
+	if rating == 0 {
+		return nil
+	}
+	return &rating
+}
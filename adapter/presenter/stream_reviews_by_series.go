@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type streamReviewsBySeriesPresenter struct{}
+
+func NewStreamReviewsBySeriesPresenter() usecase.StreamReviewsBySeriesPresenter {
+	return streamReviewsBySeriesPresenter{}
+}
+
+func (streamReviewsBySeriesPresenter) Output(
+	review domain.Review,
+) usecase.StreamReviewsBySeriesOutput {
+	return usecase.StreamReviewsBySeriesOutput{
+		ID:        review.ID().String(),
+		EpisodeID: review.EpisodeID().String(),
+		AuthorID:  review.AuthorID().String(),
+		Text:      review.Text(),
+		Rating:    review.Rating(),
+		Version:   review.Version(),
+		CreatedAt: formatTime(review.CreatedAt()),
+		UpdatedAt: formatTime(review.UpdatedAt()),
+	}
+}
@@ -0,0 +1,18 @@
+package presenter
+
+import (
+	"series/usecase"
+	"time"
+)
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatDate formats a day, the zero time is a day that isn't known.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(usecase.DateLayout)
+}
@@ -0,0 +1,28 @@
+package presenter
+
+import "series/domain"
+
+// translate returns the title and description of the series in the first
+// of the languages it has a translation in, or the default ones. The
+// default language stops the search, its title and description are the
+// ones of the series.
+func translate(series domain.Series, languages []string) (string, string) {
+	for _, language := range languages {
+		if language == domain.DefaultLanguage {
+			break
+		}
+		if translation, ok := series.Translation(language); ok {
+			return translation.Title(), translation.Description()
+		}
+	}
+	return series.Title(), series.Description()
+}
+
+// formatTranslations lists the languages the series is translated in.
+func formatTranslations(series domain.Series) []string {
+	languages := make([]string, len(series.Translations()))
+	for i, translation := range series.Translations() {
+		languages[i] = translation.Language()
+	}
+	return languages
+}
@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateReviewPresenter struct{}
+
+func NewUpdateReviewPresenter() usecase.UpdateReviewPresenter {
+	return updateReviewPresenter{}
+}
+
+func (updateReviewPresenter) Output(review domain.Review) usecase.UpdateReviewOutput {
+	return usecase.UpdateReviewOutput{
+		ID:        review.ID().String(),
+		SeriesID:  review.SeriesID().String(),
+		EpisodeID: review.EpisodeID().String(),
+		AuthorID:  review.AuthorID().String(),
+		Text:      review.Text(),
+		Rating:    review.Rating(),
+		Version:   review.Version(),
+		CreatedAt: formatTime(review.CreatedAt()),
+		UpdatedAt: formatTime(review.UpdatedAt()),
+	}
+}
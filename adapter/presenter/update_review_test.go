@@ -0,0 +1,48 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateReviewPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Review
+		Want        usecase.UpdateReviewOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewReview(
+				domain.ReviewID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				domain.AuthorID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"Review text",
+			).WithTimestamps(testCreatedAt, testUpdatedAt).WithVersion(3),
+			Want: usecase.UpdateReviewOutput{
+				ID:        "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				SeriesID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				AuthorID:  "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Text:      "Review text",
+				Version:   3,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateReviewPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
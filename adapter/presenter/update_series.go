@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesPresenter struct{}
+
+func NewUpdateSeriesPresenter() usecase.UpdateSeriesPresenter {
+	return updateSeriesPresenter{}
+}
+
+func (updateSeriesPresenter) Output(series domain.Series) usecase.UpdateSeriesOutput {
+	return usecase.UpdateSeriesOutput{
+		ID:          series.ID().String(),
+		Slug:        series.Slug(),
+		Title:       series.Title(),
+		Description: series.Description(),
+		Episodes:    series.Episodes(),
+		BeginYear:   series.BeginYear(),
+		EndYear:     series.EndYear(),
+		Creator:     series.Creator(),
+		CreatorIDs:  formatCreatorIDs(series.Creators()),
+		Status:      series.Status().String(),
+		NetworkID:   series.NetworkID().String(),
+		Network:     series.Network(),
+		Country:     series.Country(),
+		Language:    series.Language(),
+		Version:     series.Version(),
+		CreatedAt:   formatTime(series.CreatedAt()),
+		UpdatedAt:   formatTime(series.UpdatedAt()),
+	}
+}
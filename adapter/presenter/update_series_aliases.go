@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesAliasesPresenter struct{}
+
+func NewUpdateSeriesAliasesPresenter() usecase.UpdateSeriesAliasesPresenter {
+	return updateSeriesAliasesPresenter{}
+}
+
+func (updateSeriesAliasesPresenter) Output(
+	seriesID domain.SeriesID,
+	aliases []string,
+) usecase.UpdateSeriesAliasesOutput {
+	return usecase.UpdateSeriesAliasesOutput{
+		SeriesID: seriesID.String(),
+		Aliases:  formatList(aliases),
+	}
+}
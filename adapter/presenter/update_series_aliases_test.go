@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesAliasesPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesID    domain.SeriesID
+		Aliases     []string
+		Want        usecase.UpdateSeriesAliasesOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Aliases:     []string{"La casa de papel", "Money Heist"},
+			Want: usecase.UpdateSeriesAliasesOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Aliases:  []string{"La casa de papel", "Money Heist"},
+			},
+		},
+		{
+			Description: "No aliases means empty slice, not nil",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Aliases:     nil,
+			Want: usecase.UpdateSeriesAliasesOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Aliases:  []string{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesAliasesPresenter()
+			got := presenter.Output(test.SeriesID, test.Aliases)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesExternalIDsPresenter struct{}
+
+func NewUpdateSeriesExternalIDsPresenter() usecase.UpdateSeriesExternalIDsPresenter {
+	return updateSeriesExternalIDsPresenter{}
+}
+
+func (updateSeriesExternalIDsPresenter) Output(
+	seriesID domain.SeriesID,
+	externalIDs []domain.ExternalID,
+) usecase.UpdateSeriesExternalIDsOutput {
+	return usecase.UpdateSeriesExternalIDsOutput{
+		SeriesID:    seriesID.String(),
+		ExternalIDs: formatExternalIDs(externalIDs),
+	}
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesExternalIDsPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesID    domain.SeriesID
+		ExternalIDs []domain.ExternalID
+		Want        usecase.UpdateSeriesExternalIDsOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExternalIDs: []domain.ExternalID{
+				domain.NewExternalID(domain.ProviderIMDb, "tt0903747"),
+				domain.NewExternalID(domain.ProviderTMDB, "1396"),
+				domain.NewExternalID(domain.ProviderTVDB, "81189"),
+			},
+			Want: usecase.UpdateSeriesExternalIDsOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				ExternalIDs: usecase.ExternalIDsOutput{
+					IMDb: "tt0903747",
+					TVDB: "81189",
+					TMDB: "1396",
+				},
+			},
+		},
+		{
+			Description: "No external IDs",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			ExternalIDs: nil,
+			Want: usecase.UpdateSeriesExternalIDsOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesExternalIDsPresenter()
+			got := presenter.Output(test.SeriesID, test.ExternalIDs)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesGenresPresenter struct{}
+
+func NewUpdateSeriesGenresPresenter() usecase.UpdateSeriesGenresPresenter {
+	return updateSeriesGenresPresenter{}
+}
+
+func (updateSeriesGenresPresenter) Output(
+	seriesID domain.SeriesID,
+	genres []domain.Genre,
+) usecase.UpdateSeriesGenresOutput {
+	output := usecase.UpdateSeriesGenresOutput{
+		SeriesID: seriesID.String(),
+		Genres:   make([]string, len(genres)),
+	}
+
+	for i, genre := range genres {
+		output.Genres[i] = genre.Name()
+	}
+	return output
+}
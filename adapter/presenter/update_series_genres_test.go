@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesGenresPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesID    domain.SeriesID
+		Genres      []domain.Genre
+		Want        usecase.UpdateSeriesGenresOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Genres: []domain.Genre{
+				domain.NewGenre("GenreID1", "Drama"),
+				domain.NewGenre("GenreID2", "Thriller"),
+			},
+			Want: usecase.UpdateSeriesGenresOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Genres:   []string{"Drama", "Thriller"},
+			},
+		},
+		{
+			Description: "No genres means empty slice, not nil",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Genres:      nil,
+			Want: usecase.UpdateSeriesGenresOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Genres:   []string{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesGenresPresenter()
+			got := presenter.Output(test.SeriesID, test.Genres)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
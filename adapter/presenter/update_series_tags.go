@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesTagsPresenter struct{}
+
+func NewUpdateSeriesTagsPresenter() usecase.UpdateSeriesTagsPresenter {
+	return updateSeriesTagsPresenter{}
+}
+
+func (updateSeriesTagsPresenter) Output(
+	seriesID domain.SeriesID,
+	tags []string,
+) usecase.UpdateSeriesTagsOutput {
+	return usecase.UpdateSeriesTagsOutput{
+		SeriesID: seriesID.String(),
+		Tags:     formatList(tags),
+	}
+}
@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesTagsPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesID    domain.SeriesID
+		Tags        []string
+		Want        usecase.UpdateSeriesTagsOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Tags:        []string{"anthology", "period"},
+			Want: usecase.UpdateSeriesTagsOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Tags:     []string{"anthology", "period"},
+			},
+		},
+		{
+			Description: "No tags means empty slice, not nil",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Tags:        nil,
+			Want: usecase.UpdateSeriesTagsOutput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Tags:     []string{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesTagsPresenter()
+			got := presenter.Output(test.SeriesID, test.Tags)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesPresenterOutput(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Input       domain.Series
+		Want        usecase.UpdateSeriesOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			Input: domain.NewSeries(
+				domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+				"Title",
+				"Description",
+				1980,
+				1990,
+			).WithCreators(testCreators).WithEpisodes(20).WithSlug("title-1980").WithTimestamps(testCreatedAt, testUpdatedAt).WithVersion(2),
+			Want: usecase.UpdateSeriesOutput{
+				ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Title:       "Title",
+				Slug:        "title-1980",
+				Description: "Description",
+				Episodes:    20,
+				BeginYear:   1980,
+				EndYear:     1990,
+				Creator:     "Vince Gilligan & Peter Gould",
+				CreatorIDs: []string{
+					"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c",
+					"0d7e1b52-9c3a-4f6e-8a2b-1c4d5e6f7a8b",
+				},
+				Status:    "ended",
+				Version:   2,
+				CreatedAt: "2022-10-01T12:00:00Z",
+				UpdatedAt: "2022-10-02T08:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesPresenter()
+			got := presenter.Output(test.Input)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
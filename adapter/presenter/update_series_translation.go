@@ -0,0 +1,24 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateSeriesTranslationPresenter struct{}
+
+func NewUpdateSeriesTranslationPresenter() usecase.UpdateSeriesTranslationPresenter {
+	return updateSeriesTranslationPresenter{}
+}
+
+func (updateSeriesTranslationPresenter) Output(
+	seriesID domain.SeriesID,
+	translation domain.Translation,
+) usecase.UpdateSeriesTranslationOutput {
+	return usecase.UpdateSeriesTranslationOutput{
+		SeriesID:    seriesID.String(),
+		Language:    translation.Language(),
+		Title:       translation.Title(),
+		Description: translation.Description(),
+	}
+}
@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSeriesTranslationPresenter(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesID    domain.SeriesID
+		Translation domain.Translation
+		Want        usecase.UpdateSeriesTranslationOutput
+	}
+	tests := []Test{
+		{
+			Description: "Sanity check",
+			SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+			Translation: domain.NewTranslation("pt", "Irmãos de Guerra", "Série sobre a Easy Company"),
+			Want: usecase.UpdateSeriesTranslationOutput{
+				SeriesID:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Language:    "pt",
+				Title:       "Irmãos de Guerra",
+				Description: "Série sobre a Easy Company",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			presenter := NewUpdateSeriesTranslationPresenter()
+			got := presenter.Output(test.SeriesID, test.Translation)
+			assert.Equal(test.Want, got)
+		})
+	}
+}
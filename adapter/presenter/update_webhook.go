@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"series/domain"
+	"series/usecase"
+)
+
+type updateWebhookPresenter struct{}
+
+func NewUpdateWebhookPresenter() usecase.UpdateWebhookPresenter {
+	return updateWebhookPresenter{}
+}
+
+func (updateWebhookPresenter) Output(webhook domain.Webhook) usecase.UpdateWebhookOutput {
+	return usecase.UpdateWebhookOutput{
+		ID:        webhook.ID().String(),
+		URL:       webhook.URL(),
+		Events:    eventNames(webhook.Events()),
+		CreatedAt: formatTime(webhook.CreatedAt()),
+		UpdatedAt: formatTime(webhook.UpdatedAt()),
+	}
+}
@@ -0,0 +1,16 @@
+package presenter
+
+import (
+	"series/domain"
+	"time"
+)
+
+var (
+	testCreatedAt = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	testUpdatedAt = time.Date(2022, 10, 2, 8, 30, 0, 0, time.UTC)
+	testAirDate   = time.Date(2008, 1, 20, 0, 0, 0, 0, time.UTC)
+	testCreators  = []domain.Person{
+		domain.NewPerson("5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c", "Vince Gilligan"),
+		domain.NewPerson("0d7e1b52-9c3a-4f6e-8a2b-1c4d5e6f7a8b", "Peter Gould"),
+	}
+)
@@ -0,0 +1,29 @@
+package reporter
+
+import (
+	"context"
+	"time"
+)
+
+// Crash is what is known of a panic that happened while serving a request.
+type Crash struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Panic     string    `json:"panic"`
+	Stack     string    `json:"stack"`
+}
+
+// ErrorReporter sends crash reports where they get looked at, like a file
+// while developing or an error tracking service in production.
+type ErrorReporter interface {
+	Report(context.Context, Crash) error
+}
+
+// Discard drops every report.
+var Discard ErrorReporter = discard{}
+
+type discard struct{}
+
+func (discard) Report(context.Context, Crash) error { return nil }
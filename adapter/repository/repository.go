@@ -4,5 +4,14 @@ import "series/domain"
 
 type Repository interface {
 	NewSeriesRepository() domain.SeriesRepository
+	NewGenreRepository() domain.GenreRepository
+	NewNetworkRepository() domain.NetworkRepository
+	NewPersonRepository() domain.PersonRepository
+	NewCreditRepository() domain.CreditRepository
+	NewSeasonRepository() domain.SeasonRepository
+	NewEpisodeRepository() domain.EpisodeRepository
 	NewReviewRepository() domain.ReviewRepository
+	NewWebhookRepository() domain.WebhookRepository
+	NewDeliveryRepository() domain.DeliveryRepository
+	NewEventRepository() domain.EventRepository
 }
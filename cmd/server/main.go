@@ -4,24 +4,47 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"runtime/debug"
+	"series/adapter/health"
 	"series/adapter/logger"
+	"series/adapter/reporter"
 	"series/adapter/repository"
+	"series/domain"
 	"series/framework/database/postgres"
 	"series/framework/handler/gorilla"
+	"series/framework/handler/grpc"
 	"series/framework/logging/logrus"
+	"series/framework/metrics/prometheus"
+	"series/framework/pubsub/memory"
+	"series/framework/reporting/file"
+	"series/framework/tracing/opentelemetry"
 	"series/framework/validation/goplayground"
+	"series/framework/webhook/nethttp"
+	"series/usecase"
 	"syscall"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// version and commit are set when building with
+// -ldflags "-X main.version=... -X main.commit=...".
+var (
+	version = "dev"
+	commit  = ""
 )
 
 type Config struct {
 	Server struct {
-		Port int `env:"PORT"`
+		Port     int `env:"PORT"`
+		GRPCPort int `env:"GRPC_PORT" env-default:"9000"`
+		// DrainDelay is how long readiness fails before shutting down.
+		DrainDelay time.Duration `env:"DRAIN_DELAY" env-default:"5s"`
 	}
 	DB struct {
 		Type       string `env:"TYPE"`
@@ -33,17 +56,42 @@ type Config struct {
 		SSLEnabled string `env:"SSLMODE"`
 	} `env-prefix:"DB_"`
 	Logger struct {
-		Level string `env:"LVL"`
-		File  string `env:"FILE" env-default:"stderr"`
+		Level  string `env:"LVL"`
+		Format string `env:"FORMAT" env-default:"text"`
+		File   string `env:"FILE"   env-default:"stderr"`
 	} `env-prefix:"LOG_"`
+	Webhook struct {
+		MaxAttempts  int           `env:"MAX_ATTEMPTS"  env-default:"8"`
+		BaseDelay    time.Duration `env:"BASE_DELAY"    env-default:"30s"`
+		MaxDelay     time.Duration `env:"MAX_DELAY"     env-default:"1h"`
+		Timeout      time.Duration `env:"TIMEOUT"       env-default:"10s"`
+		PollInterval time.Duration `env:"POLL_INTERVAL" env-default:"1s"`
+	} `env-prefix:"WEBHOOK_"`
+	Tracing struct {
+		Exporter string `env:"EXPORTER" env-default:"none"`
+		Service  string `env:"SERVICE"  env-default:"series"`
+	} `env-prefix:"TRACING_"`
+	Outbox struct {
+		PollInterval time.Duration `env:"POLL_INTERVAL" env-default:"1s"`
+	} `env-prefix:"OUTBOX_"`
+	Crash struct {
+		Reporter string `env:"REPORTER" env-default:"file"`
+		Dir      string `env:"DIR"      env-default:"crashes"`
+	} `env-prefix:"CRASH_"`
 }
 
 func main() {
 	var (
 		config    Config
-		logger    logger.Logger
+		log       logger.Logger
+		crashes   reporter.ErrorReporter
 		repo      repository.Repository
+		streams                       = memory.NewReviewBroker(100)
+		broker    domain.ReviewBroker = streams
+		workers   []func(context.Context) error
+		deps      []health.Dependency
 		validator = goplayground.NewValidator()
+		metrics   = prometheus.New()
 		handler   http.Handler
 		server    *http.Server
 	)
@@ -70,6 +118,18 @@ func main() {
 		defer db.Close()
 
 		repo = db
+		if err := metrics.Register(prometheus.NewPoolCollector(db.Stat)); err != nil {
+			panic(err)
+		}
+
+		deps = append(deps,
+			health.Dependency{Name: "postgres", Check: db.Ping},
+			health.Dependency{Name: "schema", Check: db.CheckSchema},
+		)
+
+		bridge := db.NewReviewBridge(broker)
+		broker = bridge
+		workers = append(workers, bridge.Listen)
 
 	default:
 		panic("Unknown db type")
@@ -88,39 +148,200 @@ func main() {
 			defer logFile.Close()
 		}
 
-		logger, err = logrus.New(config.Logger.Level, logFile)
+		log, err = logrus.New(config.Logger.Level, config.Logger.Format, logFile)
 		if err != nil {
 			panic(err)
 		}
 	}
 
+	switch config.Crash.Reporter {
+	case "none":
+		crashes = reporter.Discard
+	case "file":
+		var err error
+		if crashes, err = file.NewReporter(config.Crash.Dir); err != nil {
+			panic(err)
+		}
+	default:
+		panic("Unknown crash reporter")
+	}
+
+	shutdownTracing, err := opentelemetry.New(
+		context.Background(),
+		config.Tracing.Exporter,
+		config.Tracing.Service,
+		os.Stdout,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	relayEvents := usecase.NewRelayEventsInteractor(
+		repo.NewEventRepository(),
+		usecase.NewFanOutPublisher(
+			usecase.NewWebhookDeliveriesPublisher(
+				repo.NewWebhookRepository(),
+				repo.NewDeliveryRepository(),
+			),
+			usecase.NewReviewBrokerPublisher(repo.NewReviewRepository(), broker),
+		),
+		10*time.Second,
+	)
+	workers = append(workers, func(ctx context.Context) error {
+		for {
+			output, err := relayEvents.Execute(ctx)
+			if err != nil {
+				return err
+			}
+			// Only wait once the outbox is drained.
+			if output.Published > 0 {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(config.Outbox.PollInterval):
+			}
+		}
+	})
+
+	deliverWebhooks := usecase.NewDeliverWebhooksInteractor(
+		repo.NewWebhookRepository(),
+		repo.NewDeliveryRepository(),
+		nethttp.NewSender(&http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+			Timeout:   config.Webhook.Timeout,
+		}),
+		usecase.RetryPolicy{
+			MaxAttempts: config.Webhook.MaxAttempts,
+			BaseDelay:   config.Webhook.BaseDelay,
+			MaxDelay:    config.Webhook.MaxDelay,
+		},
+		10*time.Second,
+	)
+	workers = append(workers, func(ctx context.Context) error {
+		for {
+			output, err := deliverWebhooks.Execute(ctx)
+			if err != nil {
+				return err
+			}
+			// Only wait once no delivery is due anymore.
+			if output.Attempted() > 0 {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(config.Webhook.PollInterval):
+			}
+		}
+	})
+
+	workerCtx, stopWorkers := context.WithCancel(
+		logger.NewContext(context.Background(), log),
+	)
+	defer stopWorkers()
+	for _, work := range workers {
+		go func(work func(context.Context) error) {
+			for {
+				err := work(workerCtx)
+				if workerCtx.Err() != nil {
+					return
+				}
+				log.WithFields(logger.Fields{"error": err}).
+					Errorf("Background worker failed, restarting it")
+				time.Sleep(time.Second)
+			}
+		}(work)
+	}
+
+	monitor := health.NewMonitor(
+		health.Build{Version: version, Commit: buildCommit()},
+		deps...,
+	)
 	handler = gorilla.NewHandler(
 		repo,
-		logger,
+		broker,
+		log,
+		metrics,
+		monitor,
+		crashes,
 		validator,
 		10*time.Second,
 	)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/", handler)
 	server = &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Server.Port),
-		Handler: handler,
+		Handler: mux,
+	}
+	// Streams never end on their own, Shutdown would wait for them.
+	server.RegisterOnShutdown(streams.Close)
+
+	grpcServer := grpc.NewServer(
+		repo,
+		log,
+		metrics,
+		crashes,
+		validator,
+		10*time.Second,
+	)
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Server.GRPCPort))
+	if err != nil {
+		panic(err)
 	}
 
-	logger.Infof("Starting server at %s", server.Addr)
+	log.Infof("Starting server at %s", server.Addr)
 	go func() {
 		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 			panic(err)
 		}
 	}()
 
+	log.Infof("Starting gRPC server at %s", grpcListener.Addr())
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			panic(err)
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	logger.Infof("Server is shutting down...")
+	// Keep serving while load balancers notice that readiness fails.
+	monitor.Drain()
+	log.Infof("Server is draining for %s...", config.Server.DrainDelay)
+	time.Sleep(config.Server.DrainDelay)
+
+	log.Infof("Server is shutting down...")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	grpcServer.GracefulStop()
 	if err := server.Shutdown(ctx); err != nil {
-		panic(err)
+		log.WithFields(logger.Fields{"error": err}).
+			Errorf("Server failed to shut down gracefully")
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.WithFields(logger.Fields{"error": err}).
+			Errorf("Tracing failed to shut down")
+	}
+}
+
+// buildCommit falls back to the revision recorded by the Go toolchain
+// when the commit wasn't set at build time.
+func buildCommit() string {
+	if commit != "" {
+		return commit
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" {
+				return setting.Value
+			}
+		}
 	}
+	return "unknown"
 }
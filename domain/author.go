@@ -0,0 +1,26 @@
+package domain
+
+// Author is whoever wrote reviews. Authors are only known by the ID
+// their reviews were created with, repositories derive the rest from
+// those reviews.
+type Author struct {
+	id      AuthorID
+	reviews int
+}
+
+func NewAuthor(ID AuthorID, reviews int) Author {
+	return Author{
+		id:      ID,
+		reviews: reviews,
+	}
+}
+
+func (a *Author) ID() AuthorID {
+	return a.id
+}
+
+// Reviews is the number of reviews the author wrote, of series
+// and episodes alike.
+func (a *Author) Reviews() int {
+	return a.reviews
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type CreditID string
+
+func (id CreditID) String() string {
+	return string(id)
+}
+
+// CreditRole is what a person did on a series.
+type CreditRole string
+
+const (
+	CreditCreator  CreditRole = "creator"
+	CreditActor    CreditRole = "actor"
+	CreditWriter   CreditRole = "writer"
+	CreditDirector CreditRole = "director"
+)
+
+func (r CreditRole) String() string {
+	return string(r)
+}
+
+var ErrCreditExists = errors.New("this person already has this credit on this series")
+
+type (
+	// CreditRepository returns the credits of a series along with the
+	// names of the people and those of a person along with the titles
+	// of the series.
+	CreditRepository interface {
+		Create(context.Context, Credit) (Credit, error)
+		FindBySeries(context.Context, SeriesID) ([]Credit, error)
+		FindByPerson(context.Context, PersonID) ([]Credit, error)
+	}
+
+	// Credit relates a person to a series. Character is the one an
+	// actor played, episodes the number of episodes they appeared in.
+	Credit struct {
+		id          CreditID
+		seriesID    SeriesID
+		personID    PersonID
+		role        CreditRole
+		character   string
+		episodes    int
+		personName  string
+		seriesTitle string
+		createdAt   time.Time
+		updatedAt   time.Time
+	}
+)
+
+func NewCredit(
+	ID CreditID,
+	seriesID SeriesID,
+	personID PersonID,
+	role CreditRole,
+	character string,
+	episodes int,
+) Credit {
+	return Credit{
+		id:        ID,
+		seriesID:  seriesID,
+		personID:  personID,
+		role:      role,
+		character: character,
+		episodes:  episodes,
+	}
+}
+
+// WithNames returns a copy of the credit with the name of its
+// person and the title of its series, as repositories join them.
+func (c Credit) WithNames(personName, seriesTitle string) Credit {
+	c.personName = personName
+	c.seriesTitle = seriesTitle
+	return c
+}
+
+func (c Credit) WithTimestamps(createdAt, updatedAt time.Time) Credit {
+	c.createdAt = createdAt
+	c.updatedAt = updatedAt
+	return c
+}
+
+func (c *Credit) ID() CreditID {
+	return c.id
+}
+
+func (c *Credit) SeriesID() SeriesID {
+	return c.seriesID
+}
+
+func (c *Credit) PersonID() PersonID {
+	return c.personID
+}
+
+func (c *Credit) Role() CreditRole {
+	return c.role
+}
+
+func (c *Credit) Character() string {
+	return c.character
+}
+
+func (c *Credit) Episodes() int {
+	return c.episodes
+}
+
+func (c *Credit) PersonName() string {
+	return c.personName
+}
+
+func (c *Credit) SeriesTitle() string {
+	return c.seriesTitle
+}
+
+func (c *Credit) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Credit) UpdatedAt() time.Time {
+	return c.updatedAt
+}
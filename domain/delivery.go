@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type DeliveryID string
+
+func (id DeliveryID) String() string {
+	return string(id)
+}
+
+type DeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are waiting for their next attempt.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceeded deliveries got a 2xx response.
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryDead deliveries ran out of attempts and are not retried.
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+type (
+	DeliveryRepository interface {
+		// Create queues the delivery unless the event was
+		// queued for the webhook already.
+		Create(context.Context, Delivery) (Delivery, error)
+		// Claim leases up to limit pending deliveries that are due, so
+		// that concurrent workers don't attempt them too. A lease that
+		// isn't released by Update expires after the given duration.
+		Claim(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+		// FindByWebhook returns the last limit deliveries of a webhook,
+		// newest first.
+		FindByWebhook(ctx context.Context, ID WebhookID, limit int) ([]Delivery, error)
+		// Update stores the outcome of an attempt and releases the lease.
+		Update(context.Context, Delivery) (Delivery, error)
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// Delivery is an event queued for a webhook, along with
+	// the outcome of the attempts made to send it.
+	Delivery struct {
+		id             DeliveryID
+		webhookID      WebhookID
+		eventID        EventID
+		event          EventType
+		payload        []byte
+		status         DeliveryStatus
+		attempts       int
+		nextAttemptAt  time.Time
+		lastStatusCode int
+		lastError      string
+		createdAt      time.Time
+		updatedAt      time.Time
+	}
+)
+
+// NewDelivery queues an event for a webhook, due immediately.
+func NewDelivery(
+	ID DeliveryID,
+	webhookID WebhookID,
+	event Event,
+) Delivery {
+	return Delivery{
+		id:        ID,
+		webhookID: webhookID,
+		eventID:   event.ID(),
+		event:     event.Type(),
+		payload:   event.Payload(),
+		status:    DeliveryPending,
+	}
+}
+
+// WithState returns a copy of the delivery as left by its last attempt,
+// repositories use it to restore stored deliveries.
+func (d Delivery) WithState(
+	status DeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastStatusCode int,
+	lastError string,
+) Delivery {
+	d.status = status
+	d.attempts = attempts
+	d.nextAttemptAt = nextAttemptAt
+	d.lastStatusCode = lastStatusCode
+	d.lastError = lastError
+	return d
+}
+
+func (d Delivery) WithTimestamps(createdAt, updatedAt time.Time) Delivery {
+	d.createdAt = createdAt
+	d.updatedAt = updatedAt
+	return d
+}
+
+// Succeeded records a successful attempt.
+func (d Delivery) Succeeded(statusCode int) Delivery {
+	return d.WithState(DeliverySucceeded, d.attempts+1, time.Time{}, statusCode, "")
+}
+
+// Failed records a failed attempt, the delivery is attempted
+// again at retryAt, or never if retryAt is zero.
+func (d Delivery) Failed(statusCode int, reason string, retryAt time.Time) Delivery {
+	status := DeliveryPending
+	if retryAt.IsZero() {
+		status = DeliveryDead
+	}
+	return d.WithState(status, d.attempts+1, retryAt, statusCode, reason)
+}
+
+func (d *Delivery) ID() DeliveryID {
+	return d.id
+}
+
+func (d *Delivery) WebhookID() WebhookID {
+	return d.webhookID
+}
+
+func (d *Delivery) EventID() EventID {
+	return d.eventID
+}
+
+func (d *Delivery) Event() EventType {
+	return d.event
+}
+
+func (d *Delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+func (d *Delivery) Attempts() int {
+	return d.attempts
+}
+
+// NextAttemptAt is zero when the delivery is not going to be attempted
+// again, or when it was created and is due immediately.
+func (d *Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *Delivery) LastStatusCode() int {
+	return d.lastStatusCode
+}
+
+func (d *Delivery) LastError() string {
+	return d.lastError
+}
+
+func (d *Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Delivery) UpdatedAt() time.Time {
+	return d.updatedAt
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type EpisodeID string
+
+func (id EpisodeID) String() string {
+	return string(id)
+}
+
+var (
+	ErrEpisodeNotFound = errors.New("episode not found")
+	ErrEpisodeExists   = errors.New("this season already has an episode with this number")
+)
+
+type (
+	EpisodeRepository interface {
+		Create(context.Context, Episode) (Episode, error)
+		FindByID(context.Context, EpisodeID) (Episode, error)
+		FindBySeason(context.Context, SeasonID) ([]Episode, error)
+	}
+
+	Episode struct {
+		id        EpisodeID
+		seasonID  SeasonID
+		seriesID  SeriesID
+		number    int
+		title     string
+		airDate   time.Time
+		runtime   int
+		synopsis  string
+		rating    Rating
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+// NewEpisode returns the episode numbered number of a season. airDate is
+// the zero time and runtime, in minutes, is 0 when they aren't known.
+func NewEpisode(
+	ID EpisodeID,
+	seasonID SeasonID,
+	seriesID SeriesID,
+	number int,
+	title string,
+	airDate time.Time,
+	runtime int,
+	synopsis string,
+) Episode {
+	return Episode{
+		id:       ID,
+		seasonID: seasonID,
+		seriesID: seriesID,
+		number:   number,
+		title:    title,
+		airDate:  airDate,
+		runtime:  runtime,
+		synopsis: synopsis,
+	}
+}
+
+// WithRating returns a copy of the episode with the given rating.
+// Repositories aggregate the ratings of its reviews.
+func (e Episode) WithRating(rating Rating) Episode {
+	e.rating = rating
+	return e
+}
+
+func (e Episode) WithTimestamps(createdAt, updatedAt time.Time) Episode {
+	e.createdAt = createdAt
+	e.updatedAt = updatedAt
+	return e
+}
+
+func (e *Episode) ID() EpisodeID {
+	return e.id
+}
+
+func (e *Episode) SeasonID() SeasonID {
+	return e.seasonID
+}
+
+func (e *Episode) SeriesID() SeriesID {
+	return e.seriesID
+}
+
+func (e *Episode) Number() int {
+	return e.number
+}
+
+func (e *Episode) Title() string {
+	return e.title
+}
+
+func (e *Episode) AirDate() time.Time {
+	return e.airDate
+}
+
+// Runtime is the length of the episode in minutes.
+func (e *Episode) Runtime() int {
+	return e.runtime
+}
+
+func (e *Episode) Synopsis() string {
+	return e.synopsis
+}
+
+func (e *Episode) Rating() Rating {
+	return e.rating
+}
+
+func (e *Episode) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e *Episode) UpdatedAt() time.Time {
+	return e.updatedAt
+}
@@ -0,0 +1,5 @@
+package domain
+
+import "errors"
+
+var ErrConcurrentModification = errors.New("resource was modified concurrently")
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type EventID string
+
+func (id EventID) String() string {
+	return string(id)
+}
+
+// EventType names what happened in the catalog, webhooks
+// subscribe to the event types they want to be called for.
+type EventType string
+
+const (
+	EventSeriesCreated EventType = "series.created"
+	EventReviewCreated EventType = "review.created"
+)
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+type (
+	// EventRepository is the outbox of the domain events. Events are
+	// created in the transaction of the change they describe, so that
+	// they are stored if and only if the change is, then relayed to an
+	// EventPublisher.
+	EventRepository interface {
+		Create(context.Context, Event) (Event, error)
+		// Claim leases up to limit unpublished events, oldest first, so
+		// that concurrent relays don't publish them too. A lease that
+		// isn't released by Delete expires after the given duration.
+		Claim(ctx context.Context, limit int, lease time.Duration) ([]Event, error)
+		// Delete removes a published event from the outbox.
+		Delete(context.Context, EventID) error
+	}
+
+	// EventPublisher hands events over to their consumers. Events are
+	// published at least once: a publisher may get an event again if
+	// the relay fails before the event is removed from the outbox.
+	EventPublisher interface {
+		Publish(context.Context, Event) error
+	}
+
+	// Event records a change of an aggregate, a series or a review,
+	// with a JSON payload describing it.
+	Event struct {
+		id          EventID
+		eventType   EventType
+		aggregateID string
+		payload     []byte
+		occurredAt  time.Time
+	}
+)
+
+func NewEvent(
+	ID EventID,
+	eventType EventType,
+	aggregateID string,
+	payload []byte,
+) Event {
+	return Event{
+		id:          ID,
+		eventType:   eventType,
+		aggregateID: aggregateID,
+		payload:     payload,
+	}
+}
+
+func (e Event) WithOccurredAt(occurredAt time.Time) Event {
+	e.occurredAt = occurredAt
+	return e
+}
+
+func (e *Event) ID() EventID {
+	return e.id
+}
+
+func (e *Event) Type() EventType {
+	return e.eventType
+}
+
+func (e *Event) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *Event) Payload() []byte {
+	return e.payload
+}
+
+func (e *Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+)
+
+var ErrExternalIDExists = errors.New("another series already has this external id")
+
+// ExternalIDProvider is a catalog series are mapped to by their IDs in it.
+type ExternalIDProvider string
+
+const (
+	ProviderIMDb ExternalIDProvider = "imdb"
+	ProviderTVDB ExternalIDProvider = "tvdb"
+	ProviderTMDB ExternalIDProvider = "tmdb"
+)
+
+// externalIDFormats are the formats of the IDs of each provider: IMDb
+// titles are "tt" and 7 or 8 digits, TVDB and TMDB use plain numbers.
+var externalIDFormats = map[ExternalIDProvider]*regexp.Regexp{
+	ProviderIMDb: regexp.MustCompile(`^tt[0-9]{7,8}$`),
+	ProviderTVDB: regexp.MustCompile(`^[1-9][0-9]{0,9}$`),
+	ProviderTMDB: regexp.MustCompile(`^[1-9][0-9]{0,9}$`),
+}
+
+func (p ExternalIDProvider) String() string {
+	return string(p)
+}
+
+// IsValidID reports whether the ID has the format of the provider,
+// always false for unknown providers.
+func (p ExternalIDProvider) IsValidID(ID string) bool {
+	format, ok := externalIDFormats[p]
+	return ok && format.MatchString(ID)
+}
+
+// ExternalID is the ID of a series in the catalog of a provider. A series
+// has at most one per provider, and no two series share one.
+type ExternalID struct {
+	provider ExternalIDProvider
+	id       string
+}
+
+func NewExternalID(provider ExternalIDProvider, ID string) ExternalID {
+	return ExternalID{
+		provider: provider,
+		id:       ID,
+	}
+}
+
+func (e *ExternalID) Provider() ExternalIDProvider {
+	return e.provider
+}
+
+func (e *ExternalID) ID() string {
+	return e.id
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type GenreID string
+
+func (id GenreID) String() string {
+	return string(id)
+}
+
+var (
+	ErrGenreNotFound = errors.New("genre not found")
+	ErrGenreExists   = errors.New("a genre with this name already exists")
+)
+
+type (
+	// GenreRepository matches genre names case insensitively,
+	// "Drama" and "drama" name the same genre.
+	GenreRepository interface {
+		Create(context.Context, Genre) (Genre, error)
+		FindAll(context.Context) ([]Genre, error)
+		FindByNames(context.Context, []string) ([]Genre, error)
+	}
+
+	Genre struct {
+		id        GenreID
+		name      string
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+func NewGenre(ID GenreID, name string) Genre {
+	return Genre{
+		id:   ID,
+		name: name,
+	}
+}
+
+func (g Genre) WithTimestamps(createdAt, updatedAt time.Time) Genre {
+	g.createdAt = createdAt
+	g.updatedAt = updatedAt
+	return g
+}
+
+func (g *Genre) ID() GenreID {
+	return g.id
+}
+
+func (g *Genre) Name() string {
+	return g.name
+}
+
+func (g *Genre) CreatedAt() time.Time {
+	return g.createdAt
+}
+
+func (g *Genre) UpdatedAt() time.Time {
+	return g.updatedAt
+}
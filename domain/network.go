@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type NetworkID string
+
+func (id NetworkID) String() string {
+	return string(id)
+}
+
+var (
+	ErrNetworkNotFound = errors.New("network not found")
+	ErrNetworkExists   = errors.New("a network with this name already exists")
+)
+
+type (
+	// NetworkRepository matches network names case insensitively,
+	// "HBO" and "hbo" name the same network.
+	NetworkRepository interface {
+		Create(context.Context, Network) (Network, error)
+		FindAll(context.Context) ([]Network, error)
+		FindByID(context.Context, NetworkID) (Network, error)
+	}
+
+	// Network broadcasts series. Its country is an ISO 3166-1 alpha-2
+	// code, like "US", empty when unknown.
+	Network struct {
+		id        NetworkID
+		name      string
+		country   string
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+func NewNetwork(ID NetworkID, name, country string) Network {
+	return Network{
+		id:      ID,
+		name:    name,
+		country: country,
+	}
+}
+
+func (n Network) WithTimestamps(createdAt, updatedAt time.Time) Network {
+	n.createdAt = createdAt
+	n.updatedAt = updatedAt
+	return n
+}
+
+func (n *Network) ID() NetworkID {
+	return n.id
+}
+
+func (n *Network) Name() string {
+	return n.name
+}
+
+func (n *Network) Country() string {
+	return n.country
+}
+
+func (n *Network) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+func (n *Network) UpdatedAt() time.Time {
+	return n.updatedAt
+}
@@ -0,0 +1,8 @@
+package domain
+
+// Page selects Limit items of a list after skipping Offset of them.
+// A zero Limit selects all of them.
+type Page struct {
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type PersonID string
+
+func (id PersonID) String() string {
+	return string(id)
+}
+
+var ErrPersonNotFound = errors.New("person not found")
+
+type (
+	PersonRepository interface {
+		Create(context.Context, Person) (Person, error)
+		FindByID(context.Context, PersonID) (Person, error)
+	}
+
+	// Person is anyone credited on a series, names aren't unique.
+	Person struct {
+		id        PersonID
+		name      string
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+func NewPerson(ID PersonID, name string) Person {
+	return Person{
+		id:   ID,
+		name: name,
+	}
+}
+
+func (p Person) WithTimestamps(createdAt, updatedAt time.Time) Person {
+	p.createdAt = createdAt
+	p.updatedAt = updatedAt
+	return p
+}
+
+func (p *Person) ID() PersonID {
+	return p.id
+}
+
+func (p *Person) Name() string {
+	return p.name
+}
+
+func (p *Person) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Person) UpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,23 @@
+package domain
+
+// Rating aggregates the ratings of a set of reviews, the ones
+// left unrated are not counted.
+type Rating struct {
+	average float64
+	count   int
+}
+
+func NewRating(average float64, count int) Rating {
+	return Rating{
+		average: average,
+		count:   count,
+	}
+}
+
+func (r Rating) Average() float64 {
+	return r.average
+}
+
+func (r Rating) Count() int {
+	return r.count
+}
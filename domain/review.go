@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 type AuthorID string
@@ -19,22 +20,50 @@ func (id ReviewID) String() string {
 
 var (
 	ErrReviewNotFound  = errors.New("review not found")
-	ErrAlreadyReviewed = errors.New("this author already reviewed this series")
+	ErrAlreadyReviewed = errors.New("this author already reviewed this series or episode")
 )
 
 type (
 	ReviewRepository interface {
 		Create(context.Context, Review) (Review, error)
-		FindBySeries(context.Context, SeriesID) ([]Review, error)
-		Reviewed(context.Context, SeriesID, AuthorID) error
+		FindByID(context.Context, ReviewID) (Review, error)
+		// FindBySeries and FindBySeriesIDs find the reviews of the series
+		// themselves, FindByEpisode the reviews of one of their episodes.
+		FindBySeries(context.Context, SeriesID, Page) ([]Review, error)
+		FindBySeriesIDs(context.Context, []SeriesID) ([]Review, error)
+		FindByEpisode(context.Context, EpisodeID) ([]Review, error)
+		// Reviewed returns ErrAlreadyReviewed if the author reviewed the
+		// episode, or the series itself when episodeID is empty.
+		Reviewed(ctx context.Context, seriesID SeriesID, episodeID EpisodeID, authorID AuthorID) error
+		// FindAuthors finds the authors with the given IDs, those who
+		// wrote no review are left out.
+		FindAuthors(context.Context, []AuthorID) ([]Author, error)
+		Update(context.Context, Review) (Review, error)
 		WithTransaction(context.Context, func(context.Context) error) error
 	}
 
+	// ReviewBroker delivers newly created reviews to the
+	// subscribers of their series.
+	ReviewBroker interface {
+		Publish(context.Context, Review) error
+		// Subscribe first replays the reviews published after lastID, if it
+		// is still known, then streams new ones until ctx is done.
+		Subscribe(ctx context.Context, seriesID SeriesID, lastID ReviewID) (<-chan Review, error)
+	}
+
+	// Review targets a series, or one of its episodes when
+	// EpisodeID is not empty.
 	Review struct {
-		id       ReviewID
-		seriesID SeriesID
-		author   AuthorID
-		text     string
+		id         ReviewID
+		seriesID   SeriesID
+		episodeID  EpisodeID
+		author     AuthorID
+		authorInfo Author
+		text       string
+		rating     int
+		version    int
+		createdAt  time.Time
+		updatedAt  time.Time
 	}
 )
 
@@ -49,9 +78,49 @@ func NewReview(
 		seriesID: seriesID,
 		author:   authorID,
 		text:     text,
+		version:  1,
 	}
 }
 
+// WithVersion returns a copy of the review expected to be stored
+// with the given version. Repositories use it to detect concurrent updates.
+func (r Review) WithVersion(version int) Review {
+	r.version = version
+	return r
+}
+
+// WithEpisode returns a copy of the review targeting an episode
+// of its series instead of the series itself.
+func (r Review) WithEpisode(episodeID EpisodeID) Review {
+	r.episodeID = episodeID
+	return r
+}
+
+// WithRating returns a copy of the review rating its target
+// from 1 to 10, 0 leaves it unrated.
+func (r Review) WithRating(rating int) Review {
+	r.rating = rating
+	return r
+}
+
+func (r Review) WithTimestamps(createdAt, updatedAt time.Time) Review {
+	r.createdAt = createdAt
+	r.updatedAt = updatedAt
+	return r
+}
+
+// WithAuthor returns a copy of the review with the details of
+// its author, as use cases embed them.
+func (r Review) WithAuthor(author Author) Review {
+	r.authorInfo = author
+	return r
+}
+
+func (r Review) WithText(text string) Review {
+	r.text = text
+	return r
+}
+
 func (r *Review) ID() ReviewID {
 	return r.id
 }
@@ -60,10 +129,36 @@ func (r *Review) SeriesID() SeriesID {
 	return r.seriesID
 }
 
+func (r *Review) EpisodeID() EpisodeID {
+	return r.episodeID
+}
+
 func (r *Review) AuthorID() AuthorID {
 	return r.author
 }
 
+// Author is the author of the review set by WithAuthor,
+// the zero Author otherwise.
+func (r *Review) Author() Author {
+	return r.authorInfo
+}
+
 func (r *Review) Text() string {
 	return r.text
 }
+
+func (r *Review) Rating() int {
+	return r.rating
+}
+
+func (r *Review) Version() int {
+	return r.version
+}
+
+func (r *Review) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *Review) UpdatedAt() time.Time {
+	return r.updatedAt
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type SeasonID string
+
+func (id SeasonID) String() string {
+	return string(id)
+}
+
+var (
+	ErrSeasonNotFound = errors.New("season not found")
+	ErrSeasonExists   = errors.New("this series already has a season with this number")
+)
+
+type (
+	SeasonRepository interface {
+		Create(context.Context, Season) (Season, error)
+		FindBySeries(context.Context, SeriesID) ([]Season, error)
+		FindByNumber(context.Context, SeriesID, int) (Season, error)
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	Season struct {
+		id        SeasonID
+		seriesID  SeriesID
+		number    int
+		title     string
+		airDate   time.Time
+		synopsis  string
+		episodes  int
+		rating    Rating
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+// NewSeason returns the season numbered number of a series. airDate is
+// the day its first episode aired, the zero time when it isn't known.
+func NewSeason(
+	ID SeasonID,
+	seriesID SeriesID,
+	number int,
+	title string,
+	airDate time.Time,
+	synopsis string,
+) Season {
+	return Season{
+		id:       ID,
+		seriesID: seriesID,
+		number:   number,
+		title:    title,
+		airDate:  airDate,
+		synopsis: synopsis,
+	}
+}
+
+// WithEpisodes returns a copy of the season counting the given number of
+// episodes. Repositories count the episodes stored in the season.
+func (s Season) WithEpisodes(episodes int) Season {
+	s.episodes = episodes
+	return s
+}
+
+// WithRating returns a copy of the season with the given rating.
+// Repositories aggregate the ratings of the reviews of its episodes.
+func (s Season) WithRating(rating Rating) Season {
+	s.rating = rating
+	return s
+}
+
+func (s Season) WithTimestamps(createdAt, updatedAt time.Time) Season {
+	s.createdAt = createdAt
+	s.updatedAt = updatedAt
+	return s
+}
+
+func (s *Season) ID() SeasonID {
+	return s.id
+}
+
+func (s *Season) SeriesID() SeriesID {
+	return s.seriesID
+}
+
+func (s *Season) Number() int {
+	return s.number
+}
+
+func (s *Season) Title() string {
+	return s.title
+}
+
+func (s *Season) AirDate() time.Time {
+	return s.airDate
+}
+
+func (s *Season) Synopsis() string {
+	return s.synopsis
+}
+
+func (s *Season) Episodes() int {
+	return s.episodes
+}
+
+func (s *Season) Rating() Rating {
+	return s.rating
+}
+
+func (s *Season) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Season) UpdatedAt() time.Time {
+	return s.updatedAt
+}
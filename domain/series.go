@@ -3,6 +3,9 @@ package domain
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 )
 
 type SeriesID string
@@ -14,44 +17,225 @@ func (id SeriesID) String() string {
 var ErrSeriesNotFound = errors.New("series not found")
 
 type (
+	// SeriesRepository finds series by title among the ones matching the
+	// filter, the title is searched in the translations and the aliases
+	// too. An empty title matches every series, as does an empty Genre or
+	// Tag of the filter, both matched in any case. UpdateGenres, UpdateTags and UpdateAliases replace
+	// the genres, tags and aliases of a series. UpdateTranslation adds or replaces the translation in its
+	// language, DeleteTranslation returns ErrTranslationNotFound when the
+	// series has none in the language. UpdateExternalIDs replaces the
+	// external IDs of a series, ErrExternalIDExists if another series has
+	// one of them, and FindByExternalID returns the series with one.
+	// FindBySlug returns the series that has or had the slug.
 	SeriesRepository interface {
 		Create(context.Context, Series) (Series, error)
-		FindByTitle(context.Context, string) ([]Series, error)
+		FindByTitle(context.Context, string, SeriesFilter, Page) ([]Series, error)
 		FindByID(context.Context, SeriesID) (Series, error)
+		FindBySlug(context.Context, string) (Series, error)
+		Update(context.Context, Series) (Series, error)
+		UpdateGenres(context.Context, SeriesID, []GenreID) error
+		UpdateTags(context.Context, SeriesID, []Tag) error
+		UpdateAliases(context.Context, SeriesID, []string) error
+		UpdateTranslation(context.Context, SeriesID, Translation) error
+		DeleteTranslation(context.Context, SeriesID, string) error
+		UpdateExternalIDs(context.Context, SeriesID, []ExternalID) error
+		FindByExternalID(context.Context, ExternalID) (Series, error)
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// SeriesFilter narrows a search down to the series of a genre,
+	// matched case insensitively, and with a normalized tag. Network is
+	// a network name, matched case insensitively too, Country and
+	// Language are ISO 3166-1 alpha-2 and ISO 639-1 codes.
+	SeriesFilter struct {
+		Genre    string
+		Tag      string
+		Network  string
+		Country  string
+		Language string
+		Status   SeriesStatus
 	}
 
 	Series struct {
 		id                 SeriesID
+		slug               string
 		title              string
 		description        string
 		episodes           int
 		beginYear, endYear int
-		creator            string
+		creators           []Person
+		status             SeriesStatus
+		rating             Rating
+		episodeRating      Rating
+		genres             []string
+		tags               []string
+		networkID          NetworkID
+		network            string
+		country            string
+		language           string
+		translations       []Translation
+		aliases            []string
+		matchedAlias       string
+		externalIDs        []ExternalID
+		version            int
+		createdAt          time.Time
+		updatedAt          time.Time
 	}
 )
 
 func NewSeries(
 	ID SeriesID,
 	title, description string,
-	episodes int,
 	beginYear, endYear int,
-	creator string,
 ) Series {
 	return Series{
 		id:          ID,
 		title:       title,
 		description: description,
-		episodes:    episodes,
 		beginYear:   beginYear,
 		endYear:     endYear,
-		creator:     creator,
+		status:      StatusForEndYear(endYear),
+		version:     1,
+	}
+}
+
+// WithVersion returns a copy of the series expected to be stored
+// with the given version. Repositories use it to detect concurrent updates.
+func (s Series) WithVersion(version int) Series {
+	s.version = version
+	return s
+}
+
+// WithStatus returns a copy of the series with the given status,
+// as stored. Use ChangeStatus to move a series to another status.
+func (s Series) WithStatus(status SeriesStatus) Series {
+	s.status = status
+	return s
+}
+
+// ChangeStatus returns a copy of the series moved to the given status,
+// ending in endYear when the status is finished. It returns
+// ErrStatusTransition if the current status cannot change to the given
+// one, and ErrStatusEndYear if endYear does not match it.
+func (s Series) ChangeStatus(status SeriesStatus, endYear int) (Series, error) {
+	if !s.status.CanChangeTo(status) {
+		return Series{}, fmt.Errorf(
+			"%w: from %s to %s", ErrStatusTransition, s.status, status,
+		)
+	}
+	if err := status.CheckEndYear(endYear); err != nil {
+		return Series{}, err
 	}
+	if endYear != 0 && endYear < s.beginYear {
+		return Series{}, fmt.Errorf(
+			"%w: %d is before the begin year", ErrStatusEndYear, endYear,
+		)
+	}
+	s.status = status
+	s.endYear = endYear
+	return s, nil
+}
+
+// WithEpisodes returns a copy of the series counting the given number of
+// episodes. Repositories count the episodes stored in its seasons.
+func (s Series) WithEpisodes(episodes int) Series {
+	s.episodes = episodes
+	return s
+}
+
+// WithRatings returns a copy of the series with the given ratings.
+// Repositories aggregate the ratings of the reviews of the series
+// itself into rating and the ones of its episodes into episodeRating.
+func (s Series) WithRatings(rating, episodeRating Rating) Series {
+	s.rating = rating
+	s.episodeRating = episodeRating
+	return s
+}
+
+// WithGenres returns a copy of the series with the names of its genres.
+func (s Series) WithGenres(genres []string) Series {
+	s.genres = genres
+	return s
+}
+
+// WithTags returns a copy of the series with the given tags.
+func (s Series) WithTags(tags []string) Series {
+	s.tags = tags
+	return s
+}
+
+// WithAliases returns a copy of the series with the other titles it
+// is known by.
+func (s Series) WithAliases(aliases []string) Series {
+	s.aliases = aliases
+	return s
+}
+
+// WithMatchedAlias returns a copy of the series found by a search
+// through the given alias.
+func (s Series) WithMatchedAlias(alias string) Series {
+	s.matchedAlias = alias
+	return s
+}
+
+// WithExternalIDs returns a copy of the series with its IDs in
+// other catalogs.
+func (s Series) WithExternalIDs(externalIDs []ExternalID) Series {
+	s.externalIDs = externalIDs
+	return s
+}
+
+// WithSlug returns a copy of the series with the slug it is found by.
+func (s Series) WithSlug(slug string) Series {
+	s.slug = slug
+	return s
+}
+
+// WithCreators returns a copy of the series created by the given people,
+// in the order they are credited.
+func (s Series) WithCreators(creators []Person) Series {
+	s.creators = creators
+	return s
+}
+
+// WithNetwork returns a copy of the series broadcast by the network
+// with the given ID and name. An empty ID means the network is unknown.
+func (s Series) WithNetwork(ID NetworkID, name string) Series {
+	s.networkID = ID
+	s.network = name
+	return s
+}
+
+// WithOrigin returns a copy of the series from the given country, an
+// ISO 3166-1 alpha-2 code, in the given original language, an ISO 639-1
+// code. Either is empty when unknown.
+func (s Series) WithOrigin(country, language string) Series {
+	s.country = country
+	s.language = language
+	return s
+}
+
+// WithTranslations returns a copy of the series with the given
+// translations of its title and description.
+func (s Series) WithTranslations(translations []Translation) Series {
+	s.translations = translations
+	return s
+}
+
+func (s Series) WithTimestamps(createdAt, updatedAt time.Time) Series {
+	s.createdAt = createdAt
+	s.updatedAt = updatedAt
+	return s
 }
 
 func (s *Series) ID() SeriesID {
 	return s.id
 }
 
+func (s *Series) Slug() string {
+	return s.slug
+}
+
 func (s *Series) Title() string {
 	return s.title
 }
@@ -72,6 +256,93 @@ func (s *Series) EndYear() int {
 	return s.endYear
 }
 
+func (s *Series) Creators() []Person {
+	return s.creators
+}
+
+// Creator is the names of the creators of the series, like
+// "Vince Gilligan & Peter Gould".
 func (s *Series) Creator() string {
-	return s.creator
+	names := make([]string, len(s.creators))
+	for i, creator := range s.creators {
+		names[i] = creator.Name()
+	}
+	return strings.Join(names, " & ")
+}
+
+func (s *Series) Status() SeriesStatus {
+	return s.status
+}
+
+func (s *Series) Rating() Rating {
+	return s.rating
+}
+
+func (s *Series) EpisodeRating() Rating {
+	return s.episodeRating
+}
+
+func (s *Series) Genres() []string {
+	return s.genres
+}
+
+func (s *Series) Tags() []string {
+	return s.tags
+}
+
+func (s *Series) Aliases() []string {
+	return s.aliases
+}
+
+// MatchedAlias returns the alias a search found the series through,
+// empty when no alias matched.
+func (s *Series) MatchedAlias() string {
+	return s.matchedAlias
+}
+
+func (s *Series) ExternalIDs() []ExternalID {
+	return s.externalIDs
+}
+
+func (s *Series) NetworkID() NetworkID {
+	return s.networkID
+}
+
+func (s *Series) Network() string {
+	return s.network
+}
+
+func (s *Series) Country() string {
+	return s.country
+}
+
+func (s *Series) Language() string {
+	return s.language
+}
+
+func (s *Series) Translations() []Translation {
+	return s.translations
+}
+
+// Translation returns the translation of the series in the language,
+// false if it has none.
+func (s *Series) Translation(language string) (Translation, bool) {
+	for _, translation := range s.translations {
+		if translation.language == language {
+			return translation, true
+		}
+	}
+	return Translation{}, false
+}
+
+func (s *Series) Version() int {
+	return s.version
+}
+
+func (s *Series) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Series) UpdatedAt() time.Time {
+	return s.updatedAt
 }
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SeriesStatus is where a series is in its lifecycle.
+type SeriesStatus string
+
+const (
+	SeriesAnnounced  SeriesStatus = "announced"
+	SeriesAiring     SeriesStatus = "airing"
+	SeriesOnHiatus   SeriesStatus = "on_hiatus"
+	SeriesEnded      SeriesStatus = "ended"
+	SeriesCancelled  SeriesStatus = "cancelled"
+	SeriesMiniseries SeriesStatus = "miniseries"
+)
+
+var (
+	ErrStatusTransition = errors.New("series cannot change to this status")
+	ErrStatusEndYear    = errors.New("end year does not match the status")
+)
+
+// statusTransitions are the statuses a series can change to from each
+// status. Ended and cancelled series are revived, and miniseries renewed,
+// by airing again.
+var statusTransitions = map[SeriesStatus][]SeriesStatus{
+	SeriesAnnounced:  {SeriesAiring, SeriesCancelled},
+	SeriesAiring:     {SeriesOnHiatus, SeriesEnded, SeriesCancelled, SeriesMiniseries},
+	SeriesOnHiatus:   {SeriesAiring, SeriesEnded, SeriesCancelled},
+	SeriesEnded:      {SeriesAiring},
+	SeriesCancelled:  {SeriesAiring},
+	SeriesMiniseries: {SeriesAiring},
+}
+
+// StatusForEndYear is the status of series stored before they had one,
+// airing until they got an end year.
+func StatusForEndYear(endYear int) SeriesStatus {
+	if endYear == 0 {
+		return SeriesAiring
+	}
+	return SeriesEnded
+}
+
+func (s SeriesStatus) String() string {
+	return string(s)
+}
+
+// Finished reports whether series with the status stopped airing,
+// they are the only ones with an end year.
+func (s SeriesStatus) Finished() bool {
+	return s == SeriesEnded || s == SeriesCancelled || s == SeriesMiniseries
+}
+
+// CanChangeTo reports whether a series can go from s to next.
+func (s SeriesStatus) CanChangeTo(next SeriesStatus) bool {
+	for _, status := range statusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckEndYear returns ErrStatusEndYear unless endYear is set
+// for a finished status and 0 for the others.
+func (s SeriesStatus) CheckEndYear(endYear int) error {
+	if s.Finished() != (endYear != 0) {
+		return fmt.Errorf("%w: %s with end year %d", ErrStatusEndYear, s, endYear)
+	}
+	return nil
+}
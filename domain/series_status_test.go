@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var allStatuses = []SeriesStatus{
+	SeriesAnnounced,
+	SeriesAiring,
+	SeriesOnHiatus,
+	SeriesEnded,
+	SeriesCancelled,
+	SeriesMiniseries,
+}
+
+func TestSeriesStatusCanChangeTo(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		From    SeriesStatus
+		Allowed []SeriesStatus
+	}
+	tests := []Test{
+		{From: SeriesAnnounced, Allowed: []SeriesStatus{SeriesAiring, SeriesCancelled}},
+		{
+			From:    SeriesAiring,
+			Allowed: []SeriesStatus{SeriesOnHiatus, SeriesEnded, SeriesCancelled, SeriesMiniseries},
+		},
+		{From: SeriesOnHiatus, Allowed: []SeriesStatus{SeriesAiring, SeriesEnded, SeriesCancelled}},
+		{From: SeriesEnded, Allowed: []SeriesStatus{SeriesAiring}},
+		{From: SeriesCancelled, Allowed: []SeriesStatus{SeriesAiring}},
+		{From: SeriesMiniseries, Allowed: []SeriesStatus{SeriesAiring}},
+	}
+
+	// Every pair of statuses is checked, the ones not allowed are rejected.
+	for _, test := range tests {
+		for _, to := range allStatuses {
+			allowed := false
+			for _, status := range test.Allowed {
+				allowed = allowed || status == to
+			}
+			t.Run(test.From.String()+" to "+to.String(), func(t *testing.T) {
+				assert.Equal(t, allowed, test.From.CanChangeTo(to))
+			})
+		}
+	}
+}
+
+func TestSeriesStatusCheckEndYear(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range allStatuses {
+		t.Run(status.String(), func(t *testing.T) {
+			assert := assert.New(t)
+			if status.Finished() {
+				assert.NoError(status.CheckEndYear(2010))
+				assert.ErrorIs(status.CheckEndYear(0), ErrStatusEndYear)
+			} else {
+				assert.NoError(status.CheckEndYear(0))
+				assert.ErrorIs(status.CheckEndYear(2010), ErrStatusEndYear)
+			}
+		})
+	}
+}
+
+func TestSeriesChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description     string
+		From            SeriesStatus
+		To              SeriesStatus
+		EndYear         int
+		ExpectedEndYear int
+		ExpectedErr     error
+	}
+	tests := []Test{
+		{
+			Description:     "Airing series ends",
+			From:            SeriesAiring,
+			To:              SeriesEnded,
+			EndYear:         2013,
+			ExpectedEndYear: 2013,
+		},
+		{
+			Description:     "Ended series airs again without its end year",
+			From:            SeriesEnded,
+			To:              SeriesAiring,
+			ExpectedEndYear: 0,
+		},
+		{
+			Description: "Announced series cannot end",
+			From:        SeriesAnnounced,
+			To:          SeriesEnded,
+			EndYear:     2013,
+			ExpectedErr: ErrStatusTransition,
+		},
+		{
+			Description: "Same status is not a transition",
+			From:        SeriesAiring,
+			To:          SeriesAiring,
+			ExpectedErr: ErrStatusTransition,
+		},
+		{
+			Description: "Finished status without an end year",
+			From:        SeriesAiring,
+			To:          SeriesCancelled,
+			ExpectedErr: ErrStatusEndYear,
+		},
+		{
+			Description: "Unfinished status with an end year",
+			From:        SeriesAiring,
+			To:          SeriesOnHiatus,
+			EndYear:     2013,
+			ExpectedErr: ErrStatusEndYear,
+		},
+		{
+			Description: "End year before the begin year",
+			From:        SeriesAiring,
+			To:          SeriesEnded,
+			EndYear:     2007,
+			ExpectedErr: ErrStatusEndYear,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			series := NewSeries("ID", "Breaking Bad", "Description", 2008, 0).
+				WithStatus(test.From)
+			if test.From.Finished() {
+				series = NewSeries("ID", "Breaking Bad", "Description", 2008, 2013).
+					WithStatus(test.From)
+			}
+
+			changed, err := series.ChangeStatus(test.To, test.EndYear)
+			assert.ErrorIs(err, test.ExpectedErr)
+			if test.ExpectedErr != nil {
+				assert.Equal(Series{}, changed)
+				return
+			}
+			assert.Equal(test.To, changed.Status())
+			assert.Equal(test.ExpectedEndYear, changed.EndYear())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrSeriesMoved is returned when a series is looked up by a slug it
+// used to have, it has to be looked up by its current one.
+var ErrSeriesMoved = errors.New("series moved")
+
+// ErrSlugTaken is returned when another series took the slug of a series
+// while it was stored, storing it again numbers the slug.
+var ErrSlugTaken = errors.New("slug taken by another series")
+
+// slugLetters are the accented letters replaced in slugs, as the
+// make_slug function of scripts/init.sql does.
+var slugLetters = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"ç", "c",
+	"è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i",
+	"ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u",
+	"ý", "y", "ÿ", "y",
+)
+
+// NewSlug returns the slug of a series with the given title and begin
+// year, like "breaking-bad-2008": the lowercase letters and digits of the
+// title, other runs of characters being replaced by a hyphen, followed by
+// the year.
+func NewSlug(title string, beginYear int) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range slugLetters.Replace(strings.ToLower(title)) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+	if b.Len() > 0 {
+		b.WriteByte('-')
+	}
+	b.WriteString(strconv.Itoa(beginYear))
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type TagID string
+
+func (id TagID) String() string {
+	return string(id)
+}
+
+// Tag is a free-form label of series. Unlike genres, tags are created
+// the first time a series gets them.
+type Tag struct {
+	id        TagID
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewTag normalizes the name, see NormalizeTag.
+func NewTag(ID TagID, name string) Tag {
+	return Tag{
+		id:   ID,
+		name: NormalizeTag(name),
+	}
+}
+
+func (t Tag) WithTimestamps(createdAt, updatedAt time.Time) Tag {
+	t.createdAt = createdAt
+	t.updatedAt = updatedAt
+	return t
+}
+
+func (t *Tag) ID() TagID {
+	return t.id
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *Tag) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// NormalizeTag returns the form tags are stored and matched in,
+// so that "Anthology " and "anthology" are the same tag.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "errors"
+
+var ErrTranslationNotFound = errors.New("translation not found")
+
+// DefaultLanguage is the language of the title and description
+// of the series themselves.
+const DefaultLanguage = "en"
+
+// Translation is the title and description of a series in a language,
+// an ISO 639-1 code other than DefaultLanguage.
+type Translation struct {
+	language    string
+	title       string
+	description string
+}
+
+func NewTranslation(language, title, description string) Translation {
+	return Translation{
+		language:    language,
+		title:       title,
+		description: description,
+	}
+}
+
+func (t *Translation) Language() string {
+	return t.language
+}
+
+func (t *Translation) Title() string {
+	return t.title
+}
+
+func (t *Translation) Description() string {
+	return t.description
+}
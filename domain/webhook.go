@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+type WebhookID string
+
+func (id WebhookID) String() string {
+	return string(id)
+}
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+type (
+	WebhookRepository interface {
+		Create(context.Context, Webhook) (Webhook, error)
+		FindAll(context.Context) ([]Webhook, error)
+		FindByID(context.Context, WebhookID) (Webhook, error)
+		FindByEvent(context.Context, EventType) ([]Webhook, error)
+		Update(context.Context, Webhook) (Webhook, error)
+		Delete(context.Context, WebhookID) error
+	}
+
+	// WebhookSender posts a delivery to the URL of its webhook. The status
+	// code of the response is returned along with the error, if any, so
+	// that failed attempts can be logged.
+	WebhookSender interface {
+		Send(context.Context, Webhook, Delivery) (int, error)
+	}
+
+	Webhook struct {
+		id        WebhookID
+		url       string
+		secret    string
+		events    []EventType
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+func NewWebhook(
+	ID WebhookID,
+	url, secret string,
+	events []EventType,
+) Webhook {
+	return Webhook{
+		id:     ID,
+		url:    url,
+		secret: secret,
+		events: events,
+	}
+}
+
+// NewWebhookSecret generates the key payloads are signed
+// with when a subscriber doesn't choose one.
+func NewWebhookSecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func (w Webhook) WithTimestamps(createdAt, updatedAt time.Time) Webhook {
+	w.createdAt = createdAt
+	w.updatedAt = updatedAt
+	return w
+}
+
+func (w Webhook) WithSecret(secret string) Webhook {
+	w.secret = secret
+	return w
+}
+
+func (w *Webhook) ID() WebhookID {
+	return w.id
+}
+
+func (w *Webhook) URL() string {
+	return w.url
+}
+
+func (w *Webhook) Secret() string {
+	return w.secret
+}
+
+func (w *Webhook) Events() []EventType {
+	return w.events
+}
+
+func (w *Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *Webhook) UpdatedAt() time.Time {
+	return w.updatedAt
+}
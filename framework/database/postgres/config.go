@@ -1,5 +1,7 @@
 package postgres
 
+import "time"
+
 type Config struct {
 	host     string
 	database string
@@ -7,6 +9,7 @@ type Config struct {
 	user     string
 	password string
 	sslmode  string
+	clock    func() time.Time
 }
 
 func NewConfig() *Config {
@@ -17,6 +20,7 @@ func NewConfig() *Config {
 		user:     "postgres",
 		password: "postgres",
 		sslmode:  "disable",
+		clock:    time.Now,
 	}
 }
 
@@ -49,3 +53,9 @@ func (c *Config) WithSSLMode(sslmode string) *Config {
 	c.sslmode = sslmode
 	return c
 }
+
+// WithClock overrides the source of created_at/updated_at timestamps.
+func (c *Config) WithClock(clock func() time.Time) *Config {
+	c.clock = clock
+	return c
+}
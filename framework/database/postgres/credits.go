@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type creditRepository struct {
+	db *DB
+}
+
+// Create implements domain.CreditRepository
+func (r *creditRepository) Create(
+	ctx context.Context,
+	credit domain.Credit,
+) (domain.Credit, error) {
+	ctx, span := tracer.Start(ctx, "CreditRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      credits(
+        id, series_id, person_id, role, character, episodes,
+        created_at, updated_at
+      )
+    VALUES
+      ($1, $2, $3, $4, $5, $6, $7, $7)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		credit.ID(),
+		credit.SeriesID(),
+		credit.PersonID(),
+		credit.Role().String(),
+		credit.Character(),
+		credit.Episodes(),
+		now,
+	)
+	if isUniqueViolation(err) {
+		return domain.Credit{}, domain.ErrCreditExists
+	} else if err != nil {
+		return domain.Credit{}, err
+	}
+	return credit.WithTimestamps(now, now), nil
+}
+
+// FindBySeries implements domain.CreditRepository. Creators come
+// first, then the actors by the number of episodes they appeared in.
+func (r *creditRepository) FindBySeries(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+) ([]domain.Credit, error) {
+	ctx, span := tracer.Start(ctx, "CreditRepository.FindBySeries")
+	defer span.End()
+
+	const query = `
+    SELECT
+      credits.id, credits.series_id, credits.person_id, credits.role,
+      credits.character, credits.episodes, people.name, series.title,
+      credits.created_at, credits.updated_at
+    FROM credits
+      JOIN people ON people.id = credits.person_id
+      JOIN series ON series.id = credits.series_id
+    WHERE
+      credits.series_id = $1
+    ORDER BY
+      array_position(ARRAY['creator', 'actor', 'writer', 'director'], credits.role),
+      credits.episodes DESC,
+      people.name
+  `
+
+	return r.find(ctx, query, seriesID)
+}
+
+// FindByPerson implements domain.CreditRepository. The
+// filmography is sorted from the latest series on.
+func (r *creditRepository) FindByPerson(
+	ctx context.Context,
+	personID domain.PersonID,
+) ([]domain.Credit, error) {
+	ctx, span := tracer.Start(ctx, "CreditRepository.FindByPerson")
+	defer span.End()
+
+	const query = `
+    SELECT
+      credits.id, credits.series_id, credits.person_id, credits.role,
+      credits.character, credits.episodes, people.name, series.title,
+      credits.created_at, credits.updated_at
+    FROM credits
+      JOIN people ON people.id = credits.person_id
+      JOIN series ON series.id = credits.series_id
+    WHERE
+      credits.person_id = $1
+    ORDER BY
+      series.begin_year DESC,
+      series.title,
+      credits.role
+  `
+
+	return r.find(ctx, query, personID)
+}
+
+func (r *creditRepository) find(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.Credit, error) {
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credits := []domain.Credit{}
+	for rows.Next() {
+		var (
+			id, seriesID, personID string
+			role, character        string
+			episodes               int
+			personName             string
+			seriesTitle            string
+			createdAt, updatedAt   time.Time
+		)
+		err := rows.Scan(
+			&id, &seriesID, &personID, &role,
+			&character, &episodes, &personName, &seriesTitle,
+			&createdAt, &updatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		credits = append(credits, domain.NewCredit(
+			domain.CreditID(id),
+			domain.SeriesID(seriesID),
+			domain.PersonID(personID),
+			domain.CreditRole(role),
+			character,
+			episodes,
+		).WithNames(personName, seriesTitle).
+			WithTimestamps(createdAt, updatedAt))
+	}
+	return credits, rows.Err()
+}
@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type deliveryRepository struct {
+	db *DB
+}
+
+const deliveryColumns = `
+      id, webhook_id, event_id, event, payload, status, attempts,
+      next_attempt_at, last_status_code, last_error, created_at, updated_at`
+
+// Create queues the delivery, due immediately. Events relayed again after
+// a crash hit the unique (webhook_id, event_id) constraint and are ignored.
+func (r *deliveryRepository) Create(
+	ctx context.Context,
+	delivery domain.Delivery,
+) (domain.Delivery, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      webhook_deliveries(
+        id, webhook_id, event_id, event, payload, status, attempts,
+        next_attempt_at, created_at, updated_at
+      )
+    VALUES
+      ($1, $2, $3, $4, $5, $6, $7, $8, $8, $8)
+    ON CONFLICT (webhook_id, event_id) DO NOTHING
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		delivery.ID(),
+		delivery.WebhookID(),
+		delivery.EventID(),
+		delivery.Event().String(),
+		delivery.Payload(),
+		delivery.Status().String(),
+		delivery.Attempts(),
+		now,
+	)
+	if err != nil {
+		return domain.Delivery{}, err
+	}
+	return delivery.WithState(
+		delivery.Status(),
+		delivery.Attempts(),
+		now,
+		delivery.LastStatusCode(),
+		delivery.LastError(),
+	).WithTimestamps(now, now), nil
+}
+
+// Claim leases the due deliveries by setting their locked_until column,
+// rows locked by a concurrent claim are skipped rather than waited for.
+func (r *deliveryRepository) Claim(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]domain.Delivery, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryRepository.Claim")
+	defer span.End()
+
+	const query = `
+    UPDATE webhook_deliveries
+    SET
+      locked_until = $2
+    WHERE id IN (
+      SELECT id
+      FROM webhook_deliveries
+      WHERE
+        status = 'pending' AND next_attempt_at <= $1 AND
+        (locked_until IS NULL OR locked_until <= $1)
+      ORDER BY
+        next_attempt_at
+      LIMIT $3
+      FOR UPDATE SKIP LOCKED
+    )
+    RETURNING` + deliveryColumns
+
+	now := r.db.now()
+	return r.find(ctx, query, now, now.Add(lease), limit)
+}
+
+func (r *deliveryRepository) FindByWebhook(
+	ctx context.Context,
+	ID domain.WebhookID,
+	limit int,
+) ([]domain.Delivery, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryRepository.FindByWebhook")
+	defer span.End()
+
+	const query = `
+    SELECT` + deliveryColumns + `
+    FROM webhook_deliveries
+    WHERE
+      webhook_id = $1
+    ORDER BY
+      created_at DESC
+    LIMIT $2
+  `
+
+	return r.find(ctx, query, ID, limit)
+}
+
+func (r *deliveryRepository) find(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.Delivery, error) {
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []domain.Delivery{}
+	for rows.Next() {
+		var (
+			id, webhookID        string
+			eventID, event       string
+			payload              []byte
+			status               string
+			attempts             int
+			nextAttemptAt        sql.NullTime
+			lastStatusCode       sql.NullInt32
+			lastError            sql.NullString
+			createdAt, updatedAt time.Time
+		)
+		err := rows.Scan(
+			&id,
+			&webhookID,
+			&eventID,
+			&event,
+			&payload,
+			&status,
+			&attempts,
+			&nextAttemptAt,
+			&lastStatusCode,
+			&lastError,
+			&createdAt, &updatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, domain.NewDelivery(
+			domain.DeliveryID(id),
+			domain.WebhookID(webhookID),
+			domain.NewEvent(
+				domain.EventID(eventID),
+				domain.EventType(event),
+				"",
+				payload,
+			),
+		).WithState(
+			domain.DeliveryStatus(status),
+			attempts,
+			nextAttemptAt.Time,
+			int(lastStatusCode.Int32),
+			lastError.String,
+		).WithTimestamps(createdAt, updatedAt))
+	}
+	return deliveries, rows.Err()
+}
+
+// Update stores the outcome of the last attempt and clears the lease.
+func (r *deliveryRepository) Update(
+	ctx context.Context,
+	delivery domain.Delivery,
+) (domain.Delivery, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryRepository.Update")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    UPDATE webhook_deliveries
+    SET
+      status = $2, attempts = $3, next_attempt_at = $4,
+      last_status_code = $5, last_error = $6, locked_until = NULL,
+      updated_at = $7
+    WHERE
+      id = $1
+    RETURNING
+      created_at, updated_at
+  `
+
+	var nextAttemptAt *time.Time
+	if t := delivery.NextAttemptAt(); !t.IsZero() {
+		nextAttemptAt = &t
+	}
+	var lastStatusCode *int
+	if code := delivery.LastStatusCode(); code != 0 {
+		lastStatusCode = &code
+	}
+	var lastError *string
+	if reason := delivery.LastError(); reason != "" {
+		lastError = &reason
+	}
+
+	var createdAt, updatedAt time.Time
+	row := querier.QueryRow(
+		ctx,
+		query,
+		delivery.ID(),
+		delivery.Status().String(),
+		delivery.Attempts(),
+		nextAttemptAt,
+		lastStatusCode,
+		lastError,
+		r.db.now(),
+	)
+	if err := row.Scan(&createdAt, &updatedAt); err != nil {
+		return domain.Delivery{}, err
+	}
+	return delivery.WithTimestamps(createdAt, updatedAt), nil
+}
+
+func (r *deliveryRepository) WithTransaction(
+	ctx context.Context,
+	fn func(context.Context) error,
+) error {
+	ctx, span := tracer.Start(ctx, "DeliveryRepository.WithTransaction")
+	defer span.End()
+
+	tx, err := r.db.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, CtxKeyTx, tx)
+	if err := fn(ctx); err != nil {
+		rbErr := tx.Rollback(ctx)
+		if rbErr != nil {
+			logger.FromContext(ctx).
+				WithFields(logger.Fields{"error": err}).
+				Errorf("Rolling back transaction failed: %v", rbErr)
+			return rbErr
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
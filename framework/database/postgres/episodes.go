@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type episodeRepository struct {
+	db *DB
+}
+
+// Create implements domain.EpisodeRepository. domain.ErrEpisodeExists is
+// returned when the season already has an episode with the same number.
+func (r *episodeRepository) Create(
+	ctx context.Context,
+	episode domain.Episode,
+) (domain.Episode, error) {
+	ctx, span := tracer.Start(ctx, "EpisodeRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      episodes(
+        id, season_id, series_id, number, title, air_date, runtime, synopsis,
+        created_at, updated_at
+      )
+    VALUES
+      ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		episode.ID(),
+		episode.SeasonID(),
+		episode.SeriesID(),
+		episode.Number(),
+		episode.Title(),
+		nullDate(episode.AirDate()),
+		nullInt(episode.Runtime()),
+		episode.Synopsis(),
+		now,
+	)
+	if isUniqueViolation(err) {
+		return domain.Episode{}, domain.ErrEpisodeExists
+	} else if err != nil {
+		return domain.Episode{}, err
+	}
+	return episode.WithTimestamps(now, now), nil
+}
+
+func (r *episodeRepository) FindByID(
+	ctx context.Context,
+	ID domain.EpisodeID,
+) (domain.Episode, error) {
+	ctx, span := tracer.Start(ctx, "EpisodeRepository.FindByID")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, season_id, series_id, number, title, air_date, runtime, synopsis,
+      created_at, updated_at, ratings.average, ratings.count
+    FROM episodes,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(rating), 2), 0)::float8 AS average,
+          COUNT(rating) AS count
+        FROM reviews
+        WHERE episode_id = episodes.id
+      ) AS ratings
+    WHERE
+      id = $1
+  `
+
+	episode, err := scanEpisode(querier.QueryRow(ctx, query, ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Episode{}, domain.ErrEpisodeNotFound
+	} else if err != nil {
+		return domain.Episode{}, err
+	}
+	return episode, nil
+}
+
+// FindBySeason implements domain.EpisodeRepository, episodes come in order.
+func (r *episodeRepository) FindBySeason(
+	ctx context.Context,
+	seasonID domain.SeasonID,
+) ([]domain.Episode, error) {
+	ctx, span := tracer.Start(ctx, "EpisodeRepository.FindBySeason")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, season_id, series_id, number, title, air_date, runtime, synopsis,
+      created_at, updated_at, ratings.average, ratings.count
+    FROM episodes,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(rating), 2), 0)::float8 AS average,
+          COUNT(rating) AS count
+        FROM reviews
+        WHERE episode_id = episodes.id
+      ) AS ratings
+    WHERE
+      season_id = $1
+    ORDER BY
+      number
+  `
+
+	rows, err := querier.Query(ctx, query, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	episodes := []domain.Episode{}
+	for rows.Next() {
+		episode, err := scanEpisode(rows)
+		if err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+	return episodes, rows.Err()
+}
+
+func scanEpisode(row pgx.Row) (domain.Episode, error) {
+	var (
+		id, seasonID, seriesID string
+		number                 int
+		title                  string
+		airDate                sql.NullTime
+		runtime                sql.NullInt32
+		synopsis               string
+		createdAt, updatedAt   time.Time
+		average                float64
+		count                  int
+	)
+	err := row.Scan(
+		&id,
+		&seasonID,
+		&seriesID,
+		&number,
+		&title,
+		&airDate,
+		&runtime,
+		&synopsis,
+		&createdAt, &updatedAt,
+		&average, &count,
+	)
+	if err != nil {
+		return domain.Episode{}, err
+	}
+	return domain.NewEpisode(
+		domain.EpisodeID(id),
+		domain.SeasonID(seasonID),
+		domain.SeriesID(seriesID),
+		number,
+		title,
+		airDate.Time,
+		int(runtime.Int32),
+		synopsis,
+	).
+		WithRating(domain.NewRating(average, count)).
+		WithTimestamps(createdAt, updatedAt), nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type eventRepository struct {
+	db *DB
+}
+
+// Create adds the event to the outbox, it is expected to be called
+// within the transaction of the change the event describes.
+func (r *eventRepository) Create(
+	ctx context.Context,
+	event domain.Event,
+) (domain.Event, error) {
+	ctx, span := tracer.Start(ctx, "EventRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      outbox(id, type, aggregate_id, payload, occurred_at)
+    VALUES
+      ($1, $2, $3, $4, $5)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		event.ID(),
+		event.Type().String(),
+		event.AggregateID(),
+		event.Payload(),
+		now,
+	)
+	if err != nil {
+		return domain.Event{}, err
+	}
+	return event.WithOccurredAt(now), nil
+}
+
+// Claim leases the oldest events by setting their locked_until column,
+// rows locked by a concurrent claim are skipped rather than waited for.
+func (r *eventRepository) Claim(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]domain.Event, error) {
+	ctx, span := tracer.Start(ctx, "EventRepository.Claim")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    UPDATE outbox
+    SET
+      locked_until = $2
+    WHERE id IN (
+      SELECT id
+      FROM outbox
+      WHERE
+        locked_until IS NULL OR locked_until <= $1
+      ORDER BY
+        occurred_at
+      LIMIT $3
+      FOR UPDATE SKIP LOCKED
+    )
+    RETURNING
+      id, type, aggregate_id, payload, occurred_at
+  `
+
+	now := r.db.now()
+	rows, err := querier.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []domain.Event{}
+	for rows.Next() {
+		var (
+			id, eventType, aggregateID string
+			payload                    []byte
+			occurredAt                 time.Time
+		)
+		err := rows.Scan(&id, &eventType, &aggregateID, &payload, &occurredAt)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, domain.NewEvent(
+			domain.EventID(id),
+			domain.EventType(eventType),
+			aggregateID,
+			payload,
+		).WithOccurredAt(occurredAt))
+	}
+	return events, rows.Err()
+}
+
+func (r *eventRepository) Delete(
+	ctx context.Context,
+	ID domain.EventID,
+) error {
+	ctx, span := tracer.Start(ctx, "EventRepository.Delete")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    DELETE FROM outbox
+    WHERE
+      id = $1
+  `
+
+	_, err := execer.Exec(ctx, query, ID)
+	return err
+}
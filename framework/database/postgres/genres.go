@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"series/domain"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type genreRepository struct {
+	db *DB
+}
+
+// Create implements domain.GenreRepository
+func (r *genreRepository) Create(
+	ctx context.Context,
+	genre domain.Genre,
+) (domain.Genre, error) {
+	ctx, span := tracer.Start(ctx, "GenreRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      genres(id, name, created_at, updated_at)
+    VALUES
+      ($1, $2, $3, $3)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(ctx, query, genre.ID(), genre.Name(), now)
+	if isUniqueViolation(err) {
+		return domain.Genre{}, domain.ErrGenreExists
+	} else if err != nil {
+		return domain.Genre{}, err
+	}
+	return genre.WithTimestamps(now, now), nil
+}
+
+// FindAll implements domain.GenreRepository
+func (r *genreRepository) FindAll(ctx context.Context) ([]domain.Genre, error) {
+	ctx, span := tracer.Start(ctx, "GenreRepository.FindAll")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, name, created_at, updated_at
+    FROM genres
+    ORDER BY
+      name
+  `
+
+	return r.find(ctx, query)
+}
+
+// FindByNames implements domain.GenreRepository. Names
+// no genre has are left out of the returned genres.
+func (r *genreRepository) FindByNames(
+	ctx context.Context,
+	names []string,
+) ([]domain.Genre, error) {
+	ctx, span := tracer.Start(ctx, "GenreRepository.FindByNames")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, name, created_at, updated_at
+    FROM genres
+    WHERE
+      lower(name) = ANY($1)
+    ORDER BY
+      name
+  `
+
+	lower := make([]string, len(names))
+	for i, name := range names {
+		lower[i] = strings.ToLower(name)
+	}
+	return r.find(ctx, query, lower)
+}
+
+func (r *genreRepository) find(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.Genre, error) {
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	genres := []domain.Genre{}
+	for rows.Next() {
+		var (
+			id, name             string
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&id, &name, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		genres = append(genres, domain.NewGenre(domain.GenreID(id), name).
+			WithTimestamps(createdAt, updatedAt))
+	}
+	return genres, rows.Err()
+}
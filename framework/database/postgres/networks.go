@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type networkRepository struct {
+	db *DB
+}
+
+// Create implements domain.NetworkRepository
+func (r *networkRepository) Create(
+	ctx context.Context,
+	network domain.Network,
+) (domain.Network, error) {
+	ctx, span := tracer.Start(ctx, "NetworkRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      networks(id, name, country, created_at, updated_at)
+    VALUES
+      ($1, $2, $3, $4, $4)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		network.ID(),
+		network.Name(),
+		network.Country(),
+		now,
+	)
+	if isUniqueViolation(err) {
+		return domain.Network{}, domain.ErrNetworkExists
+	} else if err != nil {
+		return domain.Network{}, err
+	}
+	return network.WithTimestamps(now, now), nil
+}
+
+// FindAll implements domain.NetworkRepository
+func (r *networkRepository) FindAll(ctx context.Context) ([]domain.Network, error) {
+	ctx, span := tracer.Start(ctx, "NetworkRepository.FindAll")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, name, country, created_at, updated_at
+    FROM networks
+    ORDER BY
+      name
+  `
+
+	return r.find(ctx, query)
+}
+
+// FindByID implements domain.NetworkRepository
+func (r *networkRepository) FindByID(
+	ctx context.Context,
+	ID domain.NetworkID,
+) (domain.Network, error) {
+	ctx, span := tracer.Start(ctx, "NetworkRepository.FindByID")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, name, country, created_at, updated_at
+    FROM networks
+    WHERE
+      id = $1
+  `
+
+	networks, err := r.find(ctx, query, ID)
+	if err != nil {
+		return domain.Network{}, err
+	}
+	if len(networks) == 0 {
+		return domain.Network{}, domain.ErrNetworkNotFound
+	}
+	return networks[0], nil
+}
+
+func (r *networkRepository) find(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.Network, error) {
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	networks := []domain.Network{}
+	for rows.Next() {
+		var (
+			id, name, country    string
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&id, &name, &country, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		networks = append(networks, domain.NewNetwork(domain.NetworkID(id), name, country).
+			WithTimestamps(createdAt, updatedAt))
+	}
+	return networks, rows.Err()
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"series/domain"
+	"time"
+)
+
+const reviewsChannel = "reviews_created"
+
+type reviewNotification struct {
+	ID        string    `json:"id"`
+	SeriesID  string    `json:"series_id"`
+	EpisodeID string    `json:"episode_id,omitempty"`
+	AuthorID  string    `json:"author_id"`
+	Text      string    `json:"text"`
+	Rating    int       `json:"rating,omitempty"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ReviewBridge is a domain.ReviewBroker that shares reviews between
+// instances with LISTEN/NOTIFY. Published reviews are sent to Postgres,
+// Listen hands every notification, including the instance's own ones,
+// to the local broker that subscriptions are served from.
+type ReviewBridge struct {
+	db    *DB
+	local domain.ReviewBroker
+}
+
+func (db *DB) NewReviewBridge(local domain.ReviewBroker) *ReviewBridge {
+	return &ReviewBridge{
+		db:    db,
+		local: local,
+	}
+}
+
+func (b *ReviewBridge) Publish(ctx context.Context, review domain.Review) error {
+	payload, err := json.Marshal(reviewNotification{
+		ID:        review.ID().String(),
+		SeriesID:  review.SeriesID().String(),
+		EpisodeID: review.EpisodeID().String(),
+		AuthorID:  review.AuthorID().String(),
+		Text:      review.Text(),
+		Rating:    review.Rating(),
+		Version:   review.Version(),
+		CreatedAt: review.CreatedAt(),
+		UpdatedAt: review.UpdatedAt(),
+	})
+	if err != nil {
+		return err
+	}
+
+	const query = `SELECT pg_notify($1, $2)`
+
+	_, err = b.db.pool.Exec(ctx, query, reviewsChannel, string(payload))
+	return err
+}
+
+func (b *ReviewBridge) Subscribe(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+	lastID domain.ReviewID,
+) (<-chan domain.Review, error) {
+	return b.local.Subscribe(ctx, seriesID, lastID)
+}
+
+// Listen holds a connection to receive notifications until ctx is done
+// or the connection fails, it has to be called again in the latter case.
+func (b *ReviewBridge) Listen(ctx context.Context) error {
+	pooled, err := b.db.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection keeps listening until closed, so it must not go
+	// back to the pool.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+reviewsChannel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var n reviewNotification
+		if err := json.Unmarshal([]byte(notification.Payload), &n); err != nil {
+			continue
+		}
+		review := domain.NewReview(
+			domain.ReviewID(n.ID),
+			domain.SeriesID(n.SeriesID),
+			domain.AuthorID(n.AuthorID),
+			n.Text,
+		).
+			WithEpisode(domain.EpisodeID(n.EpisodeID)).
+			WithRating(n.Rating).
+			WithVersion(n.Version).
+			WithTimestamps(n.CreatedAt, n.UpdatedAt)
+		if err := b.local.Publish(ctx, review); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type personRepository struct {
+	db *DB
+}
+
+// Create implements domain.PersonRepository
+func (r *personRepository) Create(
+	ctx context.Context,
+	person domain.Person,
+) (domain.Person, error) {
+	ctx, span := tracer.Start(ctx, "PersonRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      people(id, name, created_at, updated_at)
+    VALUES
+      ($1, $2, $3, $3)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(ctx, query, person.ID(), person.Name(), now)
+	if err != nil {
+		return domain.Person{}, err
+	}
+	return person.WithTimestamps(now, now), nil
+}
+
+// FindByID implements domain.PersonRepository
+func (r *personRepository) FindByID(
+	ctx context.Context,
+	ID domain.PersonID,
+) (domain.Person, error) {
+	ctx, span := tracer.Start(ctx, "PersonRepository.FindByID")
+	defer span.End()
+
+	var (
+		id, name             string
+		createdAt, updatedAt time.Time
+		querier              interface {
+			QueryRow(context.Context, string, ...any) pgx.Row
+		} = r.db.pool
+	)
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, name, created_at, updated_at
+    FROM people
+    WHERE
+      id = $1
+  `
+
+	err := querier.QueryRow(ctx, query, ID).Scan(&id, &name, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Person{}, domain.ErrPersonNotFound
+	} else if err != nil {
+		return domain.Person{}, err
+	}
+	return domain.NewPerson(domain.PersonID(id), name).
+		WithTimestamps(createdAt, updatedAt), nil
+}
@@ -2,15 +2,19 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"series/domain"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type DB struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	clock func() time.Time
 }
 
 func NewDB(c *Config) (*DB, error) {
@@ -34,17 +38,129 @@ func NewDB(c *Config) (*DB, error) {
 		}
 	}
 
-	var err error
+	config, err := pgxpool.ParseConfig(dbURL)
+	if err != nil {
+		return nil, err
+	}
+	config.ConnConfig.Logger = statementTracer{}
+
 	var pool *pgxpool.Pool
 	withRetries(func() error {
-		pool, err = pgxpool.Connect(context.Background(), dbURL)
+		pool, err = pgxpool.ConnectConfig(context.Background(), config)
 		return err
 	}, 5*time.Second, 5)
 	if err != nil {
 		return nil, err
 	}
 
-	return &DB{pool}, nil
+	return &DB{pool, c.clock}, nil
+}
+
+// tables are the tables created by scripts/init.sql.
+var tables = []string{
+	"networks", "series", "series_slugs",
+	"series_translations", "series_aliases", "series_external_ids",
+	"genres", "series_genres", "tags", "series_tags",
+	"people", "credits",
+	"seasons", "episodes", "reviews",
+	"webhooks", "webhook_deliveries", "outbox",
+}
+
+// columns are the columns scripts/init.sql adds to the tables of earlier
+// versions, as "table.column", their absence means it wasn't run again.
+var columns = []string{
+	"series.version", "series.created_at", "series.updated_at",
+	"series.network_id", "series.country", "series.language",
+	"series.status", "series.slug",
+	"reviews.episode_id", "reviews.rating",
+	"reviews.version", "reviews.created_at", "reviews.updated_at",
+	"tags.created_at", "tags.updated_at",
+}
+
+// uniqueViolation is the SQLSTATE of inserts breaking a UNIQUE constraint.
+const uniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+// nullDate stores the zero time, an unknown date, as NULL.
+func nullDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// nullString and nullInt store the zero value, an unknown or
+// missing value, as NULL.
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullInt(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// Ping checks that a connection to the database can be established.
+func (db *DB) Ping(ctx context.Context) error {
+	return db.pool.Ping(ctx)
+}
+
+// CheckSchema checks that the tables of the repositories exist and that
+// they have the columns of the latest migrations.
+func (db *DB) CheckSchema(ctx context.Context) error {
+	const query = `
+    SELECT t
+    FROM unnest($1::TEXT[]) AS t
+    WHERE
+      to_regclass(t) IS NULL
+    UNION ALL
+    SELECT c
+    FROM unnest($2::TEXT[]) AS c
+    WHERE
+      NOT EXISTS (
+        SELECT 1
+        FROM information_schema.columns
+        WHERE
+          table_schema = current_schema() AND
+          table_name = split_part(c, '.', 1) AND
+          column_name = split_part(c, '.', 2)
+      )
+  `
+
+	rows, err := db.pool.Query(ctx, query, tables, columns)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var missing []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		missing = append(missing, name)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing tables or columns: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (db *DB) now() time.Time {
+	return db.clock().UTC().Truncate(time.Microsecond)
 }
 
 func (db *DB) Close() {
@@ -57,8 +173,67 @@ func (db *DB) NewSeriesRepository() domain.SeriesRepository {
 	}
 }
 
+func (db *DB) NewGenreRepository() domain.GenreRepository {
+	return &genreRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewNetworkRepository() domain.NetworkRepository {
+	return &networkRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewPersonRepository() domain.PersonRepository {
+	return &personRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewCreditRepository() domain.CreditRepository {
+	return &creditRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewSeasonRepository() domain.SeasonRepository {
+	return &seasonRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewEpisodeRepository() domain.EpisodeRepository {
+	return &episodeRepository{
+		db: db,
+	}
+}
+
 func (db *DB) NewReviewRepository() domain.ReviewRepository {
 	return &reviewRepository{
 		db: db,
 	}
 }
+
+func (db *DB) NewWebhookRepository() domain.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewDeliveryRepository() domain.DeliveryRepository {
+	return &deliveryRepository{
+		db: db,
+	}
+}
+
+func (db *DB) NewEventRepository() domain.EventRepository {
+	return &eventRepository{
+		db: db,
+	}
+}
+
+// Stat returns the statistics of the connection pool.
+func (db *DB) Stat() *pgxpool.Stat {
+	return db.pool.Stat()
+}
@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"series/adapter/logger"
 	"series/domain"
+	"time"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
@@ -17,6 +20,9 @@ func (r *reviewRepository) Create(
 	ctx context.Context,
 	review domain.Review,
 ) (domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.Create")
+	defer span.End()
+
 	var execer interface {
 		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
 	} = r.db.pool
@@ -28,20 +34,64 @@ func (r *reviewRepository) Create(
 
 	const query = `
     INSERT INTO
-      reviews(id, series_id, author_id, text)
+      reviews(
+        id, series_id, episode_id, author_id, text, rating, version,
+        created_at, updated_at
+      )
     VALUES
-      ($1, $2, $3, $4)
+      ($1, $2, $3, $4, $5, $6, $7, $8, $8)
   `
 
+	now := r.db.now()
 	_, err := execer.Exec(
 		ctx,
 		query,
 		review.ID(),
 		review.SeriesID(),
+		nullString(review.EpisodeID().String()),
 		review.AuthorID(),
 		review.Text(),
+		nullInt(review.Rating()),
+		review.Version(),
+		now,
 	)
-	if err != nil {
+	if isUniqueViolation(err) {
+		return domain.Review{}, domain.ErrAlreadyReviewed
+	} else if err != nil {
+		return domain.Review{}, err
+	}
+	return review.WithTimestamps(now, now), nil
+}
+
+func (r *reviewRepository) FindByID(
+	ctx context.Context,
+	ID domain.ReviewID,
+) (domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.FindByID")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, series_id, episode_id, author_id, text, rating, version,
+      created_at, updated_at
+    FROM reviews
+    WHERE
+      id = $1
+  `
+
+	review, err := scanReview(querier.QueryRow(ctx, query, ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Review{}, domain.ErrReviewNotFound
+	} else if err != nil {
 		return domain.Review{}, err
 	}
 	return review, nil
@@ -50,7 +100,11 @@ func (r *reviewRepository) Create(
 func (r *reviewRepository) FindBySeries(
 	ctx context.Context,
 	seriesID domain.SeriesID,
+	page domain.Page,
 ) ([]domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.FindBySeries")
+	defer span.End()
+
 	var querier interface {
 		Query(context.Context, string, ...any) (pgx.Rows, error)
 	} = r.db.pool
@@ -62,13 +116,16 @@ func (r *reviewRepository) FindBySeries(
 
 	const query = `
     SELECT
-      id, series_id, author_id, text
+      id, series_id, episode_id, author_id, text, rating, version,
+      created_at, updated_at
     FROM reviews
     WHERE
-      series_id = $1
+      series_id = $1 AND episode_id IS NULL
+    ORDER BY created_at, id
+    LIMIT NULLIF($2, 0) OFFSET $3
   `
 
-	rows, err := querier.Query(ctx, query, seriesID)
+	rows, err := querier.Query(ctx, query, seriesID, page.Limit, page.Offset)
 	if errors.Is(err, pgx.ErrNoRows); err != nil {
 		return nil, nil
 	} else if err != nil {
@@ -77,36 +134,113 @@ func (r *reviewRepository) FindBySeries(
 
 	reviews := []domain.Review{}
 	for rows.Next() {
-		var (
-			id        string
-			series_id string
-			author_id string
-			text      string
-		)
-		err := rows.Scan(
-			&id,
-			&series_id,
-			&author_id,
-			&text,
-		)
+		review, err := scanReview(rows)
 		if err != nil {
 			return nil, err
 		}
-		reviews = append(reviews, domain.NewReview(
-			domain.ReviewID(id),
-			domain.SeriesID(series_id),
-			domain.AuthorID(author_id),
-			text,
-		))
+		reviews = append(reviews, review)
 	}
 	return reviews, nil
 }
 
+func (r *reviewRepository) FindBySeriesIDs(
+	ctx context.Context,
+	seriesIDs []domain.SeriesID,
+) ([]domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.FindBySeriesIDs")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, series_id, episode_id, author_id, text, rating, version,
+      created_at, updated_at
+    FROM reviews
+    WHERE
+      series_id = ANY($1) AND episode_id IS NULL
+  `
+
+	ids := make([]string, len(seriesIDs))
+	for i, id := range seriesIDs {
+		ids[i] = id.String()
+	}
+
+	rows, err := querier.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []domain.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
+func (r *reviewRepository) FindByEpisode(
+	ctx context.Context,
+	episodeID domain.EpisodeID,
+) ([]domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.FindByEpisode")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, series_id, episode_id, author_id, text, rating, version,
+      created_at, updated_at
+    FROM reviews
+    WHERE
+      episode_id = $1
+  `
+
+	rows, err := querier.Query(ctx, query, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []domain.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
 func (r *reviewRepository) Reviewed(
 	ctx context.Context,
 	seriesID domain.SeriesID,
+	episodeID domain.EpisodeID,
 	authorID domain.AuthorID,
 ) error {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.Reviewed")
+	defer span.End()
+
 	var querier interface {
 		QueryRow(context.Context, string, ...any) pgx.Row
 	} = r.db.pool
@@ -117,15 +251,21 @@ func (r *reviewRepository) Reviewed(
 	}
 
 	const query = `
-    SELECT 
+    SELECT
       id
     FROM reviews
     WHERE
-      series_id = $1 and author_id = $2
+      series_id = $1 AND episode_id IS NOT DISTINCT FROM $2 AND author_id = $3
   `
 
 	var id string
-	row := querier.QueryRow(ctx, query, seriesID, authorID)
+	row := querier.QueryRow(
+		ctx,
+		query,
+		seriesID,
+		nullString(episodeID.String()),
+		authorID,
+	)
 	err := row.Scan(&id)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil
@@ -136,10 +276,122 @@ func (r *reviewRepository) Reviewed(
 	}
 }
 
+func (r *reviewRepository) FindAuthors(
+	ctx context.Context,
+	authorIDs []domain.AuthorID,
+) ([]domain.Author, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.FindAuthors")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      author_id, COUNT(*)
+    FROM reviews
+    WHERE
+      author_id = ANY($1)
+    GROUP BY author_id
+  `
+
+	ids := make([]string, len(authorIDs))
+	for i, id := range authorIDs {
+		ids[i] = id.String()
+	}
+
+	rows, err := querier.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	authors := []domain.Author{}
+	for rows.Next() {
+		var (
+			id      string
+			reviews int
+		)
+		if err := rows.Scan(&id, &reviews); err != nil {
+			return nil, err
+		}
+		authors = append(authors, domain.NewAuthor(domain.AuthorID(id), reviews))
+	}
+	return authors, rows.Err()
+}
+
+// Update stores the review text and rating if the stored version matches
+// review.Version(), otherwise domain.ErrConcurrentModification is returned.
+func (r *reviewRepository) Update(
+	ctx context.Context,
+	review domain.Review,
+) (domain.Review, error) {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.Update")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    UPDATE reviews
+    SET
+      text = $2, rating = $3, version = version + 1, updated_at = $5
+    WHERE
+      id = $1 AND version = $4
+    RETURNING
+      version, created_at, updated_at
+  `
+
+	var (
+		version              int
+		createdAt, updatedAt time.Time
+	)
+	row := querier.QueryRow(
+		ctx,
+		query,
+		review.ID(),
+		review.Text(),
+		nullInt(review.Rating()),
+		review.Version(),
+		r.db.now(),
+	)
+	err := row.Scan(&version, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := r.FindByID(ctx, review.ID()); err != nil {
+			return domain.Review{}, err
+		}
+		logger.FromContext(ctx).
+			WithFields(logger.Fields{
+				"review_id": review.ID().String(),
+				"version":   review.Version(),
+			}).
+			Debugf("Stored version differs, not updating")
+		return domain.Review{}, domain.ErrConcurrentModification
+	} else if err != nil {
+		return domain.Review{}, err
+	}
+	return review.WithVersion(version).WithTimestamps(createdAt, updatedAt), nil
+}
+
 func (r *reviewRepository) WithTransaction(
 	ctx context.Context,
 	fn func(context.Context) error,
 ) error {
+	ctx, span := tracer.Start(ctx, "ReviewRepository.WithTransaction")
+	defer span.End()
+
 	tx, err := r.db.pool.Begin(ctx)
 	if err != nil {
 		return err
@@ -147,11 +399,48 @@ func (r *reviewRepository) WithTransaction(
 	ctx = context.WithValue(ctx, CtxKeyTx, tx)
 	if err := fn(ctx); err != nil {
 		rbErr := tx.Rollback(ctx)
-		// TODO: wrap err into rbErr?
 		if rbErr != nil {
+			logger.FromContext(ctx).
+				WithFields(logger.Fields{"error": err}).
+				Errorf("Rolling back transaction failed: %v", rbErr)
 			return rbErr
 		}
 		return err
 	}
 	return tx.Commit(ctx)
 }
+
+func scanReview(row pgx.Row) (domain.Review, error) {
+	var (
+		id, seriesID         string
+		episodeID            sql.NullString
+		authorID             string
+		text                 string
+		rating               sql.NullInt32
+		version              int
+		createdAt, updatedAt time.Time
+	)
+	err := row.Scan(
+		&id,
+		&seriesID,
+		&episodeID,
+		&authorID,
+		&text,
+		&rating,
+		&version,
+		&createdAt, &updatedAt,
+	)
+	if err != nil {
+		return domain.Review{}, err
+	}
+	return domain.NewReview(
+		domain.ReviewID(id),
+		domain.SeriesID(seriesID),
+		domain.AuthorID(authorID),
+		text,
+	).
+		WithEpisode(domain.EpisodeID(episodeID.String)).
+		WithRating(int(rating.Int32)).
+		WithVersion(version).
+		WithTimestamps(createdAt, updatedAt), nil
+}
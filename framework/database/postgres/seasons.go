@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type seasonRepository struct {
+	db *DB
+}
+
+// Create implements domain.SeasonRepository. domain.ErrSeasonExists is
+// returned when the series already has a season with the same number.
+func (r *seasonRepository) Create(
+	ctx context.Context,
+	season domain.Season,
+) (domain.Season, error) {
+	ctx, span := tracer.Start(ctx, "SeasonRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      seasons(
+        id, series_id, number, title, air_date, synopsis,
+        created_at, updated_at
+      )
+    VALUES
+      ($1, $2, $3, $4, $5, $6, $7, $7)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		season.ID(),
+		season.SeriesID(),
+		season.Number(),
+		season.Title(),
+		nullDate(season.AirDate()),
+		season.Synopsis(),
+		now,
+	)
+	if isUniqueViolation(err) {
+		return domain.Season{}, domain.ErrSeasonExists
+	} else if err != nil {
+		return domain.Season{}, err
+	}
+	return season.WithTimestamps(now, now), nil
+}
+
+// FindBySeries implements domain.SeasonRepository, seasons come in order.
+func (r *seasonRepository) FindBySeries(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+) ([]domain.Season, error) {
+	ctx, span := tracer.Start(ctx, "SeasonRepository.FindBySeries")
+	defer span.End()
+
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, series_id, number, title, air_date, synopsis,
+      created_at, updated_at,
+      (SELECT COUNT(*) FROM episodes WHERE season_id = seasons.id),
+      ratings.average, ratings.count
+    FROM seasons,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(reviews.rating), 2), 0)::float8 AS average,
+          COUNT(reviews.rating) AS count
+        FROM reviews
+        JOIN episodes ON episodes.id = reviews.episode_id
+        WHERE episodes.season_id = seasons.id
+      ) AS ratings
+    WHERE
+      series_id = $1
+    ORDER BY
+      number
+  `
+
+	rows, err := querier.Query(ctx, query, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seasons := []domain.Season{}
+	for rows.Next() {
+		season, err := scanSeason(rows)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, season)
+	}
+	return seasons, rows.Err()
+}
+
+// FindByNumber implements domain.SeasonRepository
+func (r *seasonRepository) FindByNumber(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+	number int,
+) (domain.Season, error) {
+	ctx, span := tracer.Start(ctx, "SeasonRepository.FindByNumber")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, series_id, number, title, air_date, synopsis,
+      created_at, updated_at,
+      (SELECT COUNT(*) FROM episodes WHERE season_id = seasons.id),
+      ratings.average, ratings.count
+    FROM seasons,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(reviews.rating), 2), 0)::float8 AS average,
+          COUNT(reviews.rating) AS count
+        FROM reviews
+        JOIN episodes ON episodes.id = reviews.episode_id
+        WHERE episodes.season_id = seasons.id
+      ) AS ratings
+    WHERE
+      series_id = $1 AND number = $2
+  `
+
+	season, err := scanSeason(querier.QueryRow(ctx, query, seriesID, number))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Season{}, domain.ErrSeasonNotFound
+	} else if err != nil {
+		return domain.Season{}, err
+	}
+	return season, nil
+}
+
+func (r *seasonRepository) WithTransaction(
+	ctx context.Context,
+	fn func(context.Context) error,
+) error {
+	ctx, span := tracer.Start(ctx, "SeasonRepository.WithTransaction")
+	defer span.End()
+
+	tx, err := r.db.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, CtxKeyTx, tx)
+	if err := fn(ctx); err != nil {
+		rbErr := tx.Rollback(ctx)
+		if rbErr != nil {
+			logger.FromContext(ctx).
+				WithFields(logger.Fields{"error": err}).
+				Errorf("Rolling back transaction failed: %v", rbErr)
+			return rbErr
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func scanSeason(row pgx.Row) (domain.Season, error) {
+	var (
+		id, seriesID         string
+		number               int
+		title                string
+		airDate              sql.NullTime
+		synopsis             string
+		createdAt, updatedAt time.Time
+		episodes             int
+		average              float64
+		count                int
+	)
+	err := row.Scan(
+		&id,
+		&seriesID,
+		&number,
+		&title,
+		&airDate,
+		&synopsis,
+		&createdAt, &updatedAt,
+		&episodes,
+		&average, &count,
+	)
+	if err != nil {
+		return domain.Season{}, err
+	}
+	return domain.NewSeason(
+		domain.SeasonID(id),
+		domain.SeriesID(seriesID),
+		number,
+		title,
+		airDate.Time,
+		synopsis,
+	).
+		WithEpisodes(episodes).
+		WithRating(domain.NewRating(average, count)).
+		WithTimestamps(createdAt, updatedAt), nil
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"errors"
+	"series/adapter/logger"
 	"series/domain"
+	"time"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
@@ -13,11 +15,74 @@ type seriesRepository struct {
 	db *DB
 }
 
+// translation is a translation of a series as aggregated in JSON.
+type translation struct {
+	Language    string `json:"language"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func newTranslations(translations []translation) []domain.Translation {
+	t := make([]domain.Translation, len(translations))
+	for i, translation := range translations {
+		t[i] = domain.NewTranslation(
+			translation.Language,
+			translation.Title,
+			translation.Description,
+		)
+	}
+	return t
+}
+
+// externalID is an external ID of a series as aggregated in JSON.
+type externalID struct {
+	Provider   string `json:"provider"`
+	ExternalID string `json:"external_id"`
+}
+
+func newExternalIDs(externalIDs []externalID) []domain.ExternalID {
+	e := make([]domain.ExternalID, len(externalIDs))
+	for i, externalID := range externalIDs {
+		e[i] = domain.NewExternalID(
+			domain.ExternalIDProvider(externalID.Provider),
+			externalID.ExternalID,
+		)
+	}
+	return e
+}
+
+// creator is a creator of a series as aggregated in JSON.
+type creator struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func newCreators(creators []creator) []domain.Person {
+	p := make([]domain.Person, len(creators))
+	for i, creator := range creators {
+		p[i] = domain.NewPerson(domain.PersonID(creator.ID), creator.Name)
+	}
+	return p
+}
+
+// creatorIDs are the IDs of the creators of a series.
+func creatorIDs(series domain.Series) []string {
+	creators := series.Creators()
+	IDs := make([]string, len(creators))
+	for i, creator := range creators {
+		IDs[i] = creator.ID().String()
+	}
+	return IDs
+}
+
 // Create implements domain.SeriesRepository
 func (r *seriesRepository) Create(
 	ctx context.Context,
 	series domain.Series,
 ) (domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.Create")
+	defer span.End()
+
 	var execer interface {
 		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
 	} = r.db.pool
@@ -29,26 +94,65 @@ func (r *seriesRepository) Create(
 
 	const query = `
   INSERT INTO
-    series(id, title, description, episodes, begin_year, end_year, creator)
+    series(
+      id, title, description, begin_year, end_year, status,
+      network_id, country, language,
+      version, created_at, updated_at, slug
+    )
   VALUES
-    ($1, $2, $3, $4, $5, $6, $7)
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11, $12)
   `
 
+	now := r.db.now()
 	_, err := execer.Exec(
 		ctx,
 		query,
 		series.ID(),
 		series.Title(),
 		series.Description(),
-		series.Episodes(),
 		series.BeginYear(),
 		series.EndYear(),
-		series.Creator(),
+		series.Status(),
+		nullString(series.NetworkID().String()),
+		series.Country(),
+		series.Language(),
+		series.Version(),
+		now,
+		series.Slug(),
 	)
+	if isUniqueViolation(err) {
+		return domain.Series{}, domain.ErrSlugTaken
+	} else if err != nil {
+		return domain.Series{}, err
+	}
+
+	const slugQuery = `
+  INSERT INTO
+    series_slugs(slug, series_id)
+  VALUES
+    ($1, $2)
+  `
+	_, err = execer.Exec(ctx, slugQuery, series.Slug(), series.ID())
+	if isUniqueViolation(err) {
+		return domain.Series{}, domain.ErrSlugTaken
+	} else if err != nil {
+		return domain.Series{}, err
+	}
+
+	// Creators are credited, the series is part of their filmography.
+	const creditQuery = `
+  INSERT INTO
+    credits(id, series_id, person_id, role, created_at, updated_at)
+  SELECT
+    gen_random_uuid(), $1, person_id, 'creator', $3, $3
+  FROM unnest($2::UUID[]) AS person_id
+  `
+
+	_, err = execer.Exec(ctx, creditQuery, series.ID(), creatorIDs(series), now)
 	if err != nil {
 		return domain.Series{}, err
 	}
-	return series, nil
+	return series.WithTimestamps(now, now), nil
 }
 
 // FindByID implements domain.SeriesRepository
@@ -56,14 +160,30 @@ func (r *seriesRepository) FindByID(
 	ctx context.Context,
 	ID domain.SeriesID,
 ) (domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.FindByID")
+	defer span.End()
+
 	var (
-		id                 string
-		title              string
-		description        string
-		episodes           int
-		beginYear, endYear int
-		creator            string
-		querier            interface {
+		id, slug             string
+		title                string
+		description          string
+		episodes             int
+		beginYear, endYear   int
+		status               string
+		creators             []creator
+		networkID, network   string
+		country, language    string
+		version              int
+		createdAt, updatedAt time.Time
+		seriesAverage        float64
+		seriesCount          int
+		episodesAverage      float64
+		episodesCount        int
+		genres, tags         []string
+		translations         []translation
+		aliases              []string
+		externalIDs          []externalID
+		querier              interface {
 			QueryRow(context.Context, string, ...any) pgx.Row
 		} = r.db.pool
 	)
@@ -75,18 +195,92 @@ func (r *seriesRepository) FindByID(
 
 	const query = `
     SELECT
-      id, title, description, episodes, begin_year, end_year, creator
+      series.id, slug, title, description, begin_year, end_year, status,
+      COALESCE(network_id::TEXT, ''), COALESCE(networks.name, ''),
+      series.country, language,
+      version, series.created_at, series.updated_at,
+      (SELECT COUNT(*) FROM episodes WHERE series_id = series.id),
+      COALESCE((
+        SELECT json_agg(json_build_object(
+          'id', people.id, 'name', people.name
+        ) ORDER BY people.name, people.id)
+        FROM credits
+          JOIN people ON people.id = credits.person_id
+        WHERE credits.series_id = series.id AND credits.role = 'creator'
+      ), '[]'),
+      series_ratings.average, series_ratings.count,
+      episode_ratings.average, episode_ratings.count,
+      ARRAY(
+        SELECT genres.name
+        FROM series_genres
+          JOIN genres ON genres.id = series_genres.genre_id
+        WHERE series_genres.series_id = series.id
+        ORDER BY genres.name
+      ),
+      ARRAY(
+        SELECT tags.name
+        FROM series_tags
+          JOIN tags ON tags.id = series_tags.tag_id
+        WHERE series_tags.series_id = series.id
+        ORDER BY tags.name
+      ),
+      COALESCE((
+        SELECT json_agg(json_build_object(
+          'language', language, 'title', title, 'description', description
+        ) ORDER BY language)
+        FROM series_translations
+        WHERE series_id = series.id
+      ), '[]'),
+      ARRAY(
+        SELECT alias
+        FROM series_aliases
+        WHERE series_id = series.id
+        ORDER BY alias
+      ),
+      COALESCE((
+        SELECT json_agg(json_build_object(
+          'provider', provider, 'external_id', external_id
+        ) ORDER BY provider)
+        FROM series_external_ids
+        WHERE series_id = series.id
+      ), '[]')
     FROM series
-    WHERE id = $1
+      LEFT JOIN networks ON networks.id = series.network_id,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(rating), 2), 0)::float8 AS average,
+          COUNT(rating) AS count
+        FROM reviews
+        WHERE series_id = series.id AND episode_id IS NULL
+      ) AS series_ratings,
+      LATERAL (
+        SELECT
+          COALESCE(ROUND(AVG(rating), 2), 0)::float8 AS average,
+          COUNT(rating) AS count
+        FROM reviews
+        WHERE series_id = series.id AND episode_id IS NOT NULL
+      ) AS episode_ratings
+    WHERE series.id = $1
   `
 	row := querier.QueryRow(ctx, query, ID)
 	err := row.Scan(
-		&id,
+		&id, &slug,
 		&title,
 		&description,
-		&episodes,
 		&beginYear, &endYear,
-		&creator,
+		&status,
+		&networkID, &network,
+		&country, &language,
+		&version,
+		&createdAt, &updatedAt,
+		&episodes,
+		&creators,
+		&seriesAverage, &seriesCount,
+		&episodesAverage, &episodesCount,
+		&genres, &tags,
+		&translations,
+		&aliases,
+		&externalIDs,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return domain.Series{}, domain.ErrSeriesNotFound
@@ -97,14 +291,36 @@ func (r *seriesRepository) FindByID(
 		domain.SeriesID(id),
 		title,
 		description,
-		episodes,
 		beginYear, endYear,
-		creator,
-	), nil
+	).WithStatus(domain.SeriesStatus(status)).
+		WithCreators(newCreators(creators)).
+		WithEpisodes(episodes).
+		WithRatings(
+			domain.NewRating(seriesAverage, seriesCount),
+			domain.NewRating(episodesAverage, episodesCount),
+		).
+		WithGenres(genres).
+		WithTags(tags).
+		WithNetwork(domain.NetworkID(networkID), network).
+		WithOrigin(country, language).
+		WithSlug(slug).
+		WithTranslations(newTranslations(translations)).
+		WithAliases(aliases).
+		WithExternalIDs(newExternalIDs(externalIDs)).
+		WithVersion(version).
+		WithTimestamps(createdAt, updatedAt), nil
 }
 
 // FindByTitle implements domain.SeriesRepository
-func (r *seriesRepository) FindByTitle(ctx context.Context, title string) ([]domain.Series, error) {
+func (r *seriesRepository) FindByTitle(
+	ctx context.Context,
+	title string,
+	filter domain.SeriesFilter,
+	page domain.Page,
+) ([]domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.FindByTitle")
+	defer span.End()
+
 	var querier interface {
 		Query(context.Context, string, ...any) (pgx.Rows, error)
 	} = r.db.pool
@@ -114,37 +330,147 @@ func (r *seriesRepository) FindByTitle(ctx context.Context, title string) ([]dom
 		querier = tx
 	}
 
+	// The query is parsed like web search engines do, any text the
+	// user types is valid, unlike with to_tsquery.
 	const query = `
     SELECT
-      id, title, description, episodes, begin_year, end_year, creator
+      series.id, slug, title, description, begin_year, end_year, status,
+      COALESCE(network_id::TEXT, ''), COALESCE(networks.name, ''),
+      series.country, language,
+      version, series.created_at, series.updated_at,
+      (SELECT COUNT(*) FROM episodes WHERE series_id = series.id),
+      COALESCE((
+        SELECT json_agg(json_build_object(
+          'id', people.id, 'name', people.name
+        ) ORDER BY people.name, people.id)
+        FROM credits
+          JOIN people ON people.id = credits.person_id
+        WHERE credits.series_id = series.id AND credits.role = 'creator'
+      ), '[]'),
+      ARRAY(
+        SELECT genres.name
+        FROM series_genres
+          JOIN genres ON genres.id = series_genres.genre_id
+        WHERE series_genres.series_id = series.id
+        ORDER BY genres.name
+      ),
+      ARRAY(
+        SELECT tags.name
+        FROM series_tags
+          JOIN tags ON tags.id = series_tags.tag_id
+        WHERE series_tags.series_id = series.id
+        ORDER BY tags.name
+      ),
+      COALESCE((
+        SELECT json_agg(json_build_object(
+          'language', language, 'title', title, 'description', description
+        ) ORDER BY language)
+        FROM series_translations
+        WHERE series_id = series.id
+      ), '[]'),
+      ARRAY(
+        SELECT alias
+        FROM series_aliases
+        WHERE series_id = series.id
+        ORDER BY alias
+      ),
+      COALESCE((
+        SELECT alias
+        FROM series_aliases
+        WHERE series_id = series.id AND $1 <> ''
+          AND to_tsvector('simple', alias) @@ websearch_to_tsquery('simple', $1)
+        ORDER BY alias
+        LIMIT 1
+      ), '')
     FROM series
+      LEFT JOIN networks ON networks.id = series.network_id
     WHERE
-      make_tsvector(title, description) @@ to_tsquery($1)
+      ($1 = ''
+        OR make_tsvector(title, description) @@ websearch_to_tsquery('english', $1)
+        OR EXISTS (
+          SELECT 1
+          FROM series_translations
+          WHERE series_id = series.id
+            AND make_tsvector(title, description, text_search_config(language))
+              @@ websearch_to_tsquery(text_search_config(language), $1)
+        )
+        OR EXISTS (
+          SELECT 1
+          FROM series_aliases
+          WHERE series_id = series.id
+            AND to_tsvector('simple', alias) @@ websearch_to_tsquery('simple', $1)
+        ))
+      AND ($2 = '' OR EXISTS (
+        SELECT 1
+        FROM series_genres
+          JOIN genres ON genres.id = series_genres.genre_id
+        WHERE series_genres.series_id = series.id AND lower(genres.name) = lower($2)
+      ))
+      AND ($3 = '' OR EXISTS (
+        SELECT 1
+        FROM series_tags
+          JOIN tags ON tags.id = series_tags.tag_id
+        WHERE series_tags.series_id = series.id AND lower(tags.name) = lower($3)
+      ))
+      AND ($4 = '' OR lower(networks.name) = lower($4))
+      AND ($5 = '' OR series.country = $5)
+      AND ($6 = '' OR language = $6)
+      AND ($7 = '' OR status = $7)
+    ORDER BY series.title, series.id
+    LIMIT NULLIF($8, 0) OFFSET $9
   `
-	rows, err := querier.Query(ctx, query, title)
-	if errors.Is(err, pgx.ErrNoRows); err != nil {
-		return nil, nil
-	} else if err != nil {
+	rows, err := querier.Query(
+		ctx,
+		query,
+		title,
+		filter.Genre,
+		filter.Tag,
+		filter.Network,
+		filter.Country,
+		filter.Language,
+		filter.Status,
+		page.Limit,
+		page.Offset,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	series := []domain.Series{}
 	for rows.Next() {
 		var (
-			id                 string
-			title              string
-			description        string
-			episodes           int
-			beginYear, endYear int
-			creator            string
+			id, slug             string
+			title                string
+			description          string
+			episodes             int
+			beginYear, endYear   int
+			status               string
+			creators             []creator
+			networkID, network   string
+			country, language    string
+			version              int
+			createdAt, updatedAt time.Time
+			genres, tags         []string
+			translations         []translation
+			aliases              []string
+			matchedAlias         string
 		)
 		err := rows.Scan(
-			&id,
+			&id, &slug,
 			&title,
 			&description,
-			&episodes,
 			&beginYear, &endYear,
-			&creator,
+			&status,
+			&networkID, &network,
+			&country, &language,
+			&version,
+			&createdAt, &updatedAt,
+			&episodes,
+			&creators,
+			&genres, &tags,
+			&translations,
+			&aliases, &matchedAlias,
 		)
 		if err != nil {
 			return nil, err
@@ -153,11 +479,495 @@ func (r *seriesRepository) FindByTitle(ctx context.Context, title string) ([]dom
 			domain.SeriesID(id),
 			title,
 			description,
-			episodes,
 			beginYear,
 			endYear,
-			creator,
-		))
+		).WithStatus(domain.SeriesStatus(status)).
+			WithCreators(newCreators(creators)).
+			WithEpisodes(episodes).
+			WithGenres(genres).
+			WithTags(tags).
+			WithNetwork(domain.NetworkID(networkID), network).
+			WithOrigin(country, language).
+			WithSlug(slug).
+			WithTranslations(newTranslations(translations)).
+			WithAliases(aliases).
+			WithMatchedAlias(matchedAlias).
+			WithVersion(version).
+			WithTimestamps(createdAt, updatedAt))
+	}
+	return series, rows.Err()
+}
+
+// Update implements domain.SeriesRepository. The row is only updated
+// if its stored version matches series.Version(), otherwise
+// domain.ErrConcurrentModification is returned. domain.ErrSlugTaken is
+// returned when another series has or had the slug, the transaction
+// must then be rolled back to undo the update.
+func (r *seriesRepository) Update(
+	ctx context.Context,
+	series domain.Series,
+) (domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.Update")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	// The slug is added to the ones of the series, its previous
+	// slug keeps redirecting to it. No slug is returned when it
+	// belongs to another series. The creators are credited in the
+	// same statement, those who no longer are lose their credit.
+	const query = `
+    WITH updated AS (
+      UPDATE series
+      SET
+        title = $2, description = $3,
+        begin_year = $4, end_year = $5,
+        network_id = $9, country = $10, language = $11, status = $12,
+        slug = $13, version = version + 1, updated_at = $8
+      WHERE
+        id = $1 AND version = $7
+      RETURNING
+        id, version, created_at, updated_at,
+        (SELECT COUNT(*) FROM episodes WHERE series_id = series.id) AS episodes
+    ), slugs AS (
+      INSERT INTO
+        series_slugs(slug, series_id)
+      SELECT
+        $13, id
+      FROM updated
+      ON CONFLICT (slug) DO UPDATE
+      SET series_id = EXCLUDED.series_id
+      WHERE series_slugs.series_id = EXCLUDED.series_id
+      RETURNING slug
+    ), uncredited AS (
+      DELETE FROM credits
+      USING updated
+      WHERE
+        credits.series_id = updated.id AND credits.role = 'creator'
+        AND credits.person_id <> ALL($6::UUID[])
+    ), credited AS (
+      INSERT INTO
+        credits(id, series_id, person_id, role, created_at, updated_at)
+      SELECT
+        gen_random_uuid(), id, person_id, 'creator', $8, $8
+      FROM updated, unnest($6::UUID[]) AS person_id
+      ON CONFLICT DO NOTHING
+    )
+    SELECT
+      version, created_at, updated_at, episodes,
+      EXISTS (SELECT 1 FROM slugs) AS slugged
+    FROM updated
+  `
+
+	var (
+		version              int
+		createdAt, updatedAt time.Time
+		episodes             int
+		slugged              bool
+	)
+	row := querier.QueryRow(
+		ctx,
+		query,
+		series.ID(),
+		series.Title(),
+		series.Description(),
+		series.BeginYear(),
+		series.EndYear(),
+		creatorIDs(series),
+		series.Version(),
+		r.db.now(),
+		nullString(series.NetworkID().String()),
+		series.Country(),
+		series.Language(),
+		series.Status(),
+		series.Slug(),
+	)
+	err := row.Scan(&version, &createdAt, &updatedAt, &episodes, &slugged)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := r.FindByID(ctx, series.ID()); err != nil {
+			return domain.Series{}, err
+		}
+		logger.FromContext(ctx).
+			WithFields(logger.Fields{
+				"series_id": series.ID().String(),
+				"version":   series.Version(),
+			}).
+			Debugf("Stored version differs, not updating")
+		return domain.Series{}, domain.ErrConcurrentModification
+	} else if isUniqueViolation(err) {
+		return domain.Series{}, domain.ErrSlugTaken
+	} else if err != nil {
+		return domain.Series{}, err
+	}
+	if !slugged {
+		return domain.Series{}, domain.ErrSlugTaken
+	}
+	return series.WithEpisodes(episodes).
+		WithVersion(version).
+		WithTimestamps(createdAt, updatedAt), nil
+}
+
+// UpdateGenres implements domain.SeriesRepository
+func (r *seriesRepository) UpdateGenres(
+	ctx context.Context,
+	ID domain.SeriesID,
+	genres []domain.GenreID,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.UpdateGenres")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const deleteQuery = `
+    DELETE FROM series_genres
+    WHERE
+      series_id = $1
+  `
+	if _, err := execer.Exec(ctx, deleteQuery, ID); err != nil {
+		return err
+	}
+
+	const insertQuery = `
+    INSERT INTO
+      series_genres(series_id, genre_id)
+    SELECT
+      $1, unnest($2::UUID[])
+  `
+	ids := make([]string, len(genres))
+	for i, genre := range genres {
+		ids[i] = genre.String()
+	}
+	_, err := execer.Exec(ctx, insertQuery, ID, ids)
+	return err
+}
+
+// UpdateTags implements domain.SeriesRepository. Tags that
+// no series had before are created along the way, the others
+// keep their ID.
+func (r *seriesRepository) UpdateTags(
+	ctx context.Context,
+	ID domain.SeriesID,
+	tags []domain.Tag,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.UpdateTags")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	ids := make([]string, len(tags))
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID().String()
+		names[i] = tag.Name()
+	}
+
+	const createQuery = `
+    INSERT INTO
+      tags(id, name, created_at, updated_at)
+    SELECT
+      unnest($1::UUID[]), unnest($2::TEXT[]), $3, $3
+    ON CONFLICT (name) DO NOTHING
+  `
+	if _, err := execer.Exec(ctx, createQuery, ids, names, r.db.now()); err != nil {
+		return err
+	}
+
+	const deleteQuery = `
+    DELETE FROM series_tags
+    WHERE
+      series_id = $1
+  `
+	if _, err := execer.Exec(ctx, deleteQuery, ID); err != nil {
+		return err
+	}
+
+	const insertQuery = `
+    INSERT INTO
+      series_tags(series_id, tag_id)
+    SELECT
+      $1, id
+    FROM tags
+    WHERE
+      name = ANY($2)
+  `
+	_, err := execer.Exec(ctx, insertQuery, ID, names)
+	return err
+}
+
+// UpdateAliases implements domain.SeriesRepository
+func (r *seriesRepository) UpdateAliases(
+	ctx context.Context,
+	ID domain.SeriesID,
+	aliases []string,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.UpdateAliases")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const deleteQuery = `
+    DELETE FROM series_aliases
+    WHERE
+      series_id = $1
+  `
+	if _, err := execer.Exec(ctx, deleteQuery, ID); err != nil {
+		return err
+	}
+
+	const insertQuery = `
+    INSERT INTO
+      series_aliases(series_id, alias)
+    SELECT
+      $1, unnest($2::TEXT[])
+  `
+	_, err := execer.Exec(ctx, insertQuery, ID, aliases)
+	return err
+}
+
+// FindBySlug implements domain.SeriesRepository
+func (r *seriesRepository) FindBySlug(
+	ctx context.Context,
+	slug string,
+) (domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.FindBySlug")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      series_id
+    FROM series_slugs
+    WHERE
+      slug = $1
+  `
+	var seriesID string
+	err := querier.QueryRow(ctx, query, slug).Scan(&seriesID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Series{}, domain.ErrSeriesNotFound
+	} else if err != nil {
+		return domain.Series{}, err
+	}
+	return r.FindByID(ctx, domain.SeriesID(seriesID))
+}
+
+// FindByExternalID implements domain.SeriesRepository
+func (r *seriesRepository) FindByExternalID(
+	ctx context.Context,
+	externalID domain.ExternalID,
+) (domain.Series, error) {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.FindByExternalID")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      series_id
+    FROM series_external_ids
+    WHERE
+      provider = $1 AND external_id = $2
+  `
+	var seriesID string
+	err := querier.QueryRow(
+		ctx,
+		query,
+		externalID.Provider().String(),
+		externalID.ID(),
+	).Scan(&seriesID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Series{}, domain.ErrSeriesNotFound
+	} else if err != nil {
+		return domain.Series{}, err
+	}
+	return r.FindByID(ctx, domain.SeriesID(seriesID))
+}
+
+// UpdateExternalIDs implements domain.SeriesRepository
+func (r *seriesRepository) UpdateExternalIDs(
+	ctx context.Context,
+	ID domain.SeriesID,
+	externalIDs []domain.ExternalID,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.UpdateExternalIDs")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const deleteQuery = `
+    DELETE FROM series_external_ids
+    WHERE
+      series_id = $1
+  `
+	if _, err := execer.Exec(ctx, deleteQuery, ID); err != nil {
+		return err
+	}
+
+	providers := make([]string, len(externalIDs))
+	ids := make([]string, len(externalIDs))
+	for i, externalID := range externalIDs {
+		providers[i] = externalID.Provider().String()
+		ids[i] = externalID.ID()
+	}
+
+	const insertQuery = `
+    INSERT INTO
+      series_external_ids(series_id, provider, external_id)
+    SELECT
+      $1, unnest($2::TEXT[]), unnest($3::TEXT[])
+  `
+	_, err := execer.Exec(ctx, insertQuery, ID, providers, ids)
+	if isUniqueViolation(err) {
+		return domain.ErrExternalIDExists
+	}
+	return err
+}
+
+// UpdateTranslation implements domain.SeriesRepository
+func (r *seriesRepository) UpdateTranslation(
+	ctx context.Context,
+	ID domain.SeriesID,
+	translation domain.Translation,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.UpdateTranslation")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      series_translations(
+        series_id, language, title, description, created_at, updated_at
+      )
+    VALUES
+      ($1, $2, $3, $4, $5, $5)
+    ON CONFLICT (series_id, language) DO UPDATE
+    SET
+      title = EXCLUDED.title, description = EXCLUDED.description,
+      updated_at = EXCLUDED.updated_at
+  `
+	_, err := execer.Exec(
+		ctx,
+		query,
+		ID,
+		translation.Language(),
+		translation.Title(),
+		translation.Description(),
+		r.db.now(),
+	)
+	return err
+}
+
+// DeleteTranslation implements domain.SeriesRepository
+func (r *seriesRepository) DeleteTranslation(
+	ctx context.Context,
+	ID domain.SeriesID,
+	language string,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.DeleteTranslation")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    DELETE FROM series_translations
+    WHERE
+      series_id = $1 AND language = $2
+  `
+	tag, err := execer.Exec(ctx, query, ID, language)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTranslationNotFound
+	}
+	return nil
+}
+
+func (r *seriesRepository) WithTransaction(
+	ctx context.Context,
+	fn func(context.Context) error,
+) error {
+	ctx, span := tracer.Start(ctx, "SeriesRepository.WithTransaction")
+	defer span.End()
+
+	tx, err := r.db.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, CtxKeyTx, tx)
+	if err := fn(ctx); err != nil {
+		rbErr := tx.Rollback(ctx)
+		if rbErr != nil {
+			logger.FromContext(ctx).
+				WithFields(logger.Fields{"error": err}).
+				Errorf("Rolling back transaction failed: %v", rbErr)
+			return rbErr
+		}
+		return err
 	}
-	return series, nil
+	return tx.Commit(ctx)
 }
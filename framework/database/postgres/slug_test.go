@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"os"
+	"regexp"
+	"series/domain"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeSlug does what the make_slug function of scripts/init.sql does,
+// with the letters its translate() call replaces.
+func makeSlug(t *testing.T, title string, beginYear int) string {
+	t.Helper()
+
+	script, err := os.ReadFile("../../../scripts/init.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	letters := regexp.MustCompile(`translate\(lower\(title\), '([^']*)', '([^']*)'\)`).
+		FindStringSubmatch(string(script))
+	if letters == nil {
+		t.Fatal("make_slug doesn't translate letters anymore")
+	}
+	from, to := []rune(letters[1]), []rune(letters[2])
+
+	// Letters of from without a counterpart in to are removed.
+	slug := strings.Map(func(r rune) rune {
+		for i, letter := range from {
+			if letter != r {
+				continue
+			}
+			if i < len(to) {
+				return to[i]
+			}
+			return -1
+		}
+		return r
+	}, strings.ToLower(title))
+	slug = regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(slug, "-")
+	slug = strings.Trim(slug, "-")
+	if slug == "" {
+		return strconv.Itoa(beginYear)
+	}
+	return slug + "-" + strconv.Itoa(beginYear)
+}
+
+func TestNewSlugMatchesMakeSlug(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Title     string
+		BeginYear int
+	}
+	tests := []Test{
+		{Title: "Breaking Bad", BeginYear: 2008},
+		{Title: "La casa de papel", BeginYear: 2017},
+		{Title: "Élite", BeginYear: 2018},
+		{Title: "¿Quién mató a Sara?", BeginYear: 2021},
+		{Title: "Mr. Robot", BeginYear: 2015},
+		{Title: "30 Rock", BeginYear: 2006},
+		{Title: "Dark — Ünd Ñoño, Øresund & Ça", BeginYear: 2017},
+		{Title: "  --Spaces   and hyphens--  ", BeginYear: 1999},
+		{Title: "!!!", BeginYear: 2000},
+		{Title: "", BeginYear: 2000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Title, func(t *testing.T) {
+			assert.Equal(
+				t,
+				makeSlug(t, test.Title, test.BeginYear),
+				domain.NewSlug(test.Title, test.BeginYear),
+			)
+		})
+	}
+}
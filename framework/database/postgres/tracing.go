@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer starts a span for every repository method. It is a no-op until
+// a tracer provider is installed with otel.SetTracerProvider.
+var tracer = otel.Tracer("series/framework/database/postgres")
+
+// statementTracer turns the statements pgx logs into spans. pgx v4 has no
+// tracing hooks, but it logs every statement once it is done, with the
+// context it was run with and the time it took, so the span is started in
+// the past. Statements run outside of a traced operation are left out.
+type statementTracer struct{}
+
+func (statementTracer) Log(
+	ctx context.Context,
+	_ pgx.LogLevel,
+	msg string,
+	data map[string]any,
+) {
+	switch msg {
+	case "Query", "Exec", "SendBatch":
+	default:
+		return
+	}
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return
+	}
+
+	end := time.Now()
+	elapsed, _ := data["time"].(time.Duration)
+	sql, _ := data["sql"].(string)
+
+	operation := "BATCH"
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+
+	_, span := tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(end.Add(-elapsed)),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationKey.String(operation),
+			semconv.DBStatementKey.String(strings.TrimSpace(sql)),
+		),
+	)
+	if err, ok := data["err"].(error); ok {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
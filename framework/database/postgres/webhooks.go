@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type webhookRepository struct {
+	db *DB
+}
+
+func (r *webhookRepository) Create(
+	ctx context.Context,
+	webhook domain.Webhook,
+) (domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.Create")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    INSERT INTO
+      webhooks(id, url, secret, events, created_at, updated_at)
+    VALUES
+      ($1, $2, $3, $4, $5, $5)
+  `
+
+	now := r.db.now()
+	_, err := execer.Exec(
+		ctx,
+		query,
+		webhook.ID(),
+		webhook.URL(),
+		webhook.Secret(),
+		eventNames(webhook.Events()),
+		now,
+	)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+	return webhook.WithTimestamps(now, now), nil
+}
+
+func (r *webhookRepository) FindAll(
+	ctx context.Context,
+) ([]domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.FindAll")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, url, secret, events, created_at, updated_at
+    FROM webhooks
+    ORDER BY
+      created_at
+  `
+
+	return r.find(ctx, query)
+}
+
+func (r *webhookRepository) FindByEvent(
+	ctx context.Context,
+	event domain.EventType,
+) ([]domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.FindByEvent")
+	defer span.End()
+
+	const query = `
+    SELECT
+      id, url, secret, events, created_at, updated_at
+    FROM webhooks
+    WHERE
+      $1 = ANY(events)
+  `
+
+	return r.find(ctx, query, event.String())
+}
+
+func (r *webhookRepository) find(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.Webhook, error) {
+	var querier interface {
+		Query(context.Context, string, ...any) (pgx.Rows, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []domain.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (r *webhookRepository) FindByID(
+	ctx context.Context,
+	ID domain.WebhookID,
+) (domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.FindByID")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    SELECT
+      id, url, secret, events, created_at, updated_at
+    FROM webhooks
+    WHERE
+      id = $1
+  `
+
+	webhook, err := scanWebhook(querier.QueryRow(ctx, query, ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	} else if err != nil {
+		return domain.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// Update stores the URL and the events of the webhook, its secret
+// is left as is and returned along with the other stored fields.
+func (r *webhookRepository) Update(
+	ctx context.Context,
+	webhook domain.Webhook,
+) (domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.Update")
+	defer span.End()
+
+	var querier interface {
+		QueryRow(context.Context, string, ...any) pgx.Row
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		querier = tx
+	}
+
+	const query = `
+    UPDATE webhooks
+    SET
+      url = $2, events = $3, updated_at = $4
+    WHERE
+      id = $1
+    RETURNING
+      id, url, secret, events, created_at, updated_at
+  `
+
+	row := querier.QueryRow(
+		ctx,
+		query,
+		webhook.ID(),
+		webhook.URL(),
+		eventNames(webhook.Events()),
+		r.db.now(),
+	)
+	webhook, err := scanWebhook(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	} else if err != nil {
+		return domain.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// Delete removes the webhook, its deliveries are removed by the database.
+func (r *webhookRepository) Delete(
+	ctx context.Context,
+	ID domain.WebhookID,
+) error {
+	ctx, span := tracer.Start(ctx, "WebhookRepository.Delete")
+	defer span.End()
+
+	var execer interface {
+		Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	} = r.db.pool
+
+	tx, ok := ctx.Value(CtxKeyTx).(pgx.Tx)
+	if ok {
+		execer = tx
+	}
+
+	const query = `
+    DELETE FROM webhooks
+    WHERE
+      id = $1
+  `
+
+	tag, err := execer.Exec(ctx, query, ID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func scanWebhook(row pgx.Row) (domain.Webhook, error) {
+	var (
+		id, url, secret      string
+		events               []string
+		createdAt, updatedAt time.Time
+	)
+	err := row.Scan(
+		&id,
+		&url,
+		&secret,
+		&events,
+		&createdAt, &updatedAt,
+	)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	types := make([]domain.EventType, len(events))
+	for i, event := range events {
+		types[i] = domain.EventType(event)
+	}
+	return domain.NewWebhook(
+		domain.WebhookID(id),
+		url,
+		secret,
+		types,
+	).WithTimestamps(createdAt, updatedAt), nil
+}
+
+func eventNames(events []domain.EventType) []string {
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = event.String()
+	}
+	return names
+}
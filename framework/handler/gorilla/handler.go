@@ -2,73 +2,235 @@ package gorilla
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"series/adapter/api/action"
+	"series/adapter/api/graphql"
 	"series/adapter/api/middleware"
+	"series/adapter/api/request"
+	"series/adapter/health"
 	"series/adapter/logger"
+	"series/adapter/metrics"
 	"series/adapter/presenter"
+	"series/adapter/reporter"
 	"series/adapter/repository"
 	"series/adapter/validator"
+	"series/domain"
 	"series/usecase"
 	"time"
 
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// heartbeat is how often idle event streams get a comment.
+const heartbeat = 15 * time.Second
+
 type service struct {
 	repo      repository.Repository
+	broker    domain.ReviewBroker
 	logger    logger.Logger
+	metrics   metrics.Metrics
+	monitor   *health.Monitor
+	reporter  reporter.ErrorReporter
 	validator validator.Validator
 	dbTimeout time.Duration
 	router    *mux.Router
+	handler   http.Handler
 }
 
 func NewHandler(
 	repo repository.Repository,
+	broker domain.ReviewBroker,
 	logger logger.Logger,
+	metrics metrics.Metrics,
+	monitor *health.Monitor,
+	reporter reporter.ErrorReporter,
 	validator validator.Validator,
 	dbTimeout time.Duration,
 ) http.Handler {
 	service := &service{
 		repo:      repo,
+		broker:    broker,
 		logger:    logger,
+		metrics:   metrics,
+		monitor:   monitor,
+		reporter:  reporter,
 		validator: validator,
 		dbTimeout: dbTimeout,
 		router:    &mux.Router{},
 	}
 
 	router := mux.NewRouter()
+	router.Use(nameSpan)
+	router.Use(middleware.RequestID(logger))
 	api := router.PathPrefix("/v1").Subrouter()
 
-	api.Use(middleware.Logging(logger))
+	api.Use(middleware.Logging)
+	api.Use(middleware.Metrics(metrics, routeTemplate))
+	api.Use(middleware.Recovery(metrics, reporter, routeTemplate))
 	api.Use(middleware.CORS)
+	api.Use(middleware.Negotiation)
 
 	api.Handle("/series", service.buildCreateSeriesAction()).Methods(http.MethodPost)
 	api.Handle("/series", service.buildFindSeriesByTitleAction()).
-		Queries("q", "{query}").
+		Methods(http.MethodGet).
+		Name(action.RouteSeriesSearch)
+	// Registered before /series/{id}, that would match it otherwise.
+	api.Handle("/series/lookup", service.buildFindSeriesByExternalIDAction()).
 		Methods(http.MethodGet)
 	api.Handle("/series/{id}", service.buildFindSeriesByIDAction()).
-		Methods(http.MethodGet)
+		Methods(http.MethodGet).
+		Name(action.RouteSeries)
+	api.Handle("/series/{id}", service.buildUpdateSeriesAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/status", service.buildChangeSeriesStatusAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/genres", service.buildUpdateSeriesGenresAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/tags", service.buildUpdateSeriesTagsAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/aliases", service.buildUpdateSeriesAliasesAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/external_ids", service.buildUpdateSeriesExternalIDsAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/translations/{language}", service.buildUpdateSeriesTranslationAction()).
+		Methods(http.MethodPut)
+	api.Handle("/series/{id}/translations/{language}", service.buildDeleteSeriesTranslationAction()).
+		Methods(http.MethodDelete)
+	api.Handle("/series/{id}/credits", service.buildCreateCreditAction()).
+		Methods(http.MethodPost)
+	api.Handle("/series/{id}/credits", service.buildFindCreditsBySeriesAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteSeriesCredits)
 	api.Handle("/series/{id}/reviews", service.buildReviewsBySeriesAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteSeriesReviews)
+	api.Handle("/series/{id}/reviews/stream", service.buildStreamReviewsBySeriesAction()).
 		Methods(http.MethodGet)
+	api.Handle("/series/{id}/seasons", service.buildCreateSeasonAction()).
+		Methods(http.MethodPost)
+	api.Handle("/series/{id}/seasons", service.buildFindSeasonsBySeriesAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteSeriesSeasons)
+	api.Handle("/series/{id}/seasons/{number}/episodes", service.buildCreateEpisodeAction()).
+		Methods(http.MethodPost)
+	api.Handle("/series/{id}/seasons/{number}/episodes", service.buildFindEpisodesBySeasonAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteSeasonEpisodes)
+	api.Handle("/genres", service.buildCreateGenreAction()).Methods(http.MethodPost)
+	api.Handle("/genres", service.buildFindGenresAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteGenres)
+	api.Handle("/networks", service.buildCreateNetworkAction()).Methods(http.MethodPost)
+	api.Handle("/networks", service.buildFindNetworksAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteNetworks)
+	api.Handle("/episodes/{id}/reviews", service.buildFindReviewsByEpisodeAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteEpisodeReviews)
+	api.Handle("/people", service.buildCreatePersonAction()).Methods(http.MethodPost)
+	api.Handle("/people/{id}/credits", service.buildFindCreditsByPersonAction()).
+		Methods(http.MethodGet).
+		Name(action.RoutePersonCredits)
 	api.Handle("/reviews", service.buildCreateReviewAction()).Methods(http.MethodPost)
+	api.Handle("/reviews/{id}", service.buildFindReviewByIDAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteReview)
+	api.Handle("/reviews/{id}", service.buildUpdateReviewAction()).
+		Methods(http.MethodPut)
+	api.Handle("/webhooks", service.buildCreateWebhookAction()).Methods(http.MethodPost)
+	api.Handle("/webhooks", service.buildFindWebhooksAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteWebhooks)
+	api.Handle("/webhooks/{id}", service.buildFindWebhookByIDAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteWebhook)
+	api.Handle("/webhooks/{id}", service.buildUpdateWebhookAction()).
+		Methods(http.MethodPut)
+	api.Handle("/webhooks/{id}", service.buildDeleteWebhookAction()).
+		Methods(http.MethodDelete)
+	api.Handle("/webhooks/{id}/deliveries", service.buildFindDeliveriesByWebhookAction()).
+		Methods(http.MethodGet).
+		Name(action.RouteWebhookDeliveries)
+
+	router.Handle("/graphql", middleware.Logging(
+		middleware.Metrics(metrics, routeTemplate)(
+			middleware.Recovery(metrics, reporter, routeTemplate)(
+				middleware.CORS(service.buildGraphQLHandler()),
+			),
+		),
+	)).Methods(http.MethodPost)
+
+	// Probes are neither logged nor counted, orchestrators send plenty.
+	router.Handle("/healthz", service.buildHealthzAction()).Methods(http.MethodGet)
+	router.Handle("/readyz", service.buildReadyzAction()).Methods(http.MethodGet)
+	router.Handle("/status", service.buildStatusAction()).Methods(http.MethodGet)
 
 	service.router = router
+	// The span is started before routing, with the traceparent header of
+	// the request as parent, and named by nameSpan once a route matched.
+	service.handler = otelhttp.NewHandler(router, "HTTP")
 	return service
 }
 
 func (s *service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	s.router.ServeHTTP(w, r)
+	s.handler.ServeHTTP(w, r)
+}
+
+// nameSpan names the span of a request after its method and route template.
+func nameSpan(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := routeTemplate(r); route != "" {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(semconv.HTTPRouteKey.String(route))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeTemplate labels the metrics of a request with the path template of
+// its route rather than with its path, which holds resource ids.
+func routeTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return template
+}
+
+// URL implements action.URLBuilder with the named routes of the router.
+func (s *service) URL(name string, pairs ...string) (string, error) {
+	route := s.router.Get(name)
+	if route == nil {
+		return "", fmt.Errorf("route %q is not registered", name)
+	}
+	url, err := route.URL(pairs...)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
 }
 
 func (s *service) buildCreateSeriesAction() http.Handler {
 	f := func(w http.ResponseWriter, r *http.Request) {
 		uc := usecase.NewCreateSeriesInteractor(
 			s.repo.NewSeriesRepository(),
+			s.repo.NewNetworkRepository(),
+			s.repo.NewPersonRepository(),
+			s.repo.NewEventRepository(),
 			presenter.NewCreateSeriesPresenter(),
 			s.dbTimeout,
 		)
-		action := action.NewCreateSeriesAction(uc, s.validator)
+		uc = usecase.InstrumentCreateSeries(uc, s.metrics)
+		action := action.NewCreateSeriesAction(uc, s.validator, s)
 		action.Execute(w, r)
 	}
 	return http.HandlerFunc(f)
@@ -78,28 +240,321 @@ func (s *service) buildCreateReviewAction() http.Handler {
 	f := func(w http.ResponseWriter, r *http.Request) {
 		uc := usecase.NewCreateReviewInteractor(
 			s.repo.NewSeriesRepository(),
+			s.repo.NewEpisodeRepository(),
 			s.repo.NewReviewRepository(),
+			s.repo.NewEventRepository(),
 			presenter.NewCreateReviewPresenter(),
 			s.dbTimeout,
 		)
-		action := action.NewCreateReviewAction(uc, s.validator)
+		uc = usecase.InstrumentCreateReview(uc, s.metrics)
+		action := action.NewCreateReviewAction(uc, s.validator, s)
 		action.Execute(w, r)
 	}
 	return http.HandlerFunc(f)
 }
 
-func (s *service) buildFindSeriesByTitleAction() http.Handler {
+func (s *service) buildUpdateSeriesAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewUpdateSeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewNetworkRepository(),
+			s.repo.NewPersonRepository(),
+			presenter.NewUpdateSeriesPresenter(),
+			s.dbTimeout,
+		)
+		uc = usecase.InstrumentUpdateSeries(uc, s.metrics)
+		action := action.NewUpdateSeriesAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildChangeSeriesStatusAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewChangeSeriesStatusInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewChangeSeriesStatusPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewChangeSeriesStatusAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateSeriesGenresAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewUpdateSeriesGenresInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewGenreRepository(),
+			presenter.NewUpdateSeriesGenresPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateSeriesGenresAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateSeriesTagsAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewUpdateSeriesTagsInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewUpdateSeriesTagsPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateSeriesTagsAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateSeriesAliasesAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewUpdateSeriesAliasesInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewUpdateSeriesAliasesPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateSeriesAliasesAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateSeriesExternalIDsAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewUpdateSeriesExternalIDsInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewUpdateSeriesExternalIDsPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateSeriesExternalIDsAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateSeriesTranslationAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ctx := context.WithValue(r.Context(), action.CtxKeySeriesID, vars["id"])
+		ctx = context.WithValue(ctx, action.CtxKeyLanguage, vars["language"])
+		r = r.WithContext(ctx)
+		uc := usecase.NewUpdateSeriesTranslationInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewUpdateSeriesTranslationPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateSeriesTranslationAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildDeleteSeriesTranslationAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ctx := context.WithValue(r.Context(), action.CtxKeySeriesID, vars["id"])
+		ctx = context.WithValue(ctx, action.CtxKeyLanguage, vars["language"])
+		r = r.WithContext(ctx)
+		uc := usecase.NewDeleteSeriesTranslationInteractor(
+			s.repo.NewSeriesRepository(),
+			s.dbTimeout,
+		)
+		action := action.NewDeleteSeriesTranslationAction(uc)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateCreditAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewCreateCreditInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewPersonRepository(),
+			s.repo.NewCreditRepository(),
+			presenter.NewCreateCreditPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateCreditAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindCreditsBySeriesAction() http.Handler {
 	f := func(w http.ResponseWriter, r *http.Request) {
-		query := mux.Vars(r)["query"]
+		seriesID := mux.Vars(r)["id"]
 		r = r.WithContext(
-			context.WithValue(r.Context(), action.CtxKeyTitleQuery, query),
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewFindCreditsBySeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewCreditRepository(),
+			presenter.NewFindCreditsBySeriesPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindCreditsBySeriesAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreatePersonAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewCreatePersonInteractor(
+			s.repo.NewPersonRepository(),
+			presenter.NewCreatePersonPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreatePersonAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindCreditsByPersonAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		personID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyPersonID, personID),
+		)
+		uc := usecase.NewFindCreditsByPersonInteractor(
+			s.repo.NewPersonRepository(),
+			s.repo.NewCreditRepository(),
+			presenter.NewFindCreditsByPersonPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindCreditsByPersonAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateGenreAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewCreateGenreInteractor(
+			s.repo.NewGenreRepository(),
+			presenter.NewCreateGenrePresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateGenreAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindGenresAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewFindGenresInteractor(
+			s.repo.NewGenreRepository(),
+			presenter.NewFindGenresPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindGenresAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateNetworkAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewCreateNetworkInteractor(
+			s.repo.NewNetworkRepository(),
+			presenter.NewCreateNetworkPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateNetworkAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindNetworksAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewFindNetworksInteractor(
+			s.repo.NewNetworkRepository(),
+			presenter.NewFindNetworksPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindNetworksAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindReviewByIDAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		reviewID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyReviewID, reviewID),
+		)
+		uc := usecase.NewFindReviewByIDInteractor(
+			s.repo.NewReviewRepository(),
+			presenter.NewFindReviewByIDPresenter(),
+			s.dbTimeout,
 		)
+		action := action.NewFindReviewByIDAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateReviewAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		reviewID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyReviewID, reviewID),
+		)
+		uc := usecase.NewUpdateReviewInteractor(
+			s.repo.NewReviewRepository(),
+			presenter.NewUpdateReviewPresenter(),
+			s.dbTimeout,
+		)
+		uc = usecase.InstrumentUpdateReview(uc, s.metrics)
+		action := action.NewUpdateReviewAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindSeriesByTitleAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
 		uc := usecase.NewFindSeriesByTitleInteractor(
 			s.repo.NewSeriesRepository(),
-			presenter.NewFindSeriesByTitlePresenter(),
+			presenter.NewFindSeriesByTitlePresenter(request.Languages(r)),
 			s.dbTimeout,
 		)
-		action := action.NewFindSeriesByTitleAction(uc)
+		action := action.NewFindSeriesByTitleAction(uc, s.validator, s)
 		action.Execute(w, r)
 	}
 	return http.HandlerFunc(f)
@@ -114,10 +569,23 @@ func (s *service) buildFindSeriesByIDAction() http.Handler {
 		uc := usecase.NewFindSeriesByIDInteractor(
 			s.repo.NewSeriesRepository(),
 			s.repo.NewReviewRepository(),
-			presenter.NewFindSeriesByIDPresenter(),
+			presenter.NewFindSeriesByIDPresenter(request.Languages(r)),
+			s.dbTimeout,
+		)
+		action := action.NewFindSeriesByIDAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindSeriesByExternalIDAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewFindSeriesByExternalIDInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewFindSeriesByIDPresenter(request.Languages(r)),
 			s.dbTimeout,
 		)
-		action := action.NewFindSeriesByIDAction(uc)
+		action := action.NewFindSeriesByExternalIDAction(uc, s.validator, s)
 		action.Execute(w, r)
 	}
 	return http.HandlerFunc(f)
@@ -135,7 +603,284 @@ func (s *service) buildReviewsBySeriesAction() http.Handler {
 			presenter.NewFindReviewsBySeriesPresenter(),
 			s.dbTimeout,
 		)
-		action := action.NewFindReviewsBySeriesAction(uc)
+		action := action.NewFindReviewsBySeriesAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateSeasonAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewCreateSeasonInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewSeasonRepository(),
+			presenter.NewCreateSeasonPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateSeasonAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindSeasonsBySeriesAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewFindSeasonsBySeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewSeasonRepository(),
+			presenter.NewFindSeasonsBySeriesPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindSeasonsBySeriesAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateEpisodeAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ctx := context.WithValue(r.Context(), action.CtxKeySeriesID, vars["id"])
+		ctx = context.WithValue(ctx, action.CtxKeySeasonNumber, vars["number"])
+		r = r.WithContext(ctx)
+		uc := usecase.NewCreateEpisodeInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewSeasonRepository(),
+			s.repo.NewEpisodeRepository(),
+			presenter.NewCreateEpisodePresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateEpisodeAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindEpisodesBySeasonAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ctx := context.WithValue(r.Context(), action.CtxKeySeriesID, vars["id"])
+		ctx = context.WithValue(ctx, action.CtxKeySeasonNumber, vars["number"])
+		r = r.WithContext(ctx)
+		uc := usecase.NewFindEpisodesBySeasonInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewSeasonRepository(),
+			s.repo.NewEpisodeRepository(),
+			presenter.NewFindEpisodesBySeasonPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindEpisodesBySeasonAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindReviewsByEpisodeAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		episodeID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyEpisodeID, episodeID),
+		)
+		uc := usecase.NewFindReviewsByEpisodeInteractor(
+			s.repo.NewEpisodeRepository(),
+			s.repo.NewReviewRepository(),
+			presenter.NewFindReviewsByEpisodePresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindReviewsByEpisodeAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildStreamReviewsBySeriesAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		seriesID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeySeriesID, seriesID),
+		)
+		uc := usecase.NewStreamReviewsBySeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.broker,
+			presenter.NewStreamReviewsBySeriesPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewStreamReviewsBySeriesAction(uc, heartbeat)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildCreateWebhookAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewCreateWebhookInteractor(
+			s.repo.NewWebhookRepository(),
+			presenter.NewCreateWebhookPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewCreateWebhookAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindWebhooksAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		uc := usecase.NewFindWebhooksInteractor(
+			s.repo.NewWebhookRepository(),
+			presenter.NewFindWebhooksPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindWebhooksAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindWebhookByIDAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		webhookID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyWebhookID, webhookID),
+		)
+		uc := usecase.NewFindWebhookByIDInteractor(
+			s.repo.NewWebhookRepository(),
+			presenter.NewFindWebhookByIDPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindWebhookByIDAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildUpdateWebhookAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		webhookID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyWebhookID, webhookID),
+		)
+		uc := usecase.NewUpdateWebhookInteractor(
+			s.repo.NewWebhookRepository(),
+			presenter.NewUpdateWebhookPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewUpdateWebhookAction(uc, s.validator, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildDeleteWebhookAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		webhookID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyWebhookID, webhookID),
+		)
+		uc := usecase.NewDeleteWebhookInteractor(
+			s.repo.NewWebhookRepository(),
+			s.dbTimeout,
+		)
+		action := action.NewDeleteWebhookAction(uc)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildFindDeliveriesByWebhookAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		webhookID := mux.Vars(r)["id"]
+		r = r.WithContext(
+			context.WithValue(r.Context(), action.CtxKeyWebhookID, webhookID),
+		)
+		uc := usecase.NewFindDeliveriesByWebhookInteractor(
+			s.repo.NewWebhookRepository(),
+			s.repo.NewDeliveryRepository(),
+			presenter.NewFindDeliveriesByWebhookPresenter(),
+			s.dbTimeout,
+		)
+		action := action.NewFindDeliveriesByWebhookAction(uc, s)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildGraphQLHandler() http.Handler {
+	return graphql.NewHandler(graphql.UseCases{
+		FindSeriesByID: usecase.NewFindSeriesByIDInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewReviewRepository(),
+			presenter.NewFindSeriesByIDPresenter(nil),
+			s.dbTimeout,
+		),
+		FindSeriesByTitle: usecase.NewFindSeriesByTitleInteractor(
+			s.repo.NewSeriesRepository(),
+			presenter.NewFindSeriesByTitlePresenter(nil),
+			s.dbTimeout,
+		),
+		FindReviewsBySeriesIDs: usecase.NewFindReviewsBySeriesIDsInteractor(
+			s.repo.NewReviewRepository(),
+			presenter.NewFindReviewsBySeriesIDsPresenter(),
+			s.dbTimeout,
+		),
+		CreateSeries: usecase.InstrumentCreateSeries(usecase.NewCreateSeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewNetworkRepository(),
+			s.repo.NewPersonRepository(),
+			s.repo.NewEventRepository(),
+			presenter.NewCreateSeriesPresenter(),
+			s.dbTimeout,
+		), s.metrics),
+		UpdateSeries: usecase.InstrumentUpdateSeries(usecase.NewUpdateSeriesInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewNetworkRepository(),
+			s.repo.NewPersonRepository(),
+			presenter.NewUpdateSeriesPresenter(),
+			s.dbTimeout,
+		), s.metrics),
+		CreateReview: usecase.InstrumentCreateReview(usecase.NewCreateReviewInteractor(
+			s.repo.NewSeriesRepository(),
+			s.repo.NewEpisodeRepository(),
+			s.repo.NewReviewRepository(),
+			s.repo.NewEventRepository(),
+			presenter.NewCreateReviewPresenter(),
+			s.dbTimeout,
+		), s.metrics),
+		UpdateReview: usecase.InstrumentUpdateReview(usecase.NewUpdateReviewInteractor(
+			s.repo.NewReviewRepository(),
+			presenter.NewUpdateReviewPresenter(),
+			s.dbTimeout,
+		), s.metrics),
+	}, s.validator)
+}
+
+func (s *service) buildHealthzAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		action := action.NewHealthzAction()
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildReadyzAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		action := action.NewReadyzAction(s.monitor)
+		action.Execute(w, r)
+	}
+	return http.HandlerFunc(f)
+}
+
+func (s *service) buildStatusAction() http.Handler {
+	f := func(w http.ResponseWriter, r *http.Request) {
+		action := action.NewStatusAction(s.monitor)
 		action.Execute(w, r)
 	}
 	return http.HandlerFunc(f)
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"series/adapter/api/middleware"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// logging identifies calls like middleware.RequestID does requests, with
+// their x-request-id metadata or a new UUID, and logs them once served.
+func logging(log logger.Logger) grpc.UnaryServerInterceptor {
+	header := strings.ToLower(middleware.HeaderRequestID)
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(header); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		if !middleware.IsValidRequestID(id) {
+			id = domain.NewUUID()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(header, id))
+
+		log := log.WithFields(logger.Fields{"request_id": id})
+		ctx = middleware.NewRequestIDContext(ctx, id)
+		res, err := handler(logger.NewContext(ctx, log), req)
+
+		code := status.Code(err)
+		log = log.WithFields(logger.Fields{
+			"method":     info.FullMethod,
+			"code":       code.String(),
+			"elapsed_ms": time.Since(start).Milliseconds(),
+		})
+
+		var logFn func(string, ...any)
+		switch code {
+		case codes.OK:
+			logFn = log.Infof
+		case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+			codes.Aborted, codes.FailedPrecondition:
+			logFn = log.Warnf
+		default:
+			logFn = log.Errorf
+		}
+		logFn("%s %s", info.FullMethod, code)
+		return res, err
+	}
+}
@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: framework/handler/grpc/proto/series.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SeriesServiceClient is the client API for SeriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SeriesServiceClient interface {
+	CreateSeries(ctx context.Context, in *CreateSeriesInput, opts ...grpc.CallOption) (*CreateSeriesOutput, error)
+	UpdateSeries(ctx context.Context, in *UpdateSeriesInput, opts ...grpc.CallOption) (*UpdateSeriesOutput, error)
+	FindSeriesByID(ctx context.Context, in *FindSeriesByIDInput, opts ...grpc.CallOption) (*FindSeriesByIDOutput, error)
+	FindSeriesByTitle(ctx context.Context, in *FindSeriesByTitleInput, opts ...grpc.CallOption) (*FindSeriesByTitleOutput, error)
+	FindReviewsBySeries(ctx context.Context, in *FindReviewsBySeriesInput, opts ...grpc.CallOption) (*FindReviewsBySeriesOutput, error)
+	CreateReview(ctx context.Context, in *CreateReviewInput, opts ...grpc.CallOption) (*CreateReviewOutput, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewInput, opts ...grpc.CallOption) (*UpdateReviewOutput, error)
+}
+
+type seriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeriesServiceClient(cc grpc.ClientConnInterface) SeriesServiceClient {
+	return &seriesServiceClient{cc}
+}
+
+func (c *seriesServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesInput, opts ...grpc.CallOption) (*CreateSeriesOutput, error) {
+	out := new(CreateSeriesOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/CreateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesInput, opts ...grpc.CallOption) (*UpdateSeriesOutput, error) {
+	out := new(UpdateSeriesOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/UpdateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) FindSeriesByID(ctx context.Context, in *FindSeriesByIDInput, opts ...grpc.CallOption) (*FindSeriesByIDOutput, error) {
+	out := new(FindSeriesByIDOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/FindSeriesByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) FindSeriesByTitle(ctx context.Context, in *FindSeriesByTitleInput, opts ...grpc.CallOption) (*FindSeriesByTitleOutput, error) {
+	out := new(FindSeriesByTitleOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/FindSeriesByTitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) FindReviewsBySeries(ctx context.Context, in *FindReviewsBySeriesInput, opts ...grpc.CallOption) (*FindReviewsBySeriesOutput, error) {
+	out := new(FindReviewsBySeriesOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/FindReviewsBySeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) CreateReview(ctx context.Context, in *CreateReviewInput, opts ...grpc.CallOption) (*CreateReviewOutput, error) {
+	out := new(CreateReviewOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/CreateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewInput, opts ...grpc.CallOption) (*UpdateReviewOutput, error) {
+	out := new(UpdateReviewOutput)
+	err := c.cc.Invoke(ctx, "/series.v1.SeriesService/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeriesServiceServer is the server API for SeriesService service.
+// All implementations must embed UnimplementedSeriesServiceServer
+// for forward compatibility
+type SeriesServiceServer interface {
+	CreateSeries(context.Context, *CreateSeriesInput) (*CreateSeriesOutput, error)
+	UpdateSeries(context.Context, *UpdateSeriesInput) (*UpdateSeriesOutput, error)
+	FindSeriesByID(context.Context, *FindSeriesByIDInput) (*FindSeriesByIDOutput, error)
+	FindSeriesByTitle(context.Context, *FindSeriesByTitleInput) (*FindSeriesByTitleOutput, error)
+	FindReviewsBySeries(context.Context, *FindReviewsBySeriesInput) (*FindReviewsBySeriesOutput, error)
+	CreateReview(context.Context, *CreateReviewInput) (*CreateReviewOutput, error)
+	UpdateReview(context.Context, *UpdateReviewInput) (*UpdateReviewOutput, error)
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+// UnimplementedSeriesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSeriesServiceServer struct {
+}
+
+func (UnimplementedSeriesServiceServer) CreateSeries(context.Context, *CreateSeriesInput) (*CreateSeriesOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) UpdateSeries(context.Context, *UpdateSeriesInput) (*UpdateSeriesOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) FindSeriesByID(context.Context, *FindSeriesByIDInput) (*FindSeriesByIDOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSeriesByID not implemented")
+}
+func (UnimplementedSeriesServiceServer) FindSeriesByTitle(context.Context, *FindSeriesByTitleInput) (*FindSeriesByTitleOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSeriesByTitle not implemented")
+}
+func (UnimplementedSeriesServiceServer) FindReviewsBySeries(context.Context, *FindReviewsBySeriesInput) (*FindReviewsBySeriesOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindReviewsBySeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) CreateReview(context.Context, *CreateReviewInput) (*CreateReviewOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedSeriesServiceServer) UpdateReview(context.Context, *UpdateReviewInput) (*UpdateReviewOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedSeriesServiceServer) mustEmbedUnimplementedSeriesServiceServer() {}
+
+// UnsafeSeriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeriesServiceServer will
+// result in compilation errors.
+type UnsafeSeriesServiceServer interface {
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+func RegisterSeriesServiceServer(s grpc.ServiceRegistrar, srv SeriesServiceServer) {
+	s.RegisterService(&SeriesService_ServiceDesc, srv)
+}
+
+func _SeriesService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/CreateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).CreateSeries(ctx, req.(*CreateSeriesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/UpdateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_FindSeriesByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSeriesByIDInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).FindSeriesByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/FindSeriesByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).FindSeriesByID(ctx, req.(*FindSeriesByIDInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_FindSeriesByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSeriesByTitleInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).FindSeriesByTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/FindSeriesByTitle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).FindSeriesByTitle(ctx, req.(*FindSeriesByTitleInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_FindReviewsBySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindReviewsBySeriesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).FindReviewsBySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/FindReviewsBySeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).FindReviewsBySeries(ctx, req.(*FindReviewsBySeriesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/CreateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).CreateReview(ctx, req.(*CreateReviewInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/series.v1.SeriesService/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).UpdateReview(ctx, req.(*UpdateReviewInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SeriesService_ServiceDesc is the grpc.ServiceDesc for SeriesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SeriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "series.v1.SeriesService",
+	HandlerType: (*SeriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSeries",
+			Handler:    _SeriesService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _SeriesService_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "FindSeriesByID",
+			Handler:    _SeriesService_FindSeriesByID_Handler,
+		},
+		{
+			MethodName: "FindSeriesByTitle",
+			Handler:    _SeriesService_FindSeriesByTitle_Handler,
+		},
+		{
+			MethodName: "FindReviewsBySeries",
+			Handler:    _SeriesService_FindReviewsBySeries_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _SeriesService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _SeriesService_UpdateReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "framework/handler/grpc/proto/series.proto",
+}
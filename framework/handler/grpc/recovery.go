@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"series/adapter/api/middleware"
+	"series/adapter/logger"
+	"series/adapter/reporter"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recovery turns a panic of a call into an Internal status, logged with its
+// stack and reported like middleware.Recovery does for HTTP requests. It
+// comes after logging, whose context carries the request id and logger.
+func recovery(r reporter.ErrorReporter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (res any, err error) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			crash := reporter.Crash{
+				Time:      time.Now(),
+				RequestID: middleware.RequestIDFromContext(ctx),
+				Method:    "gRPC",
+				Path:      info.FullMethod,
+				Panic:     fmt.Sprint(rec),
+				Stack:     string(debug.Stack()),
+			}
+
+			log := logger.FromContext(ctx)
+			log.WithFields(logger.Fields{
+				"panic": crash.Panic,
+				"stack": crash.Stack,
+			}).Errorf("Recovered from a panic serving %s", info.FullMethod)
+			if err := r.Report(ctx, crash); err != nil {
+				log.WithFields(logger.Fields{"error": err}).
+					Errorf("Failed to report a crash")
+			}
+
+			res, err = nil, status.Error(codes.Internal, "internal server error")
+		}()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"series/adapter/api/middleware"
+	"series/adapter/reporter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockReporter struct {
+	crashes *[]reporter.Crash
+}
+
+func (r mockReporter) Report(_ context.Context, crash reporter.Crash) error {
+	*r.crashes = append(*r.crashes, crash)
+	return nil
+}
+
+func TestRecovery(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	crashes := []reporter.Crash{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/series.SeriesService/FindSeriesByID"}
+	ctx := middleware.NewRequestIDContext(context.Background(), "RequestID")
+
+	res, err := recovery(mockReporter{crashes: &crashes})(
+		ctx, nil, info,
+		func(context.Context, any) (any, error) {
+			panic("Panic")
+		},
+	)
+	assert.Nil(res)
+	assert.Equal(codes.Internal, status.Code(err))
+	assert.Len(crashes, 1)
+	assert.Equal("RequestID", crashes[0].RequestID)
+	assert.Equal(info.FullMethod, crashes[0].Path)
+	assert.Equal("Panic", crashes[0].Panic)
+
+	res, err = recovery(mockReporter{crashes: &crashes})(
+		ctx, "Request", info,
+		func(_ context.Context, req any) (any, error) {
+			return req, nil
+		},
+	)
+	assert.Equal("Request", res)
+	assert.Nil(err)
+	assert.Len(crashes, 1)
+}
@@ -0,0 +1,449 @@
+package grpc
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/adapter/presenter"
+	"series/adapter/reporter"
+	"series/adapter/repository"
+	"series/adapter/validator"
+	"series/domain"
+	"series/framework/handler/grpc/pb"
+	"series/usecase"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+type service struct {
+	pb.UnimplementedSeriesServiceServer
+
+	repo      repository.Repository
+	metrics   usecase.Metrics
+	validator validator.Validator
+	dbTimeout time.Duration
+}
+
+// NewServer serves the use cases behind gorilla.NewHandler over gRPC.
+func NewServer(
+	repo repository.Repository,
+	logger logger.Logger,
+	metrics usecase.Metrics,
+	reporter reporter.ErrorReporter,
+	validator validator.Validator,
+	dbTimeout time.Duration,
+) *grpc.Server {
+	service := &service{
+		repo:      repo,
+		metrics:   metrics,
+		validator: validator,
+		dbTimeout: dbTimeout,
+	}
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		logging(logger),
+		recovery(reporter),
+	))
+	pb.RegisterSeriesServiceServer(server, service)
+	return server
+}
+
+func (s *service) CreateSeries(
+	ctx context.Context,
+	in *pb.CreateSeriesInput,
+) (*pb.CreateSeriesOutput, error) {
+	input := usecase.CreateSeriesInput{
+		Title:       in.GetTitle(),
+		Description: in.GetDescription(),
+		BeginYear:   int(in.GetBeginYear()),
+		EndYear:     int(in.GetEndYear()),
+		CreatorIDs:  in.GetCreatorIds(),
+		NetworkID:   in.GetNetworkId(),
+		Country:     in.GetCountry(),
+		Language:    in.GetLanguage(),
+		Status:      in.GetStatus(),
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewCreateSeriesInteractor(
+		s.repo.NewSeriesRepository(),
+		s.repo.NewNetworkRepository(),
+		s.repo.NewPersonRepository(),
+		s.repo.NewEventRepository(),
+		presenter.NewCreateSeriesPresenter(),
+		s.dbTimeout,
+	)
+	uc = usecase.InstrumentCreateSeries(uc, s.metrics)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &pb.CreateSeriesOutput{
+		Id:          output.ID,
+		Slug:        output.Slug,
+		Title:       output.Title,
+		Description: output.Description,
+		Episodes:    int32(output.Episodes),
+		BeginYear:   int32(output.BeginYear),
+		EndYear:     int32(output.EndYear),
+		Creator:     output.Creator,
+		CreatorIds:  output.CreatorIDs,
+		NetworkId:   output.NetworkID,
+		Network:     output.Network,
+		Country:     output.Country,
+		Language:    output.Language,
+		Status:      output.Status,
+		Version:     int32(output.Version),
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	}, nil
+}
+
+func (s *service) UpdateSeries(
+	ctx context.Context,
+	in *pb.UpdateSeriesInput,
+) (*pb.UpdateSeriesOutput, error) {
+	input := usecase.UpdateSeriesInput{
+		ID:          in.GetId(),
+		Version:     int(in.GetVersion()),
+		Title:       in.GetTitle(),
+		Description: in.GetDescription(),
+		BeginYear:   int(in.GetBeginYear()),
+		EndYear:     int(in.GetEndYear()),
+		CreatorIDs:  in.GetCreatorIds(),
+		NetworkID:   in.GetNetworkId(),
+		Country:     in.GetCountry(),
+		Language:    in.GetLanguage(),
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewUpdateSeriesInteractor(
+		s.repo.NewSeriesRepository(),
+		s.repo.NewNetworkRepository(),
+		s.repo.NewPersonRepository(),
+		presenter.NewUpdateSeriesPresenter(),
+		s.dbTimeout,
+	)
+	uc = usecase.InstrumentUpdateSeries(uc, s.metrics)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &pb.UpdateSeriesOutput{
+		Id:          output.ID,
+		Slug:        output.Slug,
+		Title:       output.Title,
+		Description: output.Description,
+		Episodes:    int32(output.Episodes),
+		BeginYear:   int32(output.BeginYear),
+		EndYear:     int32(output.EndYear),
+		Creator:     output.Creator,
+		CreatorIds:  output.CreatorIDs,
+		NetworkId:   output.NetworkID,
+		Network:     output.Network,
+		Country:     output.Country,
+		Language:    output.Language,
+		Status:      output.Status,
+		Version:     int32(output.Version),
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	}, nil
+}
+
+func (s *service) FindSeriesByID(
+	ctx context.Context,
+	in *pb.FindSeriesByIDInput,
+) (*pb.FindSeriesByIDOutput, error) {
+	input := usecase.FindSeriesByIDInput{
+		ID:      domain.SeriesID(in.GetId()),
+		Fields:  in.GetFields(),
+		Include: in.GetInclude(),
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewFindSeriesByIDInteractor(
+		s.repo.NewSeriesRepository(),
+		s.repo.NewReviewRepository(),
+		presenter.NewFindSeriesByIDPresenter(nil),
+		s.dbTimeout,
+	)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	out := &pb.FindSeriesByIDOutput{
+		Id:          output.ID,
+		Slug:        output.Slug,
+		Title:       output.Title,
+		Description: output.Description,
+		BeginYear:   int32(output.BeginYear),
+		Creator:     output.Creator,
+		NetworkId:   output.NetworkID,
+		Network:     output.Network,
+		Country:     output.Country,
+		Language:    output.Language,
+		Status:      output.Status,
+		Version:     int32(output.Version),
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	}
+	if output.Episodes != nil {
+		episodes := int32(*output.Episodes)
+		out.Episodes = &episodes
+	}
+	if output.EndYear != nil {
+		endYear := int32(*output.EndYear)
+		out.EndYear = &endYear
+	}
+	if output.Rating != nil {
+		out.Rating = newRating(*output.Rating)
+	}
+	if output.EpisodeRating != nil {
+		out.EpisodeRating = newRating(*output.EpisodeRating)
+	}
+	if output.CreatorIDs != nil {
+		out.CreatorIds = *output.CreatorIDs
+	}
+	if output.Genres != nil {
+		out.Genres = *output.Genres
+	}
+	if output.Tags != nil {
+		out.Tags = *output.Tags
+	}
+	if output.Aliases != nil {
+		out.Aliases = *output.Aliases
+	}
+	if output.Reviews != nil {
+		for _, review := range *output.Reviews {
+			outReview := &pb.FindSeriesByIDReview{
+				Id:        review.ID,
+				AuthorId:  review.AuthorID,
+				Text:      review.Text,
+				Version:   int32(review.Version),
+				CreatedAt: review.CreatedAt,
+				UpdatedAt: review.UpdatedAt,
+			}
+			if review.Rating != nil {
+				rating := int32(*review.Rating)
+				outReview.Rating = &rating
+			}
+			if review.Author != nil {
+				outReview.Author = &pb.FindSeriesByIDAuthor{
+					Id:      review.Author.ID,
+					Reviews: int32(review.Author.Reviews),
+				}
+			}
+			out.Reviews = append(out.Reviews, outReview)
+		}
+	}
+	return out, nil
+}
+
+func (s *service) FindSeriesByTitle(
+	ctx context.Context,
+	in *pb.FindSeriesByTitleInput,
+) (*pb.FindSeriesByTitleOutput, error) {
+	input := usecase.FindSeriesByTitleInput{
+		Query:    in.GetQuery(),
+		Genre:    in.GetGenre(),
+		Tag:      in.GetTag(),
+		Network:  in.GetNetwork(),
+		Country:  in.GetCountry(),
+		Language: in.GetLanguage(),
+		Status:   in.GetStatus(),
+		PageInput: usecase.PageInput{
+			Limit:  int(in.GetLimit()),
+			Offset: int(in.GetOffset()),
+		},
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewFindSeriesByTitleInteractor(
+		s.repo.NewSeriesRepository(),
+		presenter.NewFindSeriesByTitlePresenter(nil),
+		s.dbTimeout,
+	)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	out := &pb.FindSeriesByTitleOutput{
+		Series:  make([]*pb.FindSeriesByTitleSeries, len(output.Series)),
+		HasNext: output.HasNext,
+	}
+	for i, series := range output.Series {
+		out.Series[i] = &pb.FindSeriesByTitleSeries{
+			Id:           series.ID,
+			Slug:         series.Slug,
+			Title:        series.Title,
+			BeginYear:    int32(series.BeginYear),
+			EndYear:      int32(series.EndYear),
+			Creator:      series.Creator,
+			Genres:       series.Genres,
+			Tags:         series.Tags,
+			NetworkId:    series.NetworkID,
+			Network:      series.Network,
+			Country:      series.Country,
+			Language:     series.Language,
+			Status:       series.Status,
+			MatchedAlias: series.MatchedAlias,
+			CreatedAt:    series.CreatedAt,
+			UpdatedAt:    series.UpdatedAt,
+		}
+	}
+	return out, nil
+}
+
+func (s *service) FindReviewsBySeries(
+	ctx context.Context,
+	in *pb.FindReviewsBySeriesInput,
+) (*pb.FindReviewsBySeriesOutput, error) {
+	if !domain.IsValidUUID(in.GetSeriesId()) {
+		return nil, invalidArgument("invalid or missing series id")
+	}
+	input := usecase.FindReviewsBySeriesInput{
+		SeriesID: domain.SeriesID(in.GetSeriesId()),
+		PageInput: usecase.PageInput{
+			Limit:  int(in.GetLimit()),
+			Offset: int(in.GetOffset()),
+		},
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewFindReviewsBySeriesInteractor(
+		s.repo.NewSeriesRepository(),
+		s.repo.NewReviewRepository(),
+		presenter.NewFindReviewsBySeriesPresenter(),
+		s.dbTimeout,
+	)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	out := &pb.FindReviewsBySeriesOutput{
+		Reviews: make([]*pb.FindReviewsBySeriesReview, len(output.Reviews)),
+		HasNext: output.HasNext,
+	}
+	for i, review := range output.Reviews {
+		out.Reviews[i] = &pb.FindReviewsBySeriesReview{
+			Id:        review.ID,
+			AuthorId:  review.AuthorID,
+			Text:      review.Text,
+			Rating:    int32(review.Rating),
+			Version:   int32(review.Version),
+			CreatedAt: review.CreatedAt,
+			UpdatedAt: review.UpdatedAt,
+		}
+	}
+	return out, nil
+}
+
+func (s *service) CreateReview(
+	ctx context.Context,
+	in *pb.CreateReviewInput,
+) (*pb.CreateReviewOutput, error) {
+	input := usecase.CreateReviewInput{
+		SeriesID:  in.GetSeriesId(),
+		EpisodeID: in.GetEpisodeId(),
+		AuthorID:  in.GetAuthorId(),
+		Text:      in.GetText(),
+		Rating:    int(in.GetRating()),
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewCreateReviewInteractor(
+		s.repo.NewSeriesRepository(),
+		s.repo.NewEpisodeRepository(),
+		s.repo.NewReviewRepository(),
+		s.repo.NewEventRepository(),
+		presenter.NewCreateReviewPresenter(),
+		s.dbTimeout,
+	)
+	uc = usecase.InstrumentCreateReview(uc, s.metrics)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, createReviewStatus(err)
+	}
+	return &pb.CreateReviewOutput{
+		Id:        output.ID,
+		SeriesId:  output.SeriesID,
+		EpisodeId: output.EpisodeID,
+		AuthorId:  output.AuthorID,
+		Text:      output.Text,
+		Rating:    int32(output.Rating),
+		Version:   int32(output.Version),
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	}, nil
+}
+
+func (s *service) UpdateReview(
+	ctx context.Context,
+	in *pb.UpdateReviewInput,
+) (*pb.UpdateReviewOutput, error) {
+	input := usecase.UpdateReviewInput{
+		ID:      in.GetId(),
+		Version: int(in.GetVersion()),
+		Text:    in.GetText(),
+	}
+	if in.Rating != nil {
+		rating := int(in.GetRating())
+		input.Rating = &rating
+	}
+	if err := s.validate(input); err != nil {
+		return nil, err
+	}
+
+	uc := usecase.NewUpdateReviewInteractor(
+		s.repo.NewReviewRepository(),
+		presenter.NewUpdateReviewPresenter(),
+		s.dbTimeout,
+	)
+	uc = usecase.InstrumentUpdateReview(uc, s.metrics)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &pb.UpdateReviewOutput{
+		Id:        output.ID,
+		SeriesId:  output.SeriesID,
+		EpisodeId: output.EpisodeID,
+		AuthorId:  output.AuthorID,
+		Text:      output.Text,
+		Rating:    int32(output.Rating),
+		Version:   int32(output.Version),
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	}, nil
+}
+
+func (s *service) validate(input any) error {
+	if err := s.validator.Validate(input); err != nil {
+		return invalidArgument(s.validator.Messages(err)...)
+	}
+	return nil
+}
+
+func newRating(rating usecase.RatingOutput) *pb.Rating {
+	return &pb.Rating{
+		Average: rating.Average,
+		Count:   int32(rating.Count),
+	}
+}
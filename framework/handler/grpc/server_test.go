@@ -0,0 +1,257 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"series/adapter/logger"
+	"series/adapter/reporter"
+	"series/domain"
+	"series/framework/handler/grpc/pb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mockLogger struct{}
+
+func (mockLogger) Debugf(string, ...any) {}
+func (mockLogger) Errorf(string, ...any) {}
+func (mockLogger) Fatalf(string, ...any) {}
+func (mockLogger) Infof(string, ...any)  {}
+func (mockLogger) Printf(string, ...any) {}
+func (mockLogger) Warnf(string, ...any)  {}
+
+func (l mockLogger) WithFields(logger.Fields) logger.Logger {
+	return l
+}
+
+type mockValidator struct {
+	err error
+}
+
+func (v mockValidator) Validate(any) error {
+	return v.err
+}
+
+func (v mockValidator) Messages(err error) []string {
+	return []string{err.Error()}
+}
+
+type mockSeriesRepo struct {
+	domain.SeriesRepository
+	series domain.Series
+	err    error
+}
+
+func (r mockSeriesRepo) FindByID(context.Context, domain.SeriesID) (domain.Series, error) {
+	return r.series, r.err
+}
+
+type mockReviewRepo struct {
+	domain.ReviewRepository
+}
+
+func (r mockReviewRepo) WithTransaction(
+	ctx context.Context,
+	fn func(context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockReviewRepo) FindBySeries(
+	context.Context,
+	domain.SeriesID,
+	domain.Page,
+) ([]domain.Review, error) {
+	return nil, nil
+}
+
+type mockRepository struct {
+	series domain.SeriesRepository
+}
+
+func (r mockRepository) NewSeriesRepository() domain.SeriesRepository {
+	return r.series
+}
+
+func (r mockRepository) NewGenreRepository() domain.GenreRepository {
+	return nil
+}
+
+func (r mockRepository) NewNetworkRepository() domain.NetworkRepository {
+	return nil
+}
+
+func (r mockRepository) NewPersonRepository() domain.PersonRepository {
+	return nil
+}
+
+func (r mockRepository) NewCreditRepository() domain.CreditRepository {
+	return nil
+}
+
+func (r mockRepository) NewSeasonRepository() domain.SeasonRepository {
+	return nil
+}
+
+func (r mockRepository) NewEpisodeRepository() domain.EpisodeRepository {
+	return nil
+}
+
+func (r mockRepository) NewReviewRepository() domain.ReviewRepository {
+	return mockReviewRepo{}
+}
+
+func (r mockRepository) NewWebhookRepository() domain.WebhookRepository {
+	return nil
+}
+
+func (r mockRepository) NewDeliveryRepository() domain.DeliveryRepository {
+	return nil
+}
+
+func (r mockRepository) NewEventRepository() domain.EventRepository {
+	return nil
+}
+
+func dial(t *testing.T, server *grpc.Server) pb.SeriesServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewSeriesServiceClient(conn)
+}
+
+func TestFindSeriesByID(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		Repo         domain.SeriesRepository
+		Validator    mockValidator
+		Expected     *pb.FindSeriesByIDOutput
+		ExpectedCode codes.Code
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			Repo: mockSeriesRepo{
+				series: domain.NewSeries(
+					"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					"Title",
+					"Description",
+					1980,
+					0,
+				).WithEpisodes(20),
+			},
+			Expected: &pb.FindSeriesByIDOutput{
+				Id:    "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Title: "Title",
+			},
+			ExpectedCode: codes.OK,
+		},
+		{
+			Description:  "Invalid input",
+			Repo:         mockSeriesRepo{},
+			Validator:    mockValidator{err: errors.New("id is invalid")},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Description:  "Searching series that does not exist",
+			Repo:         mockSeriesRepo{err: domain.ErrSeriesNotFound},
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Description:  "Generic error",
+			Repo:         mockSeriesRepo{err: errors.New("error")},
+			ExpectedCode: codes.Internal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			client := dial(t, NewServer(
+				mockRepository{series: test.Repo},
+				mockLogger{},
+				nil,
+				reporter.Discard,
+				test.Validator,
+				time.Second,
+			))
+
+			got, err := client.FindSeriesByID(context.Background(), &pb.FindSeriesByIDInput{
+				Id:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Fields: []string{"id", "title"},
+			})
+			assert.Equal(test.ExpectedCode, status.Code(err))
+			if test.Expected != nil {
+				assert.Equal(test.Expected.GetId(), got.GetId())
+				assert.Equal(test.Expected.GetTitle(), got.GetTitle())
+				assert.Nil(got.EndYear)
+				assert.Empty(got.GetReviews())
+			}
+		})
+	}
+}
+
+func TestStatusFromError(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Err         error
+		Expected    codes.Code
+	}
+	tests := []Test{
+		{"Series not found", domain.ErrSeriesNotFound, codes.NotFound},
+		{"Review not found", domain.ErrReviewNotFound, codes.NotFound},
+		{"Already reviewed", domain.ErrAlreadyReviewed, codes.AlreadyExists},
+		{"Concurrent modification", domain.ErrConcurrentModification, codes.Aborted},
+		{"Generic error", errors.New("error"), codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert.Equal(t, test.Expected, status.Code(statusFromError(test.Err)))
+		})
+	}
+}
+
+func TestCreateReviewStatus(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Err         error
+		Expected    codes.Code
+	}
+	tests := []Test{
+		{"Series not found", domain.ErrSeriesNotFound, codes.InvalidArgument},
+		{"Episode not found", domain.ErrEpisodeNotFound, codes.InvalidArgument},
+		{"Already reviewed", domain.ErrAlreadyReviewed, codes.AlreadyExists},
+		{"Generic error", errors.New("error"), codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert.Equal(t, test.Expected, status.Code(createReviewStatus(test.Err)))
+		})
+	}
+}
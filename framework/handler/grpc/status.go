@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"errors"
+	"series/domain"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func invalidArgument(messages ...string) error {
+	return status.Error(codes.InvalidArgument, strings.Join(messages, "; "))
+}
+
+// statusFromError maps use case errors to gRPC status codes
+// the same way the REST actions map them to HTTP ones.
+func statusFromError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrEpisodeNotFound),
+		errors.Is(err, domain.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNetworkNotFound),
+		errors.Is(err, domain.ErrPersonNotFound),
+		errors.Is(err, domain.ErrStatusEndYear):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrAlreadyReviewed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification),
+		errors.Is(err, domain.ErrSlugTaken):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// createReviewStatus maps the errors of creating a review. The series
+// or episode reviewed is an argument there, a missing one is invalid as
+// REST answers 400 Bad Request rather than 404 Not Found.
+func createReviewStatus(err error) error {
+	if errors.Is(err, domain.ErrSeriesNotFound) ||
+		errors.Is(err, domain.ErrEpisodeNotFound) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return statusFromError(err)
+}
@@ -1,23 +1,47 @@
 package logrus
 
 import (
+	"fmt"
 	"io"
 	"series/adapter/logger"
 
 	"github.com/sirupsen/logrus"
 )
 
-func New(level string, out io.Writer) (logger.Logger, error) {
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+type entry struct {
+	*logrus.Entry
+}
+
+// New returns a logger writing entries at or above level to out,
+// as text or as one JSON object per line.
+func New(level, format string, out io.Writer) (logger.Logger, error) {
 	lvl, err := logrus.ParseLevel(level)
 	if err != nil {
 		return nil, err
 	}
 
+	var formatter logrus.Formatter
+	switch format {
+	case FormatText:
+		formatter = &logrus.TextFormatter{FullTimestamp: true}
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{}
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+
 	logger := logrus.New()
 	logger.SetLevel(lvl)
 	logger.SetOutput(out)
-	logger.SetFormatter(&logrus.TextFormatter{
-		DisableTimestamp: true,
-	})
-	return logger, nil
+	logger.SetFormatter(formatter)
+	return entry{logrus.NewEntry(logger)}, nil
+}
+
+func (e entry) WithFields(fields logger.Fields) logger.Logger {
+	return entry{e.Entry.WithFields(logrus.Fields(fields))}
 }
@@ -0,0 +1,79 @@
+package logrus
+
+import (
+	"bytes"
+	"encoding/json"
+	"series/adapter/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Level       string
+		Format      string
+		ExpectedErr string
+	}
+	tests := []Test{
+		{
+			Description: "Text logger",
+			Level:       "info",
+			Format:      FormatText,
+		},
+		{
+			Description: "JSON logger",
+			Level:       "debug",
+			Format:      FormatJSON,
+		},
+		{
+			Description: "Unknown level",
+			Level:       "verbose",
+			Format:      FormatText,
+			ExpectedErr: `not a valid logrus Level: "verbose"`,
+		},
+		{
+			Description: "Unknown format",
+			Level:       "info",
+			Format:      "xml",
+			ExpectedErr: `unknown log format "xml"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			_, err := New(test.Level, test.Format, &bytes.Buffer{})
+			if test.ExpectedErr != "" {
+				assert.EqualError(err, test.ExpectedErr)
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	out := &bytes.Buffer{}
+	log, err := New("info", FormatJSON, out)
+	assert.Nil(err)
+
+	log.WithFields(logger.Fields{"request_id": "RequestID"}).
+		WithFields(logger.Fields{"status": 200}).
+		Infof("Request %s", "served")
+	log.Debugf("Below the level")
+
+	var entry map[string]any
+	assert.Nil(json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal("Request served", entry["msg"])
+	assert.Equal("info", entry["level"])
+	assert.Equal("RequestID", entry["request_id"])
+	assert.Equal(float64(200), entry["status"])
+	assert.Contains(entry, "time")
+}
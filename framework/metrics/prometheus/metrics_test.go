@@ -0,0 +1,41 @@
+package prometheus
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	m := New()
+	m.ObserveRequest(http.MethodGet, "/v1/series/{id}", http.StatusOK, 20*time.Millisecond)
+	m.ObserveRequest(http.MethodGet, "/v1/series/{id}", http.StatusOK, 2*time.Second)
+	m.ObserveRequest(http.MethodPost, "/v1/reviews", http.StatusConflict, time.Millisecond)
+	m.IncUseCase("create_review", "already_reviewed")
+	m.IncPanic("/v1/series/{id}")
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(http.StatusOK, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	assert.Nil(err)
+	for _, line := range []string{
+		`series_http_requests_total{method="GET",route="/v1/series/{id}",status="200"} 2`,
+		`series_http_requests_total{method="POST",route="/v1/reviews",status="409"} 1`,
+		`series_http_request_duration_seconds_bucket{method="GET",route="/v1/series/{id}",status="200",le="0.025"} 1`,
+		`series_http_request_duration_seconds_count{method="GET",route="/v1/series/{id}",status="200"} 2`,
+		`series_usecase_executions_total{outcome="already_reviewed",usecase="create_review"} 1`,
+		`series_http_panics_total{route="/v1/series/{id}"} 1`,
+		`go_goroutines`,
+	} {
+		assert.Contains(string(body), line)
+	}
+}
@@ -0,0 +1,87 @@
+package prometheus
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector reads the statistics of a connection pool on every scrape.
+type poolCollector struct {
+	stat func() *pgxpool.Stat
+
+	acquired        *prometheus.Desc
+	idle            *prometheus.Desc
+	total           *prometheus.Desc
+	max             *prometheus.Desc
+	acquires        *prometheus.Desc
+	emptyAcquires   *prometheus.Desc
+	acquireDuration *prometheus.Desc
+}
+
+// NewPoolCollector exposes the statistics returned by stat, like the ones
+// of postgres.DB.Stat.
+func NewPoolCollector(stat func() *pgxpool.Stat) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil,
+		)
+	}
+	return &poolCollector{
+		stat: stat,
+		acquired: desc(
+			"acquired_connections",
+			"Connections currently acquired from the pool.",
+		),
+		idle: desc(
+			"idle_connections",
+			"Idle connections in the pool.",
+		),
+		total: desc(
+			"total_connections",
+			"Connections in the pool, acquired, idle or being established.",
+		),
+		max: desc(
+			"max_connections",
+			"Maximum size of the pool.",
+		),
+		acquires: desc(
+			"acquires_total",
+			"Connections acquired from the pool.",
+		),
+		emptyAcquires: desc(
+			"empty_acquires_total",
+			"Acquires that had to wait for a connection because none was idle.",
+		),
+		acquireDuration: desc(
+			"acquire_wait_seconds_total",
+			"Time spent waiting for connections to be acquired.",
+		),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquired
+	ch <- c.idle
+	ch <- c.total
+	ch <- c.max
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.acquireDuration
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.stat()
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+	gauge(c.acquired, float64(stat.AcquiredConns()))
+	gauge(c.idle, float64(stat.IdleConns()))
+	gauge(c.total, float64(stat.TotalConns()))
+	gauge(c.max, float64(stat.MaxConns()))
+	counter(c.acquires, float64(stat.AcquireCount()))
+	counter(c.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+}
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"series/domain"
+	"sync"
+)
+
+// subscriberBuffer is how many reviews a subscriber may lag behind
+// before it is dropped. Dropped subscribers see their channel closed
+// and are expected to resubscribe from the last review they got.
+const subscriberBuffer = 16
+
+type subscriber struct {
+	reviews chan domain.Review
+}
+
+// ReviewBroker is an in-process domain.ReviewBroker. It remembers the
+// last historySize reviews of every series to replay them on resubscription.
+type ReviewBroker struct {
+	mu          sync.Mutex
+	historySize int
+	history     map[domain.SeriesID][]domain.Review
+	subscribers map[domain.SeriesID]map[*subscriber]struct{}
+	closed      bool
+}
+
+func NewReviewBroker(historySize int) *ReviewBroker {
+	return &ReviewBroker{
+		historySize: historySize,
+		history:     map[domain.SeriesID][]domain.Review{},
+		subscribers: map[domain.SeriesID]map[*subscriber]struct{}{},
+	}
+}
+
+func (b *ReviewBroker) Publish(_ context.Context, review domain.Review) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seriesID := review.SeriesID()
+	history := append(b.history[seriesID], review)
+	if len(history) > b.historySize {
+		history = history[len(history)-b.historySize:]
+	}
+	b.history[seriesID] = history
+
+	for sub := range b.subscribers[seriesID] {
+		select {
+		case sub.reviews <- review:
+		default:
+			b.unsubscribe(seriesID, sub)
+		}
+	}
+	return nil
+}
+
+func (b *ReviewBroker) Subscribe(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+	lastID domain.ReviewID,
+) (<-chan domain.Review, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []domain.Review
+	if lastID != "" {
+		history := b.history[seriesID]
+		for i, review := range history {
+			if review.ID() == lastID {
+				replay = history[i+1:]
+				break
+			}
+		}
+	}
+
+	sub := &subscriber{
+		reviews: make(chan domain.Review, len(replay)+subscriberBuffer),
+	}
+	if b.closed {
+		close(sub.reviews)
+		return sub.reviews, nil
+	}
+	for _, review := range replay {
+		sub.reviews <- review
+	}
+	if b.subscribers[seriesID] == nil {
+		b.subscribers[seriesID] = map[*subscriber]struct{}{}
+	}
+	b.subscribers[seriesID][sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(seriesID, sub)
+	}()
+	return sub.reviews, nil
+}
+
+// Close ends every subscription and those made afterwards, so that
+// streams don't keep the server from shutting down.
+func (b *ReviewBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for seriesID, subs := range b.subscribers {
+		for sub := range subs {
+			b.unsubscribe(seriesID, sub)
+		}
+	}
+}
+
+// unsubscribe must be called with b.mu held.
+func (b *ReviewBroker) unsubscribe(seriesID domain.SeriesID, sub *subscriber) {
+	if _, ok := b.subscribers[seriesID][sub]; !ok {
+		return
+	}
+	delete(b.subscribers[seriesID], sub)
+	if len(b.subscribers[seriesID]) == 0 {
+		delete(b.subscribers, seriesID)
+	}
+	close(sub.reviews)
+}
@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func review(id, seriesID string) domain.Review {
+	return domain.NewReview(
+		domain.ReviewID(id),
+		domain.SeriesID(seriesID),
+		domain.AuthorID("AuthorID"),
+		"Text",
+	)
+}
+
+func ids(reviews <-chan domain.Review, n int) []string {
+	got := []string{}
+	for i := 0; i < n; i++ {
+		review, ok := <-reviews
+		if !ok {
+			break
+		}
+		got = append(got, review.ID().String())
+	}
+	return got
+}
+
+func TestReviewBroker(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Before      []domain.Review
+		LastID      domain.ReviewID
+		After       []domain.Review
+		Expected    []string
+	}
+	tests := []Test{
+		{
+			Description: "Only reviews of the series are delivered",
+			After: []domain.Review{
+				review("R1", "S1"),
+				review("R2", "S2"),
+				review("R3", "S1"),
+			},
+			Expected: []string{"R1", "R3"},
+		},
+		{
+			Description: "Reviews published before subscribing are not replayed",
+			Before:      []domain.Review{review("R1", "S1")},
+			After:       []domain.Review{review("R2", "S1")},
+			Expected:    []string{"R2"},
+		},
+		{
+			Description: "Resuming replays what was published after the last id",
+			Before: []domain.Review{
+				review("R1", "S1"),
+				review("R2", "S1"),
+				review("R3", "S1"),
+			},
+			LastID:   "R1",
+			After:    []domain.Review{review("R4", "S1")},
+			Expected: []string{"R2", "R3", "R4"},
+		},
+		{
+			Description: "Unknown last id replays nothing",
+			Before:      []domain.Review{review("R1", "S1")},
+			LastID:      "R0",
+			After:       []domain.Review{review("R2", "S1")},
+			Expected:    []string{"R2"},
+		},
+		{
+			Description: "History is bounded",
+			Before: []domain.Review{
+				review("R1", "S1"),
+				review("R2", "S1"),
+				review("R3", "S1"),
+				review("R4", "S1"),
+			},
+			LastID:   "R1",
+			After:    []domain.Review{review("R5", "S1")},
+			Expected: []string{"R5"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			broker := NewReviewBroker(3)
+			for _, review := range test.Before {
+				assert.Nil(broker.Publish(ctx, review))
+			}
+			reviews, err := broker.Subscribe(ctx, "S1", test.LastID)
+			assert.Nil(err)
+			for _, review := range test.After {
+				assert.Nil(broker.Publish(ctx, review))
+			}
+
+			assert.Equal(test.Expected, ids(reviews, len(test.Expected)))
+		})
+	}
+}
+
+func TestReviewBrokerClosesSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("When the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		reviews, err := NewReviewBroker(3).Subscribe(ctx, "S1", "")
+		assert.Nil(t, err)
+
+		cancel()
+		_, ok := <-reviews
+		assert.False(t, ok)
+	})
+
+	t.Run("When the subscriber lags behind", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		broker := NewReviewBroker(3)
+		reviews, err := broker.Subscribe(ctx, "S1", "")
+		assert.Nil(t, err)
+
+		for i := 0; i <= subscriberBuffer; i++ {
+			assert.Nil(t, broker.Publish(ctx, review("R", "S1")))
+		}
+		assert.Len(t, ids(reviews, subscriberBuffer+1), subscriberBuffer)
+	})
+
+	t.Run("When the broker is closed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		broker := NewReviewBroker(3)
+		before, err := broker.Subscribe(ctx, "S1", "")
+		assert.Nil(t, err)
+
+		broker.Close()
+		_, ok := <-before
+		assert.False(t, ok)
+
+		after, err := broker.Subscribe(ctx, "S1", "")
+		assert.Nil(t, err)
+		_, ok = <-after
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"series/adapter/reporter"
+)
+
+type fileReporter struct {
+	dir string
+}
+
+// NewReporter returns a reporter writing every crash to its own JSON file
+// in dir, named after the time and the request id. dir is created if needed.
+func NewReporter(dir string) (reporter.ErrorReporter, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating crash directory: %w", err)
+	}
+	return &fileReporter{dir: dir}, nil
+}
+
+func (f *fileReporter) Report(_ context.Context, crash reporter.Crash) error {
+	body, err := json.MarshalIndent(crash, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding crash: %w", err)
+	}
+
+	name := fmt.Sprintf(
+		"crash-%s-%s.json",
+		crash.Time.UTC().Format("20060102T150405.000000000Z"),
+		filepath.Base(crash.RequestID),
+	)
+	// O_EXCL: a report never replaces another one.
+	file, err := os.OpenFile(
+		filepath.Join(f.dir, name),
+		os.O_CREATE|os.O_EXCL|os.O_WRONLY,
+		0o600,
+	)
+	if err != nil {
+		return fmt.Errorf("creating crash file: %w", err)
+	}
+	if _, err := file.Write(body); err != nil {
+		file.Close()
+		return fmt.Errorf("writing crash file: %w", err)
+	}
+	return file.Close()
+}
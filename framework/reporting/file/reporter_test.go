@@ -0,0 +1,48 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"series/adapter/reporter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	dir := filepath.Join(t.TempDir(), "crashes")
+	r, err := NewReporter(dir)
+	assert.Nil(err)
+
+	crash := reporter.Crash{
+		Time:      time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC),
+		RequestID: "RequestID",
+		Method:    "GET",
+		Path:      "/v1/series/1",
+		Panic:     "runtime error: invalid memory address or nil pointer dereference",
+		Stack:     "goroutine 1 [running]:",
+	}
+	assert.Nil(r.Report(context.TODO(), crash))
+	// The same crash again is reported in another file.
+	crash.Time = crash.Time.Add(time.Nanosecond)
+	assert.Nil(r.Report(context.TODO(), crash))
+
+	entries, err := os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Len(entries, 2)
+	assert.Equal("crash-20221201T100000.000000000Z-RequestID.json", entries[0].Name())
+
+	body, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	assert.Nil(err)
+	var got reporter.Crash
+	assert.Nil(json.Unmarshal(body, &got))
+	assert.Equal(crash.Time.Add(-time.Nanosecond), got.Time)
+	assert.Equal(crash.Panic, got.Panic)
+	assert.Equal(crash.RequestID, got.RequestID)
+}
@@ -0,0 +1,32 @@
+package opentelemetry
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := New(context.Background(), "zipkin", "series", nil)
+	assert.EqualError(err, `unknown trace exporter "zipkin"`)
+
+	out := &bytes.Buffer{}
+	shutdown, err := New(context.Background(), ExporterStdout, "series", out)
+	assert.Nil(err)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	_, child := otel.Tracer("test").Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	assert.Nil(shutdown(context.Background()))
+	assert.Contains(out.String(), `"Name": "parent"`)
+	assert.Contains(out.String(), `"Name": "child"`)
+	assert.Contains(out.String(), parent.SpanContext().TraceID().String())
+	assert.Contains(out.String(), `"Value": "series"`)
+}
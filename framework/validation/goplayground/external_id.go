@@ -0,0 +1,14 @@
+package goplayground
+
+import (
+	"series/domain"
+
+	playground "github.com/go-playground/validator/v10"
+)
+
+// isExternalID validates the "external_id" tag, whose parameter is
+// the provider the ID is in the format of, like "external_id=imdb".
+func isExternalID(fl playground.FieldLevel) bool {
+	provider := domain.ExternalIDProvider(fl.Param())
+	return provider.IsValidID(fl.Field().String())
+}
@@ -0,0 +1,17 @@
+package goplayground
+
+import (
+	"net/url"
+
+	playground "github.com/go-playground/validator/v10"
+)
+
+// isHTTPURL validates the "http_url" tag, for absolute http and https
+// URLs with a host, that the server can make requests to.
+func isHTTPURL(fl playground.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -6,9 +6,19 @@ type validator struct {
 	validator *playground.Validate
 }
 
+// NewValidator validates the usual go-playground tags, plus "country"
+// for ISO 3166-1 alpha-2 codes, "language" for ISO 639-1 ones and
+// "external_id" for IDs in the catalog of a provider and "http_url" for
+// http and https URLs.
 func NewValidator() *validator {
+	v := playground.New()
+	// They only fail on invalid tag names or functions, that are fixed here.
+	_ = v.RegisterValidation("country", isCountry)
+	_ = v.RegisterValidation("language", isLanguage)
+	_ = v.RegisterValidation("external_id", isExternalID)
+	_ = v.RegisterValidation("http_url", isHTTPURL)
 	return &validator{
-		validator: playground.New(),
+		validator: v,
 	}
 }
 
@@ -0,0 +1,192 @@
+package goplayground
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorOrigin(t *testing.T) {
+	t.Parallel()
+
+	type origin struct {
+		Country  string `validate:"omitempty,country"`
+		Language string `validate:"omitempty,language"`
+	}
+
+	type Test struct {
+		Description string
+		Input       origin
+		Valid       bool
+	}
+	tests := []Test{
+		{
+			Description: "Valid codes",
+			Input:       origin{Country: "US", Language: "en"},
+			Valid:       true,
+		},
+		{
+			Description: "Empty codes",
+			Input:       origin{},
+			Valid:       true,
+		},
+		{
+			Description: "Unassigned country",
+			Input:       origin{Country: "XX", Language: "en"},
+			Valid:       false,
+		},
+		{
+			Description: "Lowercase country",
+			Input:       origin{Country: "us", Language: "en"},
+			Valid:       false,
+		},
+		{
+			Description: "Three letter language",
+			Input:       origin{Country: "US", Language: "eng"},
+			Valid:       false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			v := NewValidator()
+			err := v.Validate(test.Input)
+			assert.Equal(test.Valid, err == nil)
+			if !test.Valid {
+				assert.Len(v.Messages(err), 1)
+			}
+		})
+	}
+}
+
+func TestValidatorExternalIDs(t *testing.T) {
+	t.Parallel()
+
+	// externalIDs mirrors the lookup of a series by exactly one external ID.
+	type externalIDs struct {
+		IMDb string `validate:"required_without_all=TVDB TMDB,excluded_with=TVDB TMDB,omitempty,external_id=imdb"`
+		TVDB string `validate:"excluded_with=IMDb TMDB,omitempty,external_id=tvdb"`
+		TMDB string `validate:"excluded_with=IMDb TVDB,omitempty,external_id=tmdb"`
+	}
+
+	type Test struct {
+		Description string
+		Input       externalIDs
+		Valid       bool
+	}
+	tests := []Test{
+		{
+			Description: "Valid IMDb ID",
+			Input:       externalIDs{IMDb: "tt0903747"},
+			Valid:       true,
+		},
+		{
+			Description: "Valid eight digit IMDb ID",
+			Input:       externalIDs{IMDb: "tt10048342"},
+			Valid:       true,
+		},
+		{
+			Description: "Valid TVDB ID",
+			Input:       externalIDs{TVDB: "81189"},
+			Valid:       true,
+		},
+		{
+			Description: "Valid TMDB ID",
+			Input:       externalIDs{TMDB: "1396"},
+			Valid:       true,
+		},
+		{
+			Description: "No ID",
+			Input:       externalIDs{},
+			Valid:       false,
+		},
+		{
+			Description: "IMDb ID without prefix",
+			Input:       externalIDs{IMDb: "0903747"},
+			Valid:       false,
+		},
+		{
+			Description: "IMDb ID of a person",
+			Input:       externalIDs{IMDb: "nm0186505"},
+			Valid:       false,
+		},
+		{
+			Description: "TVDB ID that is not a number",
+			Input:       externalIDs{TVDB: "breaking-bad"},
+			Valid:       false,
+		},
+		{
+			Description: "Two IDs",
+			Input:       externalIDs{TVDB: "81189", TMDB: "1396"},
+			Valid:       false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			v := NewValidator()
+			err := v.Validate(test.Input)
+			assert.Equal(test.Valid, err == nil)
+		})
+	}
+}
+
+func TestValidatorHTTPURL(t *testing.T) {
+	t.Parallel()
+
+	type webhook struct {
+		URL string `validate:"required,http_url"`
+	}
+
+	type Test struct {
+		Description string
+		Input       webhook
+		Valid       bool
+	}
+	tests := []Test{
+		{
+			Description: "HTTPS URL",
+			Input:       webhook{URL: "https://partner.example.com/hooks"},
+			Valid:       true,
+		},
+		{
+			Description: "HTTP URL with a port",
+			Input:       webhook{URL: "http://localhost:8080/hooks"},
+			Valid:       true,
+		},
+		{
+			Description: "File URL",
+			Input:       webhook{URL: "file:///etc/passwd"},
+			Valid:       false,
+		},
+		{
+			Description: "FTP URL",
+			Input:       webhook{URL: "ftp://partner.example.com/hooks"},
+			Valid:       false,
+		},
+		{
+			Description: "Javascript URL",
+			Input:       webhook{URL: "javascript:alert(1)"},
+			Valid:       false,
+		},
+		{
+			Description: "URL without a host",
+			Input:       webhook{URL: "https:///hooks"},
+			Valid:       false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			v := NewValidator()
+			err := v.Validate(test.Input)
+			assert.Equal(test.Valid, err == nil)
+			if !test.Valid {
+				assert.Len(v.Messages(err), 1)
+			}
+		})
+	}
+}
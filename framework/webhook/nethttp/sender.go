@@ -0,0 +1,100 @@
+package nethttp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"series/domain"
+	"strconv"
+	"time"
+)
+
+// Headers of the requests sent to webhooks.
+const (
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// envelope is the body of the requests, data is the payload of the
+// event. The id is the event's, receivers can use it to drop duplicates.
+type envelope struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type sender struct {
+	client *http.Client
+}
+
+// NewSender returns a sender posting deliveries with the given client,
+// which is expected to have a timeout.
+func NewSender(client *http.Client) domain.WebhookSender {
+	return &sender{
+		client: client,
+	}
+}
+
+// Sign computes the signature of a request body sent at the given unix
+// time: the hex encoded HMAC-SHA256 of "timestamp.body" keyed with the
+// secret of the webhook. Receivers compute it the same way and compare
+// it to the X-Webhook-Signature header, minus its "sha256=" prefix.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the delivery, any response but a 2xx is an error.
+func (s *sender) Send(
+	ctx context.Context,
+	webhook domain.Webhook,
+	delivery domain.Delivery,
+) (int, error) {
+	body, err := json.Marshal(envelope{
+		ID:        delivery.EventID().String(),
+		Event:     delivery.Event().String(),
+		CreatedAt: delivery.CreatedAt().UTC().Format(time.RFC3339),
+		Data:      delivery.Payload(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, webhook.URL(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "series-webhooks")
+	req.Header.Set(HeaderDelivery, delivery.ID().String())
+	req.Header.Set(HeaderEvent, delivery.Event().String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(webhook.Secret(), timestamp, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// Drain a bit of the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,105 @@
+package nethttp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"series/domain"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description  string
+		Status       int
+		ExpectedCode int
+		ExpectedErr  bool
+	}
+	tests := []Test{
+		{
+			Description:  "Successful delivery",
+			Status:       http.StatusNoContent,
+			ExpectedCode: http.StatusNoContent,
+			ExpectedErr:  false,
+		},
+		{
+			Description:  "Non 2xx response is an error",
+			Status:       http.StatusInternalServerError,
+			ExpectedCode: http.StatusInternalServerError,
+			ExpectedErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var (
+				header http.Header
+				body   []byte
+			)
+			receiver := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					header = r.Header
+					body, _ = io.ReadAll(r.Body)
+					w.WriteHeader(test.Status)
+				},
+			))
+			defer receiver.Close()
+
+			webhook := domain.NewWebhook("WebhookID", receiver.URL, "secret", nil)
+			delivery := domain.NewDelivery(
+				"DeliveryID",
+				"WebhookID",
+				domain.NewEvent(
+					"EventID",
+					domain.EventSeriesCreated,
+					"SeriesID",
+					[]byte(`{"id":"SeriesID"}`),
+				),
+			).WithTimestamps(
+				time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC),
+			)
+
+			code, err := NewSender(receiver.Client()).Send(context.TODO(), webhook, delivery)
+			assert.Equal(test.ExpectedCode, code)
+			assert.Equal(test.ExpectedErr, err != nil)
+
+			assert.Equal("application/json", header.Get("Content-Type"))
+			assert.Equal("DeliveryID", header.Get(HeaderDelivery))
+			assert.Equal("series.created", header.Get(HeaderEvent))
+			timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+			assert.Nil(err)
+			assert.Equal("sha256="+Sign("secret", timestamp, body), header.Get(HeaderSignature))
+
+			var got map[string]any
+			assert.Nil(json.Unmarshal(body, &got))
+			assert.Equal(map[string]any{
+				"id":         "EventID",
+				"event":      "series.created",
+				"created_at": "2022-10-01T12:00:00Z",
+				"data":       map[string]any{"id": "SeriesID"},
+			}, got)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	// echo -n '1664625600.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(
+		"32197eef12d8dab5ed4dd596d117f97e8dd825017a91b513a42d6d5e5b916236",
+		Sign("secret", 1664625600, []byte(`{}`)),
+	)
+}
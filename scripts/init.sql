@@ -1,29 +1,423 @@
+CREATE TABLE IF NOT EXISTS networks (
+  id UUID PRIMARY KEY NOT NULL,
+  name TEXT NOT NULL,
+  country VARCHAR(2) DEFAULT '' NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_networks_name ON networks (lower(name));
+
 CREATE TABLE IF NOT EXISTS series (
   id UUID PRIMARY KEY NOT NULL,
+  slug TEXT NOT NULL,
   title TEXT NOT NULL,
   description TEXT NOT NULL,
-  episodes INTEGER NOT NULL,
   begin_year SMALLINT NOT NULL,
   end_year SMALLINT DEFAULT 0 NOT NULL,
-  creator VARCHAR(150)
+  status VARCHAR(20) DEFAULT 'airing' NOT NULL CHECK (
+    status IN ('announced', 'airing', 'on_hiatus', 'ended', 'cancelled', 'miniseries')
+  ),
+  network_id UUID REFERENCES networks(id) ON DELETE SET NULL,
+  country VARCHAR(2) DEFAULT '' NOT NULL,
+  language VARCHAR(2) DEFAULT '' NOT NULL,
+  version INTEGER DEFAULT 1 NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+-- Series stored before they had versions and timestamps start at
+-- version 1, created and updated when the columns are added.
+ALTER TABLE series
+  ADD COLUMN IF NOT EXISTS version INTEGER DEFAULT 1 NOT NULL,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL;
+
+-- Series stored before networks, countries and languages are left unknown.
+ALTER TABLE series
+  ADD COLUMN IF NOT EXISTS network_id UUID REFERENCES networks(id) ON DELETE SET NULL,
+  ADD COLUMN IF NOT EXISTS country VARCHAR(2) DEFAULT '' NOT NULL,
+  ADD COLUMN IF NOT EXISTS language VARCHAR(2) DEFAULT '' NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_series_network ON series (network_id);
+
+-- Series stored before they had a status are airing until they got an
+-- end year, and ended after, as domain.StatusForEndYear has it. The
+-- backfill only runs along with adding the column, so that running the
+-- script again leaves the statuses set since alone.
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1
+    FROM information_schema.columns
+    WHERE
+      table_schema = current_schema() AND
+      table_name = 'series' AND
+      column_name = 'status'
+  ) THEN
+    ALTER TABLE series
+      ADD COLUMN status VARCHAR(20) DEFAULT 'airing' NOT NULL CHECK (
+        status IN ('announced', 'airing', 'on_hiatus', 'ended', 'cancelled', 'miniseries')
+      );
+
+    UPDATE series SET status = 'ended' WHERE end_year <> 0;
+  END IF;
+END
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_series_status ON series (status);
+
+-- Episode counts are derived from the episodes table.
+ALTER TABLE series DROP COLUMN IF EXISTS episodes;
+
+-- make_slug is the slug of a series like domain.NewSlug makes it:
+-- "Breaking Bad" from 2008 is breaking-bad-2008.
+CREATE OR REPLACE FUNCTION make_slug(title TEXT, begin_year INTEGER)
+  RETURNS TEXT AS $$
+BEGIN
+  RETURN concat_ws('-', NULLIF(trim(BOTH '-' FROM regexp_replace(
+    translate(lower(title), 'àáâãäåçèéêëìíîïñòóôõöøùúûüýÿ', 'aaaaaaceeeeiiiinoooooouuuuyy'),
+    '[^a-z0-9]+', '-', 'g'
+  )), ''), begin_year);
+END
+$$ LANGUAGE 'plpgsql' IMMUTABLE;
+
+-- Series stored before they had a slug get one, numbered in the order
+-- they were created when several have the same title and begin year.
+ALTER TABLE series ADD COLUMN IF NOT EXISTS slug TEXT;
+
+WITH slugs AS (
+  SELECT
+    id, make_slug(title, begin_year) AS slug,
+    ROW_NUMBER() OVER (
+      PARTITION BY make_slug(title, begin_year) ORDER BY created_at, id
+    ) AS number
+  FROM series
+  WHERE slug IS NULL
+)
+UPDATE series
+SET
+  slug = CASE WHEN slugs.number = 1 THEN slugs.slug
+    ELSE slugs.slug || '-' || slugs.number END
+FROM slugs
+WHERE series.id = slugs.id;
+
+ALTER TABLE series ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_series_slug ON series (slug);
+
+-- series_slugs has every slug a series had, the old ones redirect to
+-- the current one and are never given to another series.
+CREATE TABLE IF NOT EXISTS series_slugs (
+  slug TEXT PRIMARY KEY NOT NULL,
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE
 );
 
+INSERT INTO series_slugs(slug, series_id)
+SELECT slug, id FROM series
+ON CONFLICT (slug) DO NOTHING;
+
+-- text_search_config is the text search configuration of an ISO 639-1
+-- language, the simple one for the languages Postgres has none for.
+CREATE OR REPLACE FUNCTION text_search_config(language TEXT)
+  RETURNS regconfig AS $$
+BEGIN
+  RETURN CASE language
+    WHEN 'ar' THEN 'arabic'
+    WHEN 'ca' THEN 'catalan'
+    WHEN 'da' THEN 'danish'
+    WHEN 'de' THEN 'german'
+    WHEN 'el' THEN 'greek'
+    WHEN 'en' THEN 'english'
+    WHEN 'es' THEN 'spanish'
+    WHEN 'eu' THEN 'basque'
+    WHEN 'fi' THEN 'finnish'
+    WHEN 'fr' THEN 'french'
+    WHEN 'ga' THEN 'irish'
+    WHEN 'hi' THEN 'hindi'
+    WHEN 'hu' THEN 'hungarian'
+    WHEN 'hy' THEN 'armenian'
+    WHEN 'id' THEN 'indonesian'
+    WHEN 'it' THEN 'italian'
+    WHEN 'lt' THEN 'lithuanian'
+    WHEN 'nb' THEN 'norwegian'
+    WHEN 'ne' THEN 'nepali'
+    WHEN 'nl' THEN 'dutch'
+    WHEN 'nn' THEN 'norwegian'
+    WHEN 'no' THEN 'norwegian'
+    WHEN 'pt' THEN 'portuguese'
+    WHEN 'ro' THEN 'romanian'
+    WHEN 'ru' THEN 'russian'
+    WHEN 'sr' THEN 'serbian'
+    WHEN 'sv' THEN 'swedish'
+    WHEN 'ta' THEN 'tamil'
+    WHEN 'tr' THEN 'turkish'
+    WHEN 'yi' THEN 'yiddish'
+    ELSE 'simple'
+  END::regconfig;
+END
+$$ LANGUAGE 'plpgsql' IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION make_tsvector(title TEXT, description TEXT, config regconfig)
+  RETURNS tsvector AS $$
+BEGIN
+  RETURN (setweight(to_tsvector(config, title), 'A') ||
+    setweight(to_tsvector(config, description), 'B'));
+END
+$$ LANGUAGE 'plpgsql' IMMUTABLE;
+
+-- The title and description of the series themselves are in English,
+-- the translations are indexed with the configuration of their language.
 CREATE OR REPLACE FUNCTION make_tsvector(title TEXT, description TEXT)
   RETURNS tsvector AS $$
 BEGIN
-  RETURN (setweight(to_tsvector('english', title), 'A') ||
-    setweight(to_tsvector('english', description), 'B'));
+  RETURN make_tsvector(title, description, 'english');
 END
 $$ LANGUAGE 'plpgsql' IMMUTABLE;
 
 CREATE INDEX IF NOT EXISTS idx_fts_series ON series
   USING gin(make_tsvector(title, description));
 
+CREATE TABLE IF NOT EXISTS series_translations (
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  language VARCHAR(2) NOT NULL,
+  title TEXT NOT NULL,
+  description TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  PRIMARY KEY(series_id, language)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fts_series_translations ON series_translations
+  USING gin(make_tsvector(title, description, text_search_config(language)));
+
+-- Aliases are in any language, they are indexed with the simple
+-- configuration so that no words are stemmed or dropped.
+CREATE TABLE IF NOT EXISTS series_aliases (
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  alias TEXT NOT NULL,
+  PRIMARY KEY(series_id, alias)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fts_series_aliases ON series_aliases
+  USING gin(to_tsvector('simple', alias));
+
+-- A series has at most one ID per provider, that no other series has.
+CREATE TABLE IF NOT EXISTS series_external_ids (
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  provider VARCHAR(4) NOT NULL,
+  external_id TEXT NOT NULL,
+  PRIMARY KEY(series_id, provider),
+  UNIQUE(provider, external_id)
+);
+
+CREATE TABLE IF NOT EXISTS genres (
+  id UUID PRIMARY KEY NOT NULL,
+  name TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_genres_name ON genres (lower(name));
+
+CREATE TABLE IF NOT EXISTS series_genres (
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  genre_id UUID NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+  PRIMARY KEY(series_id, genre_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_genres_genre ON series_genres (genre_id);
+
+CREATE TABLE IF NOT EXISTS tags (
+  id UUID PRIMARY KEY NOT NULL,
+  name TEXT NOT NULL UNIQUE,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+-- Tags stored before they had timestamps are created and updated when
+-- the columns are added. Their IDs come from the application now, like
+-- the ones of genres.
+ALTER TABLE tags
+  ALTER COLUMN id DROP DEFAULT,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL;
+
+CREATE TABLE IF NOT EXISTS series_tags (
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+  PRIMARY KEY(series_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_tags_tag ON series_tags (tag_id);
+
+CREATE TABLE IF NOT EXISTS people (
+  id UUID PRIMARY KEY NOT NULL,
+  name VARCHAR(150) NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_people_name ON people (name);
+
+CREATE TABLE IF NOT EXISTS credits (
+  id UUID PRIMARY KEY NOT NULL,
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  person_id UUID NOT NULL REFERENCES people(id) ON DELETE CASCADE,
+  role TEXT NOT NULL CHECK (role IN ('creator', 'actor', 'writer', 'director')),
+  character TEXT DEFAULT '' NOT NULL,
+  episodes SMALLINT DEFAULT 0 NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  UNIQUE(series_id, person_id, role, character)
+);
+
+CREATE INDEX IF NOT EXISTS idx_credits_person ON credits (person_id);
+
+CREATE TABLE IF NOT EXISTS seasons (
+  id UUID PRIMARY KEY NOT NULL,
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  number SMALLINT NOT NULL,
+  title TEXT NOT NULL,
+  air_date DATE,
+  synopsis TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  UNIQUE(series_id, number)
+);
+
+CREATE TABLE IF NOT EXISTS episodes (
+  id UUID PRIMARY KEY NOT NULL,
+  season_id UUID NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+  series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+  number SMALLINT NOT NULL,
+  title TEXT NOT NULL,
+  air_date DATE,
+  runtime SMALLINT,
+  synopsis TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  UNIQUE(season_id, number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_episodes_series ON episodes (series_id);
+
 CREATE TABLE IF NOT EXISTS  reviews (
   id UUID NOT NULL,
   series_id UUID NOT NULL,
+  episode_id UUID REFERENCES episodes(id) ON DELETE CASCADE,
   author_id UUID NOT NULL,
   text TEXT NOT NULL,
-  PRIMARY KEY(id),
-  UNIQUE(author_id, series_id)
+  rating SMALLINT CHECK (rating BETWEEN 1 AND 10),
+  version INTEGER DEFAULT 1 NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  PRIMARY KEY(id)
 );
+
+-- Reviews stored before they had versions and timestamps are migrated
+-- like the series.
+ALTER TABLE reviews
+  ADD COLUMN IF NOT EXISTS version INTEGER DEFAULT 1 NOT NULL,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL;
+
+-- Reviews stored before episodes could be reviewed are about their series
+-- and unrated. An author reviews a series once, and each of its episodes
+-- once, so the former constraint on the author and the series goes.
+ALTER TABLE reviews
+  ADD COLUMN IF NOT EXISTS episode_id UUID REFERENCES episodes(id) ON DELETE CASCADE,
+  ADD COLUMN IF NOT EXISTS rating SMALLINT CHECK (rating BETWEEN 1 AND 10),
+  DROP CONSTRAINT IF EXISTS reviews_author_id_series_id_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reviews_series_author ON reviews
+  (series_id, author_id) WHERE episode_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reviews_episode_author ON reviews
+  (episode_id, author_id) WHERE episode_id IS NOT NULL;
+
+-- Authors are counted from their reviews when embedded in a series.
+CREATE INDEX IF NOT EXISTS idx_reviews_author ON reviews (author_id);
+
+-- Creators used to be a string of the series, like "Vince Gilligan & Peter
+-- Gould", they are credited people now. Every name in it becomes a person
+-- of its own, namesakes aren't assumed to be the same person, and is
+-- credited as a creator of the series before the column goes.
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1
+    FROM information_schema.columns
+    WHERE
+      table_schema = current_schema() AND
+      table_name = 'series' AND
+      column_name = 'creator'
+  ) THEN
+    WITH creators AS (
+      SELECT gen_random_uuid() AS person_id, series.id AS series_id, trim(name) AS name
+      FROM series,
+        regexp_split_to_table(series.creator, '\s*(,|&|\mand\M)\s*', 'i') AS name
+      WHERE
+        trim(name) <> '' AND
+        NOT EXISTS (
+          SELECT 1
+          FROM credits
+          WHERE series_id = series.id AND role = 'creator'
+        )
+    ), created AS (
+      INSERT INTO people (id, name)
+        SELECT person_id, name FROM creators
+    )
+    INSERT INTO credits (id, series_id, person_id, role)
+      SELECT gen_random_uuid(), series_id, person_id, 'creator'
+      FROM creators;
+
+    ALTER TABLE series DROP COLUMN creator;
+  END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TABLE IF NOT EXISTS webhooks (
+  id UUID PRIMARY KEY NOT NULL,
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  events TEXT[] NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_events ON webhooks
+  USING gin(events);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id UUID PRIMARY KEY NOT NULL,
+  webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+  event_id UUID NOT NULL,
+  event TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  status TEXT NOT NULL,
+  attempts INTEGER DEFAULT 0 NOT NULL,
+  next_attempt_at TIMESTAMPTZ,
+  locked_until TIMESTAMPTZ,
+  last_status_code INTEGER,
+  last_error TEXT,
+  created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  UNIQUE(webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries
+  (next_attempt_at) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries
+  (webhook_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS outbox (
+  id UUID PRIMARY KEY NOT NULL,
+  type TEXT NOT NULL,
+  aggregate_id UUID NOT NULL,
+  payload JSONB NOT NULL,
+  occurred_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  locked_until TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_occurred_at ON outbox (occurred_at);
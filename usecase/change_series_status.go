@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	ChangeSeriesStatusUseCase interface {
+		Execute(context.Context, ChangeSeriesStatusInput) (ChangeSeriesStatusOutput, error)
+	}
+
+	// ChangeSeriesStatusInput moves a series to Status. EndYear is set
+	// when the series stops airing, and 0 otherwise.
+	ChangeSeriesStatusInput struct {
+		ID      string `json:"-"        xml:"-"        validate:"required,uuid_rfc4122"`
+		Version int    `json:"version"  xml:"version"  validate:"required,min=1"`
+		Status  string `json:"status"   xml:"status"   validate:"required,oneof=announced airing on_hiatus ended cancelled miniseries"`
+		EndYear int    `json:"end_year" xml:"end_year" validate:"min=0,max=2030"`
+	}
+
+	ChangeSeriesStatusOutput struct {
+		ID        string `json:"id"         xml:"id"`
+		Status    string `json:"status"     xml:"status"`
+		BeginYear int    `json:"begin_year" xml:"begin_year"`
+		EndYear   int    `json:"end_year"   xml:"end_year"`
+		Version   int    `json:"version"    xml:"version"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	ChangeSeriesStatusPresenter interface {
+		Output(domain.Series) ChangeSeriesStatusOutput
+	}
+
+	changeSeriesStatusInteractor struct {
+		repo      domain.SeriesRepository
+		presenter ChangeSeriesStatusPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewChangeSeriesStatusInteractor(
+	repo domain.SeriesRepository,
+	presenter ChangeSeriesStatusPresenter,
+	timeout time.Duration,
+) ChangeSeriesStatusUseCase {
+	return changeSeriesStatusInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i changeSeriesStatusInteractor) Execute(
+	ctx context.Context, input ChangeSeriesStatusInput,
+) (ChangeSeriesStatusOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.ChangeSeriesStatus")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	series, err := i.repo.FindByID(ctx, domain.SeriesID(input.ID))
+	if err != nil {
+		return i.presenter.Output(domain.Series{}), err
+	}
+	from := series.Status()
+
+	series, err = series.ChangeStatus(domain.SeriesStatus(input.Status), input.EndYear)
+	if err != nil {
+		return i.presenter.Output(domain.Series{}), err
+	}
+
+	// The update is rejected if the series changed since the client read
+	// the version, even if it was stored with the current status.
+	series, err = i.repo.Update(ctx, series.WithVersion(input.Version))
+	if err != nil {
+		return i.presenter.Output(domain.Series{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": series.ID().String(),
+			"from":      from.String(),
+			"to":        series.Status().String(),
+		}).
+		Infof("Series status changed")
+
+	return i.presenter.Output(series), nil
+}
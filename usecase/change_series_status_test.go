@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChangeSeriesStatusRepo struct {
+	domain.SeriesRepository
+	current domain.Series
+	findErr error
+	err     error
+	updated *[]domain.Series
+}
+
+func (r mockChangeSeriesStatusRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return r.current, r.findErr
+}
+
+func (r mockChangeSeriesStatusRepo) Update(
+	_ context.Context,
+	series domain.Series,
+) (domain.Series, error) {
+	if r.err != nil {
+		return domain.Series{}, r.err
+	}
+	*r.updated = append(*r.updated, series)
+	return series.WithVersion(series.Version() + 1), nil
+}
+
+type mockChangeSeriesStatusPresenter struct{}
+
+func (mockChangeSeriesStatusPresenter) Output(series domain.Series) ChangeSeriesStatusOutput {
+	return ChangeSeriesStatusOutput{
+		ID:      series.ID().String(),
+		Status:  series.Status().String(),
+		EndYear: series.EndYear(),
+		Version: series.Version(),
+	}
+}
+
+func TestChangeSeriesStatusInteractor(t *testing.T) {
+	t.Parallel()
+
+	airing := domain.NewSeries(
+		"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+		"Title",
+		"Description",
+		1980,
+		0,
+	)
+	announced := airing.WithStatus(domain.SeriesAnnounced)
+	ended := airing.WithStatus(domain.SeriesEnded)
+
+	type Test struct {
+		Description string
+		Current     domain.Series
+		FindErr     error
+		Err         error
+		Input       ChangeSeriesStatusInput
+		Expected    ChangeSeriesStatusOutput
+		ExpectedErr error
+		// ExpectedUpdates is the number of series stored.
+		ExpectedUpdates int
+	}
+	tests := []Test{
+		{
+			Description: "Airing series ends",
+			Current:     airing,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "ended",
+				EndYear: 1990,
+			},
+			Expected: ChangeSeriesStatusOutput{
+				ID:      "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Status:  "ended",
+				EndYear: 1990,
+				Version: 2,
+			},
+			ExpectedUpdates: 1,
+		},
+		{
+			Description: "Ended series is revived",
+			Current:     ended,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "airing",
+			},
+			Expected: ChangeSeriesStatusOutput{
+				ID:      "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Status:  "airing",
+				Version: 2,
+			},
+			ExpectedUpdates: 1,
+		},
+		{
+			Description: "Announced series cannot end",
+			Current:     announced,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "ended",
+				EndYear: 1990,
+			},
+			Expected:    ChangeSeriesStatusOutput{},
+			ExpectedErr: domain.ErrStatusTransition,
+		},
+		{
+			Description: "Cancelled series needs an end year",
+			Current:     airing,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "cancelled",
+			},
+			Expected:    ChangeSeriesStatusOutput{},
+			ExpectedErr: domain.ErrStatusEndYear,
+		},
+		{
+			Description: "End year before the begin year",
+			Current:     airing,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "ended",
+				EndYear: 1970,
+			},
+			Expected:    ChangeSeriesStatusOutput{},
+			ExpectedErr: domain.ErrStatusEndYear,
+		},
+		{
+			Description: "Series not found",
+			FindErr:     domain.ErrSeriesNotFound,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "airing",
+			},
+			Expected:    ChangeSeriesStatusOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description: "Stale version",
+			Current:     airing,
+			Err:         domain.ErrConcurrentModification,
+			Input: ChangeSeriesStatusInput{
+				Version: 1,
+				Status:  "on_hiatus",
+			},
+			Expected:    ChangeSeriesStatusOutput{},
+			ExpectedErr: domain.ErrConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			updated := []domain.Series{}
+			uc := NewChangeSeriesStatusInteractor(
+				mockChangeSeriesStatusRepo{
+					current: test.Current,
+					findErr: test.FindErr,
+					err:     test.Err,
+					updated: &updated,
+				},
+				mockChangeSeriesStatusPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.ErrorIs(err, test.ExpectedErr)
+			assert.Equal(test.Expected, output)
+			assert.Len(updated, test.ExpectedUpdates)
+		})
+	}
+}
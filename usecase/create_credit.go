@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	CreateCreditUseCase interface {
+		Execute(context.Context, CreateCreditInput) (CreateCreditOutput, error)
+	}
+
+	// CreateCreditInput credits a person on the series. Actors are
+	// credited with the character they played, once per character.
+	CreateCreditInput struct {
+		SeriesID  string `json:"-"                   xml:"-"                   validate:"required,uuid_rfc4122"`
+		PersonID  string `json:"person_id"           xml:"person_id"           validate:"required,uuid_rfc4122"`
+		Role      string `json:"role"                xml:"role"                validate:"required,oneof=creator actor writer director"`
+		Character string `json:"character,omitempty" xml:"character,omitempty" validate:"required_if=Role actor,max=100"`
+		Episodes  int    `json:"episodes"            xml:"episodes"            validate:"min=0,max=10000"`
+	}
+
+	CreateCreditOutput struct {
+		ID        string `json:"id"                  xml:"id"`
+		SeriesID  string `json:"series_id"           xml:"series_id"`
+		PersonID  string `json:"person_id"           xml:"person_id"`
+		Name      string `json:"name"                xml:"name"`
+		Role      string `json:"role"                xml:"role"`
+		Character string `json:"character,omitempty" xml:"character,omitempty"`
+		Episodes  int    `json:"episodes"            xml:"episodes"`
+		CreatedAt string `json:"created_at"          xml:"created_at"`
+		UpdatedAt string `json:"updated_at"          xml:"updated_at"`
+	}
+
+	CreateCreditPresenter interface {
+		Output(domain.Credit) CreateCreditOutput
+	}
+
+	createCreditInteractor struct {
+		series    domain.SeriesRepository
+		people    domain.PersonRepository
+		credits   domain.CreditRepository
+		presenter CreateCreditPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateCreditInteractor(
+	series domain.SeriesRepository,
+	people domain.PersonRepository,
+	credits domain.CreditRepository,
+	presenter CreateCreditPresenter,
+	timeout time.Duration,
+) CreateCreditUseCase {
+	return createCreditInteractor{
+		series:    series,
+		people:    people,
+		credits:   credits,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createCreditInteractor) Execute(
+	ctx context.Context, input CreateCreditInput,
+) (CreateCreditOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateCredit")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var credit domain.Credit
+	err := i.series.WithTransaction(ctx, func(ctx context.Context) error {
+		series, err := i.series.FindByID(ctx, domain.SeriesID(input.SeriesID))
+		if err != nil {
+			return err
+		}
+		person, err := i.people.FindByID(ctx, domain.PersonID(input.PersonID))
+		if err != nil {
+			return err
+		}
+
+		credit, err = i.credits.Create(ctx, domain.NewCredit(
+			domain.CreditID(domain.NewUUID()),
+			series.ID(),
+			person.ID(),
+			domain.CreditRole(input.Role),
+			input.Character,
+			input.Episodes,
+		))
+		if err != nil {
+			return err
+		}
+		credit = credit.WithNames(person.Name(), series.Title())
+		return nil
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Credit{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"credit_id": credit.ID().String(),
+			"series_id": credit.SeriesID().String(),
+			"person_id": credit.PersonID().String(),
+			"role":      credit.Role().String(),
+		}).
+		Infof("Credit created")
+
+	return i.presenter.Output(credit), nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateCreditSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockCreateCreditSeriesRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockCreateCreditSeriesRepo) FindByID(
+	_ context.Context,
+	ID domain.SeriesID,
+) (domain.Series, error) {
+	if r.err != nil {
+		return domain.Series{}, r.err
+	}
+	return domain.NewSeries(ID, "Better Call Saul", "", 2015, 2022), nil
+}
+
+type mockCreateCreditPersonRepo struct {
+	domain.PersonRepository
+	err error
+}
+
+func (r mockCreateCreditPersonRepo) FindByID(
+	_ context.Context,
+	ID domain.PersonID,
+) (domain.Person, error) {
+	if r.err != nil {
+		return domain.Person{}, r.err
+	}
+	return domain.NewPerson(ID, "Bob Odenkirk"), nil
+}
+
+type mockCreateCreditCreditRepo struct {
+	domain.CreditRepository
+	created *[]domain.Credit
+	err     error
+}
+
+func (r mockCreateCreditCreditRepo) Create(
+	_ context.Context,
+	credit domain.Credit,
+) (domain.Credit, error) {
+	if r.err != nil {
+		return domain.Credit{}, r.err
+	}
+	*r.created = append(*r.created, credit)
+	return credit, nil
+}
+
+type mockCreateCreditPresenter struct{}
+
+func (mockCreateCreditPresenter) Output(credit domain.Credit) CreateCreditOutput {
+	return CreateCreditOutput{
+		SeriesID:  credit.SeriesID().String(),
+		PersonID:  credit.PersonID().String(),
+		Name:      credit.PersonName(),
+		Role:      credit.Role().String(),
+		Character: credit.Character(),
+		Episodes:  credit.Episodes(),
+	}
+}
+
+func TestCreateCreditInteractor(t *testing.T) {
+	t.Parallel()
+
+	input := CreateCreditInput{
+		SeriesID:  "SeriesID",
+		PersonID:  "PersonID",
+		Role:      "actor",
+		Character: "Jimmy McGill",
+		Episodes:  63,
+	}
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		PersonErr   error
+		CreditErr   error
+		Expected    CreateCreditOutput
+		ExpectedErr error
+		// ExpectedCreated is the number of credits stored.
+		ExpectedCreated int
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			Expected: CreateCreditOutput{
+				SeriesID:  "SeriesID",
+				PersonID:  "PersonID",
+				Name:      "Bob Odenkirk",
+				Role:      "actor",
+				Character: "Jimmy McGill",
+				Episodes:  63,
+			},
+			ExpectedCreated: 1,
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Expected:    CreateCreditOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description: "Person not found",
+			PersonErr:   domain.ErrPersonNotFound,
+			Expected:    CreateCreditOutput{},
+			ExpectedErr: domain.ErrPersonNotFound,
+		},
+		{
+			Description: "Credit already exists",
+			CreditErr:   domain.ErrCreditExists,
+			Expected:    CreateCreditOutput{},
+			ExpectedErr: domain.ErrCreditExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			created := []domain.Credit{}
+			uc := NewCreateCreditInteractor(
+				mockCreateCreditSeriesRepo{err: test.SeriesErr},
+				mockCreateCreditPersonRepo{err: test.PersonErr},
+				mockCreateCreditCreditRepo{created: &created, err: test.CreditErr},
+				mockCreateCreditPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Len(created, test.ExpectedCreated)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	CreateEpisodeUseCase interface {
+		Execute(context.Context, CreateEpisodeInput) (CreateEpisodeOutput, error)
+	}
+
+	// CreateEpisodeInput adds an episode to the season numbered Season
+	// of a series.
+	CreateEpisodeInput struct {
+		SeriesID string `json:"-"        xml:"-"        validate:"required,uuid_rfc4122"`
+		Season   int    `json:"-"        xml:"-"        validate:"required,min=1"`
+		Number   int    `json:"number"   xml:"number"   validate:"required,min=1,max=1000"`
+		Title    string `json:"title"    xml:"title"    validate:"required,max=100"`
+		AirDate  string `json:"air_date" xml:"air_date" validate:"omitempty,datetime=2006-01-02"`
+		Runtime  int    `json:"runtime"  xml:"runtime"  validate:"omitempty,min=1,max=600"`
+		Synopsis string `json:"synopsis" xml:"synopsis" validate:"max=1000"`
+	}
+
+	CreateEpisodeOutput struct {
+		ID        string `json:"id"                 xml:"id"`
+		SeriesID  string `json:"series_id"          xml:"series_id"`
+		Season    int    `json:"season"             xml:"season"`
+		Number    int    `json:"number"             xml:"number"`
+		Title     string `json:"title"              xml:"title"`
+		AirDate   string `json:"air_date,omitempty" xml:"air_date,omitempty"`
+		Runtime   int    `json:"runtime,omitempty"  xml:"runtime,omitempty"`
+		Synopsis  string `json:"synopsis"           xml:"synopsis"`
+		CreatedAt string `json:"created_at"         xml:"created_at"`
+		UpdatedAt string `json:"updated_at"         xml:"updated_at"`
+	}
+
+	CreateEpisodePresenter interface {
+		Output(domain.Season, domain.Episode) CreateEpisodeOutput
+	}
+
+	createEpisodeInteractor struct {
+		series    domain.SeriesRepository
+		seasons   domain.SeasonRepository
+		episodes  domain.EpisodeRepository
+		presenter CreateEpisodePresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateEpisodeInteractor(
+	series domain.SeriesRepository,
+	seasons domain.SeasonRepository,
+	episodes domain.EpisodeRepository,
+	presenter CreateEpisodePresenter,
+	timeout time.Duration,
+) CreateEpisodeUseCase {
+	return createEpisodeInteractor{
+		series:    series,
+		seasons:   seasons,
+		episodes:  episodes,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createEpisodeInteractor) Execute(
+	ctx context.Context, input CreateEpisodeInput,
+) (CreateEpisodeOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateEpisode")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	airDate, err := parseDate(input.AirDate)
+	if err != nil {
+		return i.presenter.Output(domain.Season{}, domain.Episode{}), err
+	}
+
+	var (
+		season  domain.Season
+		episode domain.Episode
+	)
+	err = i.seasons.WithTransaction(ctx, func(ctx context.Context) error {
+		seriesID := domain.SeriesID(input.SeriesID)
+		_, err := i.series.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		season, err = i.seasons.FindByNumber(ctx, seriesID, input.Season)
+		if err != nil {
+			return err
+		}
+
+		episode, err = i.episodes.Create(ctx, domain.NewEpisode(
+			domain.EpisodeID(domain.NewUUID()),
+			season.ID(),
+			seriesID,
+			input.Number,
+			input.Title,
+			airDate,
+			input.Runtime,
+			input.Synopsis,
+		))
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Season{}, domain.Episode{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"episode_id": episode.ID().String(),
+			"season_id":  episode.SeasonID().String(),
+			"series_id":  episode.SeriesID().String(),
+		}).
+		Infof("Episode created")
+
+	return i.presenter.Output(season, episode), nil
+}
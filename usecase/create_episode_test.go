@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateEpisodeSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockCreateEpisodeSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockCreateEpisodeSeasonRepo struct {
+	domain.SeasonRepository
+	season domain.Season
+	err    error
+}
+
+func (r mockCreateEpisodeSeasonRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockCreateEpisodeSeasonRepo) FindByNumber(
+	_ context.Context,
+	_ domain.SeriesID,
+	_ int,
+) (domain.Season, error) {
+	return r.season, r.err
+}
+
+type mockCreateEpisodeEpisodeRepo struct {
+	domain.EpisodeRepository
+	created *[]domain.Episode
+	err     error
+}
+
+func (r mockCreateEpisodeEpisodeRepo) Create(
+	_ context.Context,
+	episode domain.Episode,
+) (domain.Episode, error) {
+	*r.created = append(*r.created, episode)
+	return episode, r.err
+}
+
+type mockCreateEpisodePresenter struct{}
+
+func (mockCreateEpisodePresenter) Output(
+	season domain.Season,
+	episode domain.Episode,
+) CreateEpisodeOutput {
+	return CreateEpisodeOutput{
+		Season:  season.Number(),
+		Number:  episode.Number(),
+		Runtime: episode.Runtime(),
+	}
+}
+
+func TestCreateEpisodeInteractor(t *testing.T) {
+	t.Parallel()
+
+	season := domain.NewSeason(
+		"SeasonID", "1be9775b-8d32-4710-9ce6-7ece88e30f01", 5, "", time.Time{}, "",
+	)
+	input := CreateEpisodeInput{
+		SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+		Season:   5,
+		Number:   14,
+		Title:    "Ozymandias",
+		AirDate:  "2013-09-15",
+		Runtime:  47,
+	}
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		SeasonErr   error
+		EpisodeErr  error
+		Expected    CreateEpisodeOutput
+		ExpectedErr error
+		// ExpectedCreated is the number of episodes stored.
+		ExpectedCreated int
+	}
+	tests := []Test{
+		{
+			Description:     "Successful creation",
+			Expected:        CreateEpisodeOutput{Season: 5, Number: 14, Runtime: 47},
+			ExpectedCreated: 1,
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Expected:    CreateEpisodeOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description: "Season not found",
+			SeasonErr:   domain.ErrSeasonNotFound,
+			Expected:    CreateEpisodeOutput{},
+			ExpectedErr: domain.ErrSeasonNotFound,
+		},
+		{
+			Description:     "Episode number taken",
+			EpisodeErr:      domain.ErrEpisodeExists,
+			Expected:        CreateEpisodeOutput{},
+			ExpectedErr:     domain.ErrEpisodeExists,
+			ExpectedCreated: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			created := []domain.Episode{}
+			uc := NewCreateEpisodeInteractor(
+				mockCreateEpisodeSeriesRepo{err: test.SeriesErr},
+				mockCreateEpisodeSeasonRepo{season: season, err: test.SeasonErr},
+				mockCreateEpisodeEpisodeRepo{created: &created, err: test.EpisodeErr},
+				mockCreateEpisodePresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+			assert.Len(created, test.ExpectedCreated)
+			if test.ExpectedCreated > 0 {
+				assert.Equal(season.ID(), created[0].SeasonID())
+				assert.Equal(
+					time.Date(2013, 9, 15, 0, 0, 0, 0, time.UTC),
+					created[0].AirDate(),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"time"
+)
+
+type (
+	CreateGenreUseCase interface {
+		Execute(context.Context, CreateGenreInput) (CreateGenreOutput, error)
+	}
+
+	CreateGenreInput struct {
+		Name string `json:"name" xml:"name" validate:"required,max=50"`
+	}
+
+	CreateGenreOutput struct {
+		ID        string `json:"id"         xml:"id"`
+		Name      string `json:"name"       xml:"name"`
+		CreatedAt string `json:"created_at" xml:"created_at"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	CreateGenrePresenter interface {
+		Output(domain.Genre) CreateGenreOutput
+	}
+
+	createGenreInteractor struct {
+		repo      domain.GenreRepository
+		presenter CreateGenrePresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateGenreInteractor(
+	repo domain.GenreRepository,
+	presenter CreateGenrePresenter,
+	timeout time.Duration,
+) CreateGenreUseCase {
+	return createGenreInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createGenreInteractor) Execute(
+	ctx context.Context, input CreateGenreInput,
+) (CreateGenreOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateGenre")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	genre := domain.NewGenre(
+		domain.GenreID(domain.NewUUID()),
+		strings.TrimSpace(input.Name),
+	)
+
+	genre, err := i.repo.Create(ctx, genre)
+	if err != nil {
+		return i.presenter.Output(domain.Genre{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"genre_id": genre.ID().String(),
+			"name":     genre.Name(),
+		}).
+		Infof("Genre created")
+
+	return i.presenter.Output(genre), nil
+}
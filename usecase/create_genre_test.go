@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateGenreRepo struct {
+	domain.GenreRepository
+	created *domain.Genre
+	err     error
+}
+
+func (r mockCreateGenreRepo) Create(
+	_ context.Context,
+	genre domain.Genre,
+) (domain.Genre, error) {
+	*r.created = genre
+	return genre, r.err
+}
+
+type mockCreateGenrePresenter struct{}
+
+func (p mockCreateGenrePresenter) Output(genre domain.Genre) CreateGenreOutput {
+	return CreateGenreOutput{
+		ID:   genre.ID().String(),
+		Name: genre.Name(),
+	}
+}
+
+func TestCreateGenreInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description  string
+		Input        CreateGenreInput
+		Err          error
+		ExpectedName string
+		ExpectedErr  error
+	}
+	tests := []Test{
+		{
+			Description:  "Successful creation",
+			Input:        CreateGenreInput{Name: "Drama"},
+			ExpectedName: "Drama",
+		},
+		{
+			Description:  "Name is trimmed",
+			Input:        CreateGenreInput{Name: " Science fiction "},
+			ExpectedName: "Science fiction",
+		},
+		{
+			Description: "Genre already exists",
+			Input:       CreateGenreInput{Name: "Drama"},
+			Err:         domain.ErrGenreExists,
+			ExpectedErr: domain.ErrGenreExists,
+		},
+		{
+			Description: "Some error",
+			Input:       CreateGenreInput{Name: "Drama"},
+			Err:         testErr,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var created domain.Genre
+			uc := NewCreateGenreInteractor(
+				mockCreateGenreRepo{created: &created, err: test.Err},
+				mockCreateGenrePresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			if err != nil {
+				assert.Equal(CreateGenreOutput{}, output)
+				return
+			}
+
+			assert.Equal(test.ExpectedName, created.Name())
+			assert.True(domain.IsValidUUID(created.ID().String()))
+			assert.Equal(created.ID().String(), output.ID)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"time"
+)
+
+type (
+	CreateNetworkUseCase interface {
+		Execute(context.Context, CreateNetworkInput) (CreateNetworkOutput, error)
+	}
+
+	CreateNetworkInput struct {
+		Name    string `json:"name"    xml:"name"    validate:"required,max=50"`
+		Country string `json:"country" xml:"country" validate:"omitempty,country"`
+	}
+
+	CreateNetworkOutput struct {
+		ID        string `json:"id"         xml:"id"`
+		Name      string `json:"name"       xml:"name"`
+		Country   string `json:"country"    xml:"country"`
+		CreatedAt string `json:"created_at" xml:"created_at"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	CreateNetworkPresenter interface {
+		Output(domain.Network) CreateNetworkOutput
+	}
+
+	createNetworkInteractor struct {
+		repo      domain.NetworkRepository
+		presenter CreateNetworkPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateNetworkInteractor(
+	repo domain.NetworkRepository,
+	presenter CreateNetworkPresenter,
+	timeout time.Duration,
+) CreateNetworkUseCase {
+	return createNetworkInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createNetworkInteractor) Execute(
+	ctx context.Context, input CreateNetworkInput,
+) (CreateNetworkOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateNetwork")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	network := domain.NewNetwork(
+		domain.NetworkID(domain.NewUUID()),
+		strings.TrimSpace(input.Name),
+		input.Country,
+	)
+
+	network, err := i.repo.Create(ctx, network)
+	if err != nil {
+		return i.presenter.Output(domain.Network{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"network_id": network.ID().String(),
+			"name":       network.Name(),
+		}).
+		Infof("Network created")
+
+	return i.presenter.Output(network), nil
+}
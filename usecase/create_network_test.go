@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateNetworkRepo struct {
+	domain.NetworkRepository
+	created *domain.Network
+	err     error
+}
+
+func (r mockCreateNetworkRepo) Create(
+	_ context.Context,
+	network domain.Network,
+) (domain.Network, error) {
+	*r.created = network
+	return network, r.err
+}
+
+type mockCreateNetworkPresenter struct{}
+
+func (p mockCreateNetworkPresenter) Output(network domain.Network) CreateNetworkOutput {
+	return CreateNetworkOutput{
+		ID:   network.ID().String(),
+		Name: network.Name(),
+	}
+}
+
+func TestCreateNetworkInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description  string
+		Input        CreateNetworkInput
+		Err          error
+		ExpectedName string
+		ExpectedErr  error
+	}
+	tests := []Test{
+		{
+			Description:  "Successful creation",
+			Input:        CreateNetworkInput{Name: "HBO", Country: "US"},
+			ExpectedName: "HBO",
+		},
+		{
+			Description:  "Name is trimmed",
+			Input:        CreateNetworkInput{Name: " Adult Swim "},
+			ExpectedName: "Adult Swim",
+		},
+		{
+			Description: "Network already exists",
+			Input:       CreateNetworkInput{Name: "HBO"},
+			Err:         domain.ErrNetworkExists,
+			ExpectedErr: domain.ErrNetworkExists,
+		},
+		{
+			Description: "Some error",
+			Input:       CreateNetworkInput{Name: "HBO"},
+			Err:         testErr,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var created domain.Network
+			uc := NewCreateNetworkInteractor(
+				mockCreateNetworkRepo{created: &created, err: test.Err},
+				mockCreateNetworkPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			if err != nil {
+				assert.Equal(CreateNetworkOutput{}, output)
+				return
+			}
+
+			assert.Equal(test.ExpectedName, created.Name())
+			assert.True(domain.IsValidUUID(created.ID().String()))
+			assert.Equal(created.ID().String(), output.ID)
+		})
+	}
+}
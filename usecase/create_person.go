@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"time"
+)
+
+type (
+	CreatePersonUseCase interface {
+		Execute(context.Context, CreatePersonInput) (CreatePersonOutput, error)
+	}
+
+	CreatePersonInput struct {
+		Name string `json:"name" xml:"name" validate:"required,max=150"`
+	}
+
+	CreatePersonOutput struct {
+		ID        string `json:"id"         xml:"id"`
+		Name      string `json:"name"       xml:"name"`
+		CreatedAt string `json:"created_at" xml:"created_at"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	CreatePersonPresenter interface {
+		Output(domain.Person) CreatePersonOutput
+	}
+
+	createPersonInteractor struct {
+		repo      domain.PersonRepository
+		presenter CreatePersonPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreatePersonInteractor(
+	repo domain.PersonRepository,
+	presenter CreatePersonPresenter,
+	timeout time.Duration,
+) CreatePersonUseCase {
+	return createPersonInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createPersonInteractor) Execute(
+	ctx context.Context, input CreatePersonInput,
+) (CreatePersonOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreatePerson")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	person := domain.NewPerson(
+		domain.PersonID(domain.NewUUID()),
+		strings.TrimSpace(input.Name),
+	)
+
+	person, err := i.repo.Create(ctx, person)
+	if err != nil {
+		return i.presenter.Output(domain.Person{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{"person_id": person.ID().String()}).
+		Infof("Person created")
+
+	return i.presenter.Output(person), nil
+}
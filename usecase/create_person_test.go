@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreatePersonRepo struct {
+	domain.PersonRepository
+	created *domain.Person
+	err     error
+}
+
+func (r mockCreatePersonRepo) Create(
+	_ context.Context,
+	person domain.Person,
+) (domain.Person, error) {
+	*r.created = person
+	return person, r.err
+}
+
+type mockCreatePersonPresenter struct{}
+
+func (p mockCreatePersonPresenter) Output(person domain.Person) CreatePersonOutput {
+	return CreatePersonOutput{
+		ID:   person.ID().String(),
+		Name: person.Name(),
+	}
+}
+
+func TestCreatePersonInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description  string
+		Input        CreatePersonInput
+		Err          error
+		ExpectedName string
+		ExpectedErr  error
+	}
+	tests := []Test{
+		{
+			Description:  "Successful creation",
+			Input:        CreatePersonInput{Name: "Vince Gilligan"},
+			ExpectedName: "Vince Gilligan",
+		},
+		{
+			Description:  "Name is trimmed",
+			Input:        CreatePersonInput{Name: " Peter Gould "},
+			ExpectedName: "Peter Gould",
+		},
+		{
+			Description: "Some error",
+			Input:       CreatePersonInput{Name: "Vince Gilligan"},
+			Err:         testErr,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var created domain.Person
+			uc := NewCreatePersonInteractor(
+				mockCreatePersonRepo{created: &created, err: test.Err},
+				mockCreatePersonPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			if err != nil {
+				assert.Equal(CreatePersonOutput{}, output)
+				return
+			}
+
+			assert.Equal(test.ExpectedName, created.Name())
+			assert.True(domain.IsValidUUID(created.ID().String()))
+			assert.Equal(created.ID().String(), output.ID)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"series/adapter/logger"
 	"series/domain"
 	"time"
 )
@@ -11,17 +12,26 @@ type (
 		Execute(context.Context, CreateReviewInput) (CreateReviewOutput, error)
 	}
 
+	// CreateReviewInput reviews the series, or one of its episodes when
+	// EpisodeID is set. Rating is optional, 0 leaves the review unrated.
 	CreateReviewInput struct {
-		SeriesID string `json:"series_id" validate:"required,uuid_rfc4122"`
-		AuthorID string `json:"author_id" validate:"required,uuid_rfc4122"`
-		Text     string `json:"text"      validate:"required,max=500"`
+		SeriesID  string `json:"series_id"  xml:"series_id"  validate:"required,uuid_rfc4122"`
+		EpisodeID string `json:"episode_id" xml:"episode_id" validate:"omitempty,uuid_rfc4122"`
+		AuthorID  string `json:"author_id"  xml:"author_id"  validate:"required,uuid_rfc4122"`
+		Text      string `json:"text"       xml:"text"       validate:"required,max=500"`
+		Rating    int    `json:"rating"     xml:"rating"     validate:"omitempty,min=1,max=10"`
 	}
 
 	CreateReviewOutput struct {
-		ID       string `json:"id"`
-		SeriesID string `json:"series_id"`
-		AuthorID string `json:"author_id"`
-		Text     string `json:"text"`
+		ID        string `json:"id"                   xml:"id"`
+		SeriesID  string `json:"series_id"            xml:"series_id"`
+		EpisodeID string `json:"episode_id,omitempty" xml:"episode_id,omitempty"`
+		AuthorID  string `json:"author_id"            xml:"author_id"`
+		Text      string `json:"text"                 xml:"text"`
+		Rating    int    `json:"rating,omitempty"     xml:"rating,omitempty"`
+		Version   int    `json:"version"              xml:"version"`
+		CreatedAt string `json:"created_at"           xml:"created_at"`
+		UpdatedAt string `json:"updated_at"           xml:"updated_at"`
 	}
 
 	CreateReviewPresenter interface {
@@ -30,7 +40,9 @@ type (
 
 	createReviewInteractor struct {
 		series    domain.SeriesRepository
+		episodes  domain.EpisodeRepository
 		reviews   domain.ReviewRepository
+		events    domain.EventRepository
 		presenter CreateReviewPresenter
 		timeout   time.Duration
 	}
@@ -38,13 +50,17 @@ type (
 
 func NewCreateReviewInteractor(
 	series domain.SeriesRepository,
+	episodes domain.EpisodeRepository,
 	reviews domain.ReviewRepository,
+	events domain.EventRepository,
 	presenter CreateReviewPresenter,
 	timeout time.Duration,
 ) CreateReviewUseCase {
 	return createReviewInteractor{
 		series:    series,
+		episodes:  episodes,
 		reviews:   reviews,
+		events:    events,
 		presenter: presenter,
 		timeout:   timeout,
 	}
@@ -53,6 +69,9 @@ func NewCreateReviewInteractor(
 func (i createReviewInteractor) Execute(
 	ctx context.Context, input CreateReviewInput,
 ) (CreateReviewOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateReview")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, i.timeout)
 	defer cancel()
 
@@ -67,9 +86,21 @@ func (i createReviewInteractor) Execute(
 			return err
 		}
 
+		if input.EpisodeID != "" {
+			episode, err := i.episodes.FindByID(ctx, domain.EpisodeID(input.EpisodeID))
+			if err != nil {
+				return err
+			}
+			// An episode of another series is as unknown as a missing one.
+			if episode.SeriesID() != domain.SeriesID(input.SeriesID) {
+				return domain.ErrEpisodeNotFound
+			}
+		}
+
 		err = i.reviews.Reviewed(
 			ctx,
 			domain.SeriesID(input.SeriesID),
+			domain.EpisodeID(input.EpisodeID),
 			domain.AuthorID(input.AuthorID),
 		)
 		if err != nil {
@@ -81,12 +112,33 @@ func (i createReviewInteractor) Execute(
 			domain.SeriesID(input.SeriesID),
 			domain.AuthorID(input.AuthorID),
 			input.Text,
-		))
-		return err
+		).
+			WithEpisode(domain.EpisodeID(input.EpisodeID)).
+			WithRating(input.Rating))
+		if err != nil {
+			return err
+		}
+
+		return createEvent(
+			ctx,
+			i.events,
+			domain.EventReviewCreated,
+			review.ID().String(),
+			i.presenter.Output(review),
+		)
 	})
 
 	if err != nil {
 		return i.presenter.Output(domain.Review{}), err
 	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"review_id":  review.ID().String(),
+			"series_id":  review.SeriesID().String(),
+			"episode_id": review.EpisodeID().String(),
+		}).
+		Infof("Review created")
+
 	return i.presenter.Output(review), nil
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"series/domain"
 	"testing"
 	"time"
@@ -22,6 +23,19 @@ func (r mockCreateReviewSeriesRepo) FindByID(
 	return r.series, r.err
 }
 
+type mockCreateReviewEpisodeRepo struct {
+	domain.EpisodeRepository
+	episode domain.Episode
+	err     error
+}
+
+func (r mockCreateReviewEpisodeRepo) FindByID(
+	_ context.Context,
+	_ domain.EpisodeID,
+) (domain.Episode, error) {
+	return r.episode, r.err
+}
+
 type mockCreateReviewReviewRepo struct {
 	domain.ReviewRepository
 	reviewedErr error
@@ -46,11 +60,26 @@ func (r mockCreateReviewReviewRepo) Create(
 func (r mockCreateReviewReviewRepo) Reviewed(
 	_ context.Context,
 	_ domain.SeriesID,
+	_ domain.EpisodeID,
 	_ domain.AuthorID,
 ) error {
 	return r.reviewedErr
 }
 
+type mockCreateReviewEventRepo struct {
+	domain.EventRepository
+	created *[]domain.Event
+	err     error
+}
+
+func (r mockCreateReviewEventRepo) Create(
+	_ context.Context,
+	event domain.Event,
+) (domain.Event, error) {
+	*r.created = append(*r.created, event)
+	return event, r.err
+}
+
 type mockCreateReviewPresenter struct {
 	output CreateReviewOutput
 }
@@ -67,10 +96,15 @@ func TestCreateReviewInteractor(t *testing.T) {
 	type Test struct {
 		Description string
 		Series      domain.SeriesRepository
+		Episodes    domain.EpisodeRepository
 		Reviews     domain.ReviewRepository
+		EventErr    error
+		Input       CreateReviewInput
 		Presenter   CreateReviewPresenter
 		Expected    CreateReviewOutput
 		ExpectedErr error
+		// ExpectedEvents is the number of events added to the outbox.
+		ExpectedEvents int
 	}
 	tests := []Test{
 		{
@@ -103,7 +137,26 @@ func TestCreateReviewInteractor(t *testing.T) {
 				AuthorID: "AuthorID",
 				Text:     "Text",
 			},
-			ExpectedErr: nil,
+			ExpectedErr:    nil,
+			ExpectedEvents: 1,
+		},
+
+		{
+			Description: "Failing to add the event fails the creation",
+			Series:      mockCreateReviewSeriesRepo{},
+			Reviews: mockCreateReviewReviewRepo{
+				review: domain.NewReview(
+					"ID",
+					"SeriesID",
+					"AuthorID",
+					"Text",
+				),
+			},
+			EventErr:       errors.New("error"),
+			Presenter:      mockCreateReviewPresenter{},
+			Expected:       CreateReviewOutput{},
+			ExpectedErr:    errors.New("error"),
+			ExpectedEvents: 1,
 		},
 
 		{
@@ -128,20 +181,105 @@ func TestCreateReviewInteractor(t *testing.T) {
 			Expected:    CreateReviewOutput{},
 			ExpectedErr: domain.ErrAlreadyReviewed,
 		},
+
+		{
+			Description: "Successful creation of an episode review",
+			Series:      mockCreateReviewSeriesRepo{},
+			Episodes: mockCreateReviewEpisodeRepo{
+				episode: domain.NewEpisode(
+					"EpisodeID",
+					"SeasonID",
+					"SeriesID",
+					1,
+					"Title",
+					time.Time{},
+					0,
+					"Synopsis",
+				),
+			},
+			Reviews: mockCreateReviewReviewRepo{
+				review: domain.NewReview(
+					"ID",
+					"SeriesID",
+					"AuthorID",
+					"Text",
+				).WithEpisode("EpisodeID").WithRating(9),
+			},
+			Input: CreateReviewInput{
+				SeriesID:  "SeriesID",
+				EpisodeID: "EpisodeID",
+				Rating:    9,
+			},
+			Presenter: mockCreateReviewPresenter{
+				output: CreateReviewOutput{ID: "ID", EpisodeID: "EpisodeID", Rating: 9},
+			},
+			Expected:       CreateReviewOutput{ID: "ID", EpisodeID: "EpisodeID", Rating: 9},
+			ExpectedErr:    nil,
+			ExpectedEvents: 1,
+		},
+
+		{
+			Description: "Creating review for episode that does not exist",
+			Series:      mockCreateReviewSeriesRepo{},
+			Episodes: mockCreateReviewEpisodeRepo{
+				err: domain.ErrEpisodeNotFound,
+			},
+			Reviews: mockCreateReviewReviewRepo{},
+			Input: CreateReviewInput{
+				SeriesID:  "SeriesID",
+				EpisodeID: "EpisodeID",
+			},
+			Presenter:   mockCreateReviewPresenter{},
+			Expected:    CreateReviewOutput{},
+			ExpectedErr: domain.ErrEpisodeNotFound,
+		},
+
+		{
+			Description: "Creating review for episode of another series",
+			Series:      mockCreateReviewSeriesRepo{},
+			Episodes: mockCreateReviewEpisodeRepo{
+				episode: domain.NewEpisode(
+					"EpisodeID",
+					"SeasonID",
+					"OtherSeriesID",
+					1,
+					"Title",
+					time.Time{},
+					0,
+					"Synopsis",
+				),
+			},
+			Reviews: mockCreateReviewReviewRepo{},
+			Input: CreateReviewInput{
+				SeriesID:  "SeriesID",
+				EpisodeID: "EpisodeID",
+			},
+			Presenter:   mockCreateReviewPresenter{},
+			Expected:    CreateReviewOutput{},
+			ExpectedErr: domain.ErrEpisodeNotFound,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Description, func(t *testing.T) {
 			assert := assert.New(t)
+			created := []domain.Event{}
 			uc := NewCreateReviewInteractor(
 				test.Series,
+				test.Episodes,
 				test.Reviews,
+				mockCreateReviewEventRepo{created: &created, err: test.EventErr},
 				test.Presenter,
 				1*time.Second,
 			)
-			got, err := uc.Execute(context.TODO(), CreateReviewInput{})
+			got, err := uc.Execute(context.TODO(), test.Input)
 			assert.Equal(test.ExpectedErr, err)
 			assert.Equal(test.Expected, got)
+			assert.Len(created, test.ExpectedEvents)
+			for _, event := range created {
+				assert.Equal(domain.EventReviewCreated, event.Type())
+				assert.Equal("ID", event.AggregateID())
+			}
 		})
 	}
 }
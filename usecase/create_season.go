@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	CreateSeasonUseCase interface {
+		Execute(context.Context, CreateSeasonInput) (CreateSeasonOutput, error)
+	}
+
+	CreateSeasonInput struct {
+		SeriesID string `json:"-"        xml:"-"        validate:"required,uuid_rfc4122"`
+		Number   int    `json:"number"   xml:"number"   validate:"required,min=1,max=100"`
+		Title    string `json:"title"    xml:"title"    validate:"max=70"`
+		AirDate  string `json:"air_date" xml:"air_date" validate:"omitempty,datetime=2006-01-02"`
+		Synopsis string `json:"synopsis" xml:"synopsis" validate:"max=1000"`
+	}
+
+	CreateSeasonOutput struct {
+		ID        string `json:"id"                 xml:"id"`
+		SeriesID  string `json:"series_id"          xml:"series_id"`
+		Number    int    `json:"number"             xml:"number"`
+		Title     string `json:"title"              xml:"title"`
+		AirDate   string `json:"air_date,omitempty" xml:"air_date,omitempty"`
+		Synopsis  string `json:"synopsis"           xml:"synopsis"`
+		Episodes  int    `json:"episodes"           xml:"episodes"`
+		CreatedAt string `json:"created_at"         xml:"created_at"`
+		UpdatedAt string `json:"updated_at"         xml:"updated_at"`
+	}
+
+	CreateSeasonPresenter interface {
+		Output(domain.Season) CreateSeasonOutput
+	}
+
+	createSeasonInteractor struct {
+		series    domain.SeriesRepository
+		seasons   domain.SeasonRepository
+		presenter CreateSeasonPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateSeasonInteractor(
+	series domain.SeriesRepository,
+	seasons domain.SeasonRepository,
+	presenter CreateSeasonPresenter,
+	timeout time.Duration,
+) CreateSeasonUseCase {
+	return createSeasonInteractor{
+		series:    series,
+		seasons:   seasons,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createSeasonInteractor) Execute(
+	ctx context.Context, input CreateSeasonInput,
+) (CreateSeasonOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateSeason")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	airDate, err := parseDate(input.AirDate)
+	if err != nil {
+		return i.presenter.Output(domain.Season{}), err
+	}
+
+	var season domain.Season
+	err = i.seasons.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.series.FindByID(ctx, domain.SeriesID(input.SeriesID))
+		if err != nil {
+			return err
+		}
+
+		season, err = i.seasons.Create(ctx, domain.NewSeason(
+			domain.SeasonID(domain.NewUUID()),
+			domain.SeriesID(input.SeriesID),
+			input.Number,
+			input.Title,
+			airDate,
+			input.Synopsis,
+		))
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Season{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"season_id": season.ID().String(),
+			"series_id": season.SeriesID().String(),
+		}).
+		Infof("Season created")
+
+	return i.presenter.Output(season), nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateSeasonSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockCreateSeasonSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockCreateSeasonSeasonRepo struct {
+	domain.SeasonRepository
+	created *[]domain.Season
+	err     error
+}
+
+func (r mockCreateSeasonSeasonRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockCreateSeasonSeasonRepo) Create(
+	_ context.Context,
+	season domain.Season,
+) (domain.Season, error) {
+	*r.created = append(*r.created, season)
+	return season, r.err
+}
+
+type mockCreateSeasonPresenter struct{}
+
+func (mockCreateSeasonPresenter) Output(season domain.Season) CreateSeasonOutput {
+	return CreateSeasonOutput{
+		Number:  season.Number(),
+		AirDate: season.AirDate().Format(DateLayout),
+	}
+}
+
+func TestCreateSeasonInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		SeasonErr   error
+		Input       CreateSeasonInput
+		Expected    CreateSeasonOutput
+		ExpectedErr error
+		// ExpectedCreated is the number of seasons stored.
+		ExpectedCreated int
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation",
+			Input: CreateSeasonInput{
+				SeriesID: "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				Number:   1,
+				AirDate:  "2008-01-20",
+			},
+			Expected:        CreateSeasonOutput{Number: 1, AirDate: "2008-01-20"},
+			ExpectedCreated: 1,
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Input:       CreateSeasonInput{Number: 1},
+			Expected:    CreateSeasonOutput{AirDate: "0001-01-01"},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description:     "Season number taken",
+			SeasonErr:       domain.ErrSeasonExists,
+			Input:           CreateSeasonInput{Number: 1},
+			Expected:        CreateSeasonOutput{AirDate: "0001-01-01"},
+			ExpectedErr:     domain.ErrSeasonExists,
+			ExpectedCreated: 1,
+		},
+		{
+			Description:     "Generic error",
+			SeasonErr:       errors.New("Error"),
+			Input:           CreateSeasonInput{Number: 1},
+			Expected:        CreateSeasonOutput{AirDate: "0001-01-01"},
+			ExpectedErr:     errors.New("Error"),
+			ExpectedCreated: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			created := []domain.Season{}
+			uc := NewCreateSeasonInteractor(
+				mockCreateSeasonSeriesRepo{err: test.SeriesErr},
+				mockCreateSeasonSeasonRepo{created: &created, err: test.SeasonErr},
+				mockCreateSeasonPresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+			assert.Len(created, test.ExpectedCreated)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"series/adapter/logger"
 	"series/domain"
 	"time"
 )
@@ -12,22 +15,35 @@ type (
 	}
 
 	CreateSeriesInput struct {
-		Title       string `json:"title"       validate:"required,max=70"`
-		Description string `json:"description" validate:"required,max=200"`
-		Episodes    int    `json:"episodes"    validate:"required,min=1"`
-		BeginYear   int    `json:"begin_year"  validate:"required,min=1946,max=2030"`
-		EndYear     int    `json:"end_year"    validate:"eq=0|gtefield=BeginYear"`
-		Creator     string `json:"creator"     validate:"required,min=5,max=30"`
+		Title       string   `json:"title"       xml:"title"                  validate:"required,max=70"`
+		Description string   `json:"description" xml:"description"            validate:"required,max=200"`
+		BeginYear   int      `json:"begin_year"  xml:"begin_year"             validate:"required,min=1946,max=2030"`
+		EndYear     int      `json:"end_year"    xml:"end_year"               validate:"eq=0|gtefield=BeginYear"`
+		CreatorIDs  []string `json:"creator_ids" xml:"creator_ids>creator_id" validate:"required,min=1,max=10,unique,dive,uuid_rfc4122"`
+		Status      string   `json:"status"      xml:"status"                 validate:"omitempty,oneof=announced airing on_hiatus ended cancelled miniseries"`
+		NetworkID   string   `json:"network_id"  xml:"network_id"             validate:"omitempty,uuid_rfc4122"`
+		Country     string   `json:"country"     xml:"country"                validate:"omitempty,country"`
+		Language    string   `json:"language"    xml:"language"               validate:"omitempty,language"`
 	}
 
 	CreateSeriesOutput struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Episodes    int    `json:"episodes"`
-		BeginYear   int    `json:"begin_year"`
-		EndYear     int    `json:"end_year"`
-		Creator     string `json:"creator"`
+		ID          string   `json:"id"          xml:"id"`
+		Slug        string   `json:"slug"        xml:"slug"`
+		Title       string   `json:"title"       xml:"title"`
+		Description string   `json:"description" xml:"description"`
+		Episodes    int      `json:"episodes"    xml:"episodes"`
+		BeginYear   int      `json:"begin_year"  xml:"begin_year"`
+		EndYear     int      `json:"end_year"    xml:"end_year"`
+		Creator     string   `json:"creator"     xml:"creator"`
+		CreatorIDs  []string `json:"creator_ids" xml:"creator_ids>creator_id"`
+		Status      string   `json:"status"      xml:"status"`
+		NetworkID   string   `json:"network_id"  xml:"network_id"`
+		Network     string   `json:"network"     xml:"network"`
+		Country     string   `json:"country"     xml:"country"`
+		Language    string   `json:"language"    xml:"language"`
+		Version     int      `json:"version"     xml:"version"`
+		CreatedAt   string   `json:"created_at"  xml:"created_at"`
+		UpdatedAt   string   `json:"updated_at"  xml:"updated_at"`
 	}
 
 	CreateSeriesPresenter interface {
@@ -36,6 +52,9 @@ type (
 
 	createSeriesInteractor struct {
 		repo      domain.SeriesRepository
+		networks  domain.NetworkRepository
+		people    domain.PersonRepository
+		events    domain.EventRepository
 		presenter CreateSeriesPresenter
 		timeout   time.Duration
 	}
@@ -43,11 +62,17 @@ type (
 
 func NewCreateSeriesInteractor(
 	repo domain.SeriesRepository,
+	networks domain.NetworkRepository,
+	people domain.PersonRepository,
+	events domain.EventRepository,
 	presenter CreateSeriesPresenter,
 	timeout time.Duration,
 ) CreateSeriesUseCase {
 	return createSeriesInteractor{
 		repo:      repo,
+		networks:  networks,
+		people:    people,
+		events:    events,
 		presenter: presenter,
 		timeout:   timeout,
 	}
@@ -56,21 +81,127 @@ func NewCreateSeriesInteractor(
 func (i createSeriesInteractor) Execute(
 	ctx context.Context, input CreateSeriesInput,
 ) (CreateSeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateSeries")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, i.timeout)
 	defer cancel()
 
 	series := domain.NewSeries(
 		domain.SeriesID(domain.NewUUID()),
 		input.Title, input.Description,
-		input.Episodes,
 		input.BeginYear, input.EndYear,
-		input.Creator,
-	)
+	).WithOrigin(input.Country, input.Language)
+
+	// Without a status, series are airing until they get an end year.
+	if input.Status != "" {
+		status := domain.SeriesStatus(input.Status)
+		if err := status.CheckEndYear(input.EndYear); err != nil {
+			return i.presenter.Output(domain.Series{}), err
+		}
+		series = series.WithStatus(status)
+	}
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		series, err = withNetwork(ctx, i.networks, series, input.NetworkID)
+		if err != nil {
+			return err
+		}
+
+		series, err = withCreators(ctx, i.people, series, input.CreatorIDs)
+		if err != nil {
+			return err
+		}
+
+		slug, err := uniqueSlug(ctx, i.repo, series)
+		if err != nil {
+			return err
+		}
+
+		series, err = i.repo.Create(ctx, series.WithSlug(slug))
+		if err != nil {
+			return err
+		}
+
+		return createEvent(
+			ctx,
+			i.events,
+			domain.EventSeriesCreated,
+			series.ID().String(),
+			i.presenter.Output(series),
+		)
+	})
 
-	series, err := i.repo.Create(ctx, series)
 	if err != nil {
 		return i.presenter.Output(domain.Series{}), err
 	}
 
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{"series_id": series.ID().String()}).
+		Infof("Series created")
+
 	return i.presenter.Output(series), nil
 }
+
+// withNetwork returns a copy of the series broadcast by the network with
+// the given ID, or domain.ErrNetworkNotFound. An empty ID leaves the
+// network of the series unknown.
+func withNetwork(
+	ctx context.Context,
+	networks domain.NetworkRepository,
+	series domain.Series,
+	ID string,
+) (domain.Series, error) {
+	if ID == "" {
+		return series, nil
+	}
+	network, err := networks.FindByID(ctx, domain.NetworkID(ID))
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return series.WithNetwork(network.ID(), network.Name()), nil
+}
+
+// withCreators returns a copy of the series created by the people with
+// the given IDs, or domain.ErrPersonNotFound.
+func withCreators(
+	ctx context.Context,
+	people domain.PersonRepository,
+	series domain.Series,
+	IDs []string,
+) (domain.Series, error) {
+	creators := make([]domain.Person, len(IDs))
+	for i, ID := range IDs {
+		person, err := people.FindByID(ctx, domain.PersonID(ID))
+		if err != nil {
+			return domain.Series{}, err
+		}
+		creators[i] = person
+	}
+	return series.WithCreators(creators), nil
+}
+
+// uniqueSlug returns the slug of the title and begin year of the series,
+// numbered from 2 when another series has or had it. A slug the series
+// had itself is given back to it.
+func uniqueSlug(
+	ctx context.Context,
+	repo domain.SeriesRepository,
+	series domain.Series,
+) (string, error) {
+	base := domain.NewSlug(series.Title(), series.BeginYear())
+	slug := base
+	for number := 2; ; number++ {
+		found, err := repo.FindBySlug(ctx, slug)
+		if errors.Is(err, domain.ErrSeriesNotFound) {
+			return slug, nil
+		} else if err != nil {
+			return "", err
+		}
+		if found.ID() == series.ID() {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, number)
+	}
+}
@@ -14,6 +14,26 @@ type mockCreateSeriesRepository struct {
 	domain.SeriesRepository
 	result domain.Series
 	err    error
+	// slugs are the slugs already taken, by the series that took them.
+	slugs map[string]domain.Series
+}
+
+func (r mockCreateSeriesRepository) FindBySlug(
+	_ context.Context,
+	slug string,
+) (domain.Series, error) {
+	series, ok := r.slugs[slug]
+	if !ok {
+		return domain.Series{}, domain.ErrSeriesNotFound
+	}
+	return series, nil
+}
+
+func (r mockCreateSeriesRepository) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
 }
 
 func (r mockCreateSeriesRepository) Create(
@@ -23,6 +43,50 @@ func (r mockCreateSeriesRepository) Create(
 	return r.result, r.err
 }
 
+type mockSeriesNetworkRepo struct {
+	domain.NetworkRepository
+	err error
+}
+
+func (r mockSeriesNetworkRepo) FindByID(
+	_ context.Context,
+	ID domain.NetworkID,
+) (domain.Network, error) {
+	if r.err != nil {
+		return domain.Network{}, r.err
+	}
+	return domain.NewNetwork(ID, "HBO", "US"), nil
+}
+
+type mockSeriesPersonRepo struct {
+	domain.PersonRepository
+	err error
+}
+
+func (r mockSeriesPersonRepo) FindByID(
+	_ context.Context,
+	ID domain.PersonID,
+) (domain.Person, error) {
+	if r.err != nil {
+		return domain.Person{}, r.err
+	}
+	return domain.NewPerson(ID, "Vince Gilligan"), nil
+}
+
+type mockCreateSeriesEventRepo struct {
+	domain.EventRepository
+	created *[]domain.Event
+	err     error
+}
+
+func (r mockCreateSeriesEventRepo) Create(
+	_ context.Context,
+	event domain.Event,
+) (domain.Event, error) {
+	*r.created = append(*r.created, event)
+	return event, r.err
+}
+
 type mockCreateSeriesPresenter struct {
 	result CreateSeriesOutput
 }
@@ -38,18 +102,15 @@ func TestCreateSeriesInteractor(t *testing.T) {
 		domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
 		"Title",
 		"Description",
-		20,
 		1980,
 		1990,
-		"Creator",
 	)
 	testInput := CreateSeriesInput{
 		Title:       "",
 		Description: "",
-		Episodes:    0,
 		BeginYear:   0,
 		EndYear:     0,
-		Creator:     "",
+		CreatorIDs:  []string{"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c"},
 	}
 	testOutput := CreateSeriesOutput{
 		ID:          testSeries.ID().String(),
@@ -65,10 +126,15 @@ func TestCreateSeriesInteractor(t *testing.T) {
 	type Test struct {
 		Description string
 		Repo        domain.SeriesRepository
+		NetworkErr  error
+		PersonErr   error
+		EventErr    error
 		Presenter   CreateSeriesPresenter
 		Input       CreateSeriesInput
 		Expected    CreateSeriesOutput
 		ExpectedErr any
+		// ExpectedEvents is the number of events added to the outbox.
+		ExpectedEvents int
 	}
 	tests := []Test{
 		{
@@ -80,9 +146,64 @@ func TestCreateSeriesInteractor(t *testing.T) {
 			Presenter: mockCreateSeriesPresenter{
 				result: testOutput,
 			},
+			Input:          testInput,
+			Expected:       testOutput,
+			ExpectedErr:    nil,
+			ExpectedEvents: 1,
+		},
+		{
+			Description: "Failing to add the event fails the creation",
+			Repo: mockCreateSeriesRepository{
+				result: testSeries,
+			},
+			EventErr: testErr,
+			Presenter: mockCreateSeriesPresenter{
+				result: testOutput,
+			},
+			Input:          testInput,
+			Expected:       testOutput,
+			ExpectedErr:    testErr,
+			ExpectedEvents: 1,
+		},
+		{
+			Description: "Finished status without end year",
+			Repo: mockCreateSeriesRepository{
+				result: testSeries,
+			},
+			Presenter: mockCreateSeriesPresenter{
+				result: CreateSeriesOutput{},
+			},
+			Input:       CreateSeriesInput{Status: "ended"},
+			Expected:    CreateSeriesOutput{},
+			ExpectedErr: domain.SeriesEnded.CheckEndYear(0),
+		},
+		{
+			Description: "Network not found",
+			Repo: mockCreateSeriesRepository{
+				result: testSeries,
+			},
+			NetworkErr: domain.ErrNetworkNotFound,
+			Presenter: mockCreateSeriesPresenter{
+				result: CreateSeriesOutput{},
+			},
+			Input: CreateSeriesInput{
+				NetworkID: "8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11",
+			},
+			Expected:    CreateSeriesOutput{},
+			ExpectedErr: domain.ErrNetworkNotFound,
+		},
+		{
+			Description: "Creator not found",
+			Repo: mockCreateSeriesRepository{
+				result: testSeries,
+			},
+			PersonErr: domain.ErrPersonNotFound,
+			Presenter: mockCreateSeriesPresenter{
+				result: CreateSeriesOutput{},
+			},
 			Input:       testInput,
-			Expected:    testOutput,
-			ExpectedErr: nil,
+			Expected:    CreateSeriesOutput{},
+			ExpectedErr: domain.ErrPersonNotFound,
 		},
 		{
 			Description: "Some error",
@@ -102,14 +223,79 @@ func TestCreateSeriesInteractor(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Description, func(t *testing.T) {
 			assert := assert.New(t)
+			created := []domain.Event{}
 			uc := NewCreateSeriesInteractor(
 				test.Repo,
+				mockSeriesNetworkRepo{err: test.NetworkErr},
+				mockSeriesPersonRepo{err: test.PersonErr},
+				mockCreateSeriesEventRepo{created: &created, err: test.EventErr},
 				test.Presenter,
 				1*time.Second,
 			)
 			output, err := uc.Execute(context.TODO(), test.Input)
 			assert.Equal(test.ExpectedErr, err)
 			assert.Equal(test.Expected, output)
+			assert.Len(created, test.ExpectedEvents)
+			for _, event := range created {
+				assert.Equal(domain.EventSeriesCreated, event.Type())
+				assert.Equal(testSeries.ID().String(), event.AggregateID())
+			}
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	t.Parallel()
+
+	series := domain.NewSeries(
+		domain.SeriesID("1be9775b-8d32-4710-9ce6-7ece88e30f01"),
+		"Breaking Bad",
+		"Description",
+		2008,
+		2013,
+	)
+	other := domain.NewSeries(
+		domain.SeriesID("8a1c3c1e-5f7a-4a9e-9f0d-3f1b2f6f2d11"),
+		"Breaking Bad",
+		"Description",
+		2008,
+		0,
+	)
+
+	type Test struct {
+		Description string
+		Slugs       map[string]domain.Series
+		Expected    string
+	}
+	tests := []Test{
+		{
+			Description: "Free slug",
+			Expected:    "breaking-bad-2008",
+		},
+		{
+			Description: "Taken slug is numbered",
+			Slugs: map[string]domain.Series{
+				"breaking-bad-2008":   other,
+				"breaking-bad-2008-2": other,
+			},
+			Expected: "breaking-bad-2008-3",
+		},
+		{
+			Description: "Own slug is kept",
+			Slugs: map[string]domain.Series{
+				"breaking-bad-2008": series,
+			},
+			Expected: "breaking-bad-2008",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			repo := mockCreateSeriesRepository{slugs: test.Slugs}
+			slug, err := uniqueSlug(context.TODO(), repo, series)
+			assert.Nil(err)
+			assert.Equal(test.Expected, slug)
 		})
 	}
 }
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	CreateWebhookUseCase interface {
+		Execute(context.Context, CreateWebhookInput) (CreateWebhookOutput, error)
+	}
+
+	CreateWebhookInput struct {
+		URL    string   `json:"url"              xml:"url"              validate:"required,http_url,max=2000"`
+		Events []string `json:"events"           xml:"events>event"     validate:"required,min=1,dive,oneof=series.created review.created"`
+		Secret string   `json:"secret,omitempty" xml:"secret,omitempty" validate:"omitempty,min=16,max=100"`
+	}
+
+	// CreateWebhookOutput is the only output the secret is part of,
+	// subscribers have to keep it to check the signature of payloads.
+	CreateWebhookOutput struct {
+		ID        string   `json:"id"         xml:"id"`
+		URL       string   `json:"url"        xml:"url"`
+		Events    []string `json:"events"     xml:"events>event"`
+		Secret    string   `json:"secret"     xml:"secret"`
+		CreatedAt string   `json:"created_at" xml:"created_at"`
+		UpdatedAt string   `json:"updated_at" xml:"updated_at"`
+	}
+
+	CreateWebhookPresenter interface {
+		Output(domain.Webhook) CreateWebhookOutput
+	}
+
+	createWebhookInteractor struct {
+		repo      domain.WebhookRepository
+		presenter CreateWebhookPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewCreateWebhookInteractor(
+	repo domain.WebhookRepository,
+	presenter CreateWebhookPresenter,
+	timeout time.Duration,
+) CreateWebhookUseCase {
+	return createWebhookInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i createWebhookInteractor) Execute(
+	ctx context.Context, input CreateWebhookInput,
+) (CreateWebhookOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.CreateWebhook")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	secret := input.Secret
+	if secret == "" {
+		secret = domain.NewWebhookSecret()
+	}
+
+	webhook := domain.NewWebhook(
+		domain.WebhookID(domain.NewUUID()),
+		input.URL,
+		secret,
+		eventTypes(input.Events),
+	)
+
+	webhook, err := i.repo.Create(ctx, webhook)
+	if err != nil {
+		return i.presenter.Output(domain.Webhook{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"webhook_id": webhook.ID().String(),
+			"url":        webhook.URL(),
+		}).
+		Infof("Webhook created")
+
+	return i.presenter.Output(webhook), nil
+}
+
+func eventTypes(events []string) []domain.EventType {
+	types := make([]domain.EventType, len(events))
+	for i, event := range events {
+		types[i] = domain.EventType(event)
+	}
+	return types
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateWebhookRepo struct {
+	domain.WebhookRepository
+	created *domain.Webhook
+	err     error
+}
+
+func (r mockCreateWebhookRepo) Create(
+	_ context.Context,
+	webhook domain.Webhook,
+) (domain.Webhook, error) {
+	*r.created = webhook
+	return webhook, r.err
+}
+
+type mockCreateWebhookPresenter struct{}
+
+func (p mockCreateWebhookPresenter) Output(webhook domain.Webhook) CreateWebhookOutput {
+	return CreateWebhookOutput{
+		ID:     webhook.ID().String(),
+		URL:    webhook.URL(),
+		Secret: webhook.Secret(),
+	}
+}
+
+func TestCreateWebhookInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Input       CreateWebhookInput
+		Err         error
+		ExpectedErr error
+		// ExpectedSecret is the stored secret, any when empty.
+		ExpectedSecret string
+	}
+	tests := []Test{
+		{
+			Description: "Successful creation with a given secret",
+			Input: CreateWebhookInput{
+				URL:    "http://localhost/hook",
+				Events: []string{"series.created"},
+				Secret: "0123456789abcdef",
+			},
+			ExpectedSecret: "0123456789abcdef",
+		},
+		{
+			Description: "Secret is generated when missing",
+			Input: CreateWebhookInput{
+				URL:    "http://localhost/hook",
+				Events: []string{"series.created", "review.created"},
+			},
+		},
+		{
+			Description: "Some error",
+			Input: CreateWebhookInput{
+				URL:    "http://localhost/hook",
+				Events: []string{"series.created"},
+			},
+			Err:         testErr,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var created domain.Webhook
+			uc := NewCreateWebhookInteractor(
+				mockCreateWebhookRepo{created: &created, err: test.Err},
+				mockCreateWebhookPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			if err != nil {
+				assert.Equal(CreateWebhookOutput{}, output)
+				return
+			}
+
+			assert.Equal(test.Input.URL, created.URL())
+			assert.Equal(eventTypes(test.Input.Events), created.Events())
+			assert.NotEmpty(created.Secret())
+			if test.ExpectedSecret != "" {
+				assert.Equal(test.ExpectedSecret, created.Secret())
+			}
+			assert.Equal(created.Secret(), output.Secret)
+		})
+	}
+}
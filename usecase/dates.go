@@ -0,0 +1,15 @@
+package usecase
+
+import "time"
+
+// DateLayout is how dates, like air dates, are written in inputs and outputs.
+const DateLayout = "2006-01-02"
+
+// parseDate parses a date written as DateLayout, "" is the zero time
+// which stands for a date that isn't known.
+func parseDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(DateLayout, date)
+}
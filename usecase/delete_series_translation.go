@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	// DeleteSeriesTranslationUseCase removes the translation of a series
+	// in a language, its title and description fall back to the default
+	// ones in that language.
+	DeleteSeriesTranslationUseCase interface {
+		Execute(context.Context, domain.SeriesID, string) error
+	}
+
+	deleteSeriesTranslationInteractor struct {
+		repo    domain.SeriesRepository
+		timeout time.Duration
+	}
+)
+
+func NewDeleteSeriesTranslationInteractor(
+	repo domain.SeriesRepository,
+	timeout time.Duration,
+) DeleteSeriesTranslationUseCase {
+	return deleteSeriesTranslationInteractor{
+		repo:    repo,
+		timeout: timeout,
+	}
+}
+
+func (i deleteSeriesTranslationInteractor) Execute(
+	ctx context.Context,
+	ID domain.SeriesID,
+	language string,
+) error {
+	ctx, span := tracer.Start(ctx, "usecase.DeleteSeriesTranslation")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	if err := i.repo.DeleteTranslation(ctx, ID, language); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": ID.String(),
+			"language":  language,
+		}).
+		Infof("Series translation deleted")
+	return nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeleteSeriesTranslationRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockDeleteSeriesTranslationRepo) DeleteTranslation(
+	context.Context,
+	domain.SeriesID,
+	string,
+) error {
+	return r.err
+}
+
+func TestDeleteSeriesTranslationInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Repo        domain.SeriesRepository
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful deletion",
+			Repo:        mockDeleteSeriesTranslationRepo{},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Deleting translation that does not exist",
+			Repo:        mockDeleteSeriesTranslationRepo{err: domain.ErrTranslationNotFound},
+			ExpectedErr: domain.ErrTranslationNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewDeleteSeriesTranslationInteractor(test.Repo, 1*time.Second)
+			assert.Equal(test.ExpectedErr, uc.Execute(context.TODO(), "SeriesID", "fr"))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	// DeleteWebhookUseCase removes a webhook along with its
+	// deliveries, pending ones are not attempted anymore.
+	DeleteWebhookUseCase interface {
+		Execute(context.Context, domain.WebhookID) error
+	}
+
+	deleteWebhookInteractor struct {
+		repo    domain.WebhookRepository
+		timeout time.Duration
+	}
+)
+
+func NewDeleteWebhookInteractor(
+	repo domain.WebhookRepository,
+	timeout time.Duration,
+) DeleteWebhookUseCase {
+	return deleteWebhookInteractor{
+		repo:    repo,
+		timeout: timeout,
+	}
+}
+
+func (i deleteWebhookInteractor) Execute(
+	ctx context.Context,
+	ID domain.WebhookID,
+) error {
+	ctx, span := tracer.Start(ctx, "usecase.DeleteWebhook")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	if err := i.repo.Delete(ctx, ID); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{"webhook_id": ID.String()}).
+		Infof("Webhook deleted")
+	return nil
+}
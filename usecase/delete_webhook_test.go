@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeleteWebhookRepo struct {
+	domain.WebhookRepository
+	err error
+}
+
+func (r mockDeleteWebhookRepo) Delete(context.Context, domain.WebhookID) error {
+	return r.err
+}
+
+func TestDeleteWebhookInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Repo        domain.WebhookRepository
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful deletion",
+			Repo:        mockDeleteWebhookRepo{},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Deleting webhook that does not exist",
+			Repo:        mockDeleteWebhookRepo{err: domain.ErrWebhookNotFound},
+			ExpectedErr: domain.ErrWebhookNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewDeleteWebhookInteractor(test.Repo, 1*time.Second)
+			assert.Equal(test.ExpectedErr, uc.Execute(context.TODO(), "ID"))
+		})
+	}
+}
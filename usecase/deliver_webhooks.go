@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+const (
+	// deliveryBatchSize is how many deliveries are claimed at once.
+	deliveryBatchSize = 10
+	// deliveryLease has to outlast the attempts of a whole batch, a
+	// worker that dies mid batch leaves its deliveries to the others
+	// once it expires.
+	deliveryLease = 5 * time.Minute
+)
+
+type (
+	// DeliverWebhooksUseCase makes one attempt at a batch of due
+	// deliveries. It is meant to be run in a loop by background workers.
+	DeliverWebhooksUseCase interface {
+		Execute(context.Context) (DeliverWebhooksOutput, error)
+	}
+
+	// DeliverWebhooksOutput counts the outcomes of the attempts.
+	DeliverWebhooksOutput struct {
+		Succeeded int
+		Retried   int
+		Dead      int
+	}
+
+	// RetryPolicy spaces out the attempts of a failing delivery: the n-th
+	// retry waits BaseDelay * 2^(n-1), up to MaxDelay. A delivery is dead
+	// once MaxAttempts attempts failed.
+	RetryPolicy struct {
+		MaxAttempts int
+		BaseDelay   time.Duration
+		MaxDelay    time.Duration
+	}
+
+	deliverWebhooksInteractor struct {
+		webhooks   domain.WebhookRepository
+		deliveries domain.DeliveryRepository
+		sender     domain.WebhookSender
+		policy     RetryPolicy
+		timeout    time.Duration
+	}
+)
+
+// Delay is how long to wait before the next attempt of
+// a delivery that failed the given number of attempts.
+func (p RetryPolicy) Delay(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+func (o DeliverWebhooksOutput) Attempted() int {
+	return o.Succeeded + o.Retried + o.Dead
+}
+
+// NewDeliverWebhooksInteractor returns a use case that bounds every
+// database operation with timeout, the sender is expected to bound
+// its requests itself.
+func NewDeliverWebhooksInteractor(
+	webhooks domain.WebhookRepository,
+	deliveries domain.DeliveryRepository,
+	sender domain.WebhookSender,
+	policy RetryPolicy,
+	timeout time.Duration,
+) DeliverWebhooksUseCase {
+	return deliverWebhooksInteractor{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		sender:     sender,
+		policy:     policy,
+		timeout:    timeout,
+	}
+}
+
+func (i deliverWebhooksInteractor) Execute(
+	ctx context.Context,
+) (DeliverWebhooksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.DeliverWebhooks")
+	defer span.End()
+
+	var output DeliverWebhooksOutput
+
+	claimCtx, cancel := context.WithTimeout(ctx, i.timeout)
+	deliveries, err := i.deliveries.Claim(claimCtx, deliveryBatchSize, deliveryLease)
+	cancel()
+	if err != nil {
+		return output, err
+	}
+
+	for _, delivery := range deliveries {
+		delivery, err = i.attempt(ctx, delivery)
+		if err != nil {
+			return output, err
+		}
+
+		updateCtx, cancel := context.WithTimeout(ctx, i.timeout)
+		_, err = i.deliveries.Update(updateCtx, delivery)
+		cancel()
+		if err != nil {
+			return output, err
+		}
+
+		log := logger.FromContext(ctx).WithFields(logger.Fields{
+			"delivery_id": delivery.ID().String(),
+			"webhook_id":  delivery.WebhookID().String(),
+			"event":       delivery.Event().String(),
+			"attempts":    delivery.Attempts(),
+			"status_code": delivery.LastStatusCode(),
+		})
+		switch delivery.Status() {
+		case domain.DeliverySucceeded:
+			output.Succeeded++
+			log.Debugf("Webhook delivered")
+		case domain.DeliveryDead:
+			output.Dead++
+			log.WithFields(logger.Fields{"error": delivery.LastError()}).
+				Warnf("Gave up on webhook delivery")
+		default:
+			output.Retried++
+			log.WithFields(logger.Fields{
+				"error":           delivery.LastError(),
+				"next_attempt_at": delivery.NextAttemptAt(),
+			}).Infof("Webhook delivery failed, retrying")
+		}
+	}
+	return output, nil
+}
+
+// attempt sends the delivery and records the outcome. Errors looking the
+// webhook up are returned as is, the delivery is then attempted again once
+// its lease expires.
+func (i deliverWebhooksInteractor) attempt(
+	ctx context.Context,
+	delivery domain.Delivery,
+) (domain.Delivery, error) {
+	findCtx, cancel := context.WithTimeout(ctx, i.timeout)
+	webhook, err := i.webhooks.FindByID(findCtx, delivery.WebhookID())
+	cancel()
+	if errors.Is(err, domain.ErrWebhookNotFound) {
+		return delivery.Failed(0, err.Error(), time.Time{}), nil
+	} else if err != nil {
+		return domain.Delivery{}, err
+	}
+
+	statusCode, err := i.sender.Send(ctx, webhook, delivery)
+	if err == nil {
+		return delivery.Succeeded(statusCode), nil
+	}
+
+	attempts := delivery.Attempts() + 1
+	var retryAt time.Time
+	if attempts < i.policy.MaxAttempts {
+		retryAt = time.Now().Add(i.policy.Delay(attempts))
+	}
+	return delivery.Failed(statusCode, err.Error(), retryAt), nil
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeliverWebhooksWebhookRepo struct {
+	domain.WebhookRepository
+	err error
+}
+
+func (r mockDeliverWebhooksWebhookRepo) FindByID(
+	_ context.Context,
+	ID domain.WebhookID,
+) (domain.Webhook, error) {
+	return domain.NewWebhook(ID, "http://localhost/hook", "secret", nil), r.err
+}
+
+type mockDeliverWebhooksDeliveryRepo struct {
+	domain.DeliveryRepository
+	claimed []domain.Delivery
+	updated *[]domain.Delivery
+}
+
+func (r mockDeliverWebhooksDeliveryRepo) Claim(
+	_ context.Context,
+	_ int,
+	_ time.Duration,
+) ([]domain.Delivery, error) {
+	return r.claimed, nil
+}
+
+func (r mockDeliverWebhooksDeliveryRepo) Update(
+	_ context.Context,
+	delivery domain.Delivery,
+) (domain.Delivery, error) {
+	*r.updated = append(*r.updated, delivery)
+	return delivery, nil
+}
+
+type mockWebhookSender struct {
+	statusCode int
+	err        error
+}
+
+func (s mockWebhookSender) Send(
+	_ context.Context,
+	_ domain.Webhook,
+	_ domain.Delivery,
+) (int, error) {
+	return s.statusCode, s.err
+}
+
+func TestDeliverWebhooksInteractor(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Minute,
+		MaxDelay:    time.Hour,
+	}
+	testDelivery := domain.NewDelivery(
+		"ID",
+		"WebhookID",
+		domain.NewEvent("EventID", domain.EventSeriesCreated, "SeriesID", []byte(`{}`)),
+	)
+	testErr := errors.New("unexpected status 500")
+
+	type Test struct {
+		Description string
+		Delivery    domain.Delivery
+		WebhookErr  error
+		Sender      domain.WebhookSender
+		Expected    DeliverWebhooksOutput
+		ExpectedErr error
+		// ExpectedStatus and ExpectedAttempts describe the updated delivery.
+		ExpectedStatus   domain.DeliveryStatus
+		ExpectedAttempts int
+		// ExpectedDelay is how long until the next attempt, if any.
+		ExpectedDelay time.Duration
+	}
+	tests := []Test{
+		{
+			Description:      "Successful delivery",
+			Delivery:         testDelivery,
+			Sender:           mockWebhookSender{statusCode: 200},
+			Expected:         DeliverWebhooksOutput{Succeeded: 1},
+			ExpectedStatus:   domain.DeliverySucceeded,
+			ExpectedAttempts: 1,
+		},
+		{
+			Description:      "First failure is retried after the base delay",
+			Delivery:         testDelivery,
+			Sender:           mockWebhookSender{statusCode: 500, err: testErr},
+			Expected:         DeliverWebhooksOutput{Retried: 1},
+			ExpectedStatus:   domain.DeliveryPending,
+			ExpectedAttempts: 1,
+			ExpectedDelay:    time.Minute,
+		},
+		{
+			Description: "Delay doubles with every failure",
+			Delivery: testDelivery.WithState(
+				domain.DeliveryPending, 1, time.Time{}, 500, "",
+			),
+			Sender:           mockWebhookSender{statusCode: 500, err: testErr},
+			Expected:         DeliverWebhooksOutput{Retried: 1},
+			ExpectedStatus:   domain.DeliveryPending,
+			ExpectedAttempts: 2,
+			ExpectedDelay:    2 * time.Minute,
+		},
+		{
+			Description: "Last failed attempt kills the delivery",
+			Delivery: testDelivery.WithState(
+				domain.DeliveryPending, 2, time.Time{}, 500, "",
+			),
+			Sender:           mockWebhookSender{statusCode: 500, err: testErr},
+			Expected:         DeliverWebhooksOutput{Dead: 1},
+			ExpectedStatus:   domain.DeliveryDead,
+			ExpectedAttempts: 3,
+		},
+		{
+			Description:      "Delivery of deleted webhook is dead",
+			Delivery:         testDelivery,
+			WebhookErr:       domain.ErrWebhookNotFound,
+			Sender:           mockWebhookSender{statusCode: 200},
+			Expected:         DeliverWebhooksOutput{Dead: 1},
+			ExpectedStatus:   domain.DeliveryDead,
+			ExpectedAttempts: 1,
+		},
+		{
+			Description: "Failing to find the webhook leaves the delivery alone",
+			Delivery:    testDelivery,
+			WebhookErr:  testErr,
+			Sender:      mockWebhookSender{statusCode: 200},
+			Expected:    DeliverWebhooksOutput{},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			updated := []domain.Delivery{}
+			uc := NewDeliverWebhooksInteractor(
+				mockDeliverWebhooksWebhookRepo{err: test.WebhookErr},
+				mockDeliverWebhooksDeliveryRepo{
+					claimed: []domain.Delivery{test.Delivery},
+					updated: &updated,
+				},
+				test.Sender,
+				policy,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO())
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			if test.ExpectedErr != nil {
+				assert.Empty(updated)
+				return
+			}
+
+			assert.Len(updated, 1)
+			assert.Equal(test.ExpectedStatus, updated[0].Status())
+			assert.Equal(test.ExpectedAttempts, updated[0].Attempts())
+			if test.ExpectedDelay == 0 {
+				assert.True(updated[0].NextAttemptAt().IsZero())
+			} else {
+				assert.WithinDuration(
+					time.Now().Add(test.ExpectedDelay),
+					updated[0].NextAttemptAt(),
+					time.Second,
+				)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelay:   30 * time.Second,
+		MaxDelay:    5 * time.Minute,
+	}
+	assert.Equal(30*time.Second, policy.Delay(1))
+	assert.Equal(1*time.Minute, policy.Delay(2))
+	assert.Equal(4*time.Minute, policy.Delay(4))
+	assert.Equal(5*time.Minute, policy.Delay(5))
+	assert.Equal(5*time.Minute, policy.Delay(100))
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"series/domain"
+)
+
+// createEvent adds an event to the outbox, with the JSON encoding of data
+// as payload. It is meant to be called in the transaction that stores the
+// change, so that no event is lost or published for a rolled back change.
+func createEvent(
+	ctx context.Context,
+	events domain.EventRepository,
+	eventType domain.EventType,
+	aggregateID string,
+	data any,
+) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = events.Create(ctx, domain.NewEvent(
+		domain.EventID(domain.NewUUID()),
+		eventType,
+		aggregateID,
+		payload,
+	))
+	return err
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import "series/domain"
+
+// ExternalIDsOutput has the IDs of a series in other catalogs,
+// empty for the ones it has none in.
+type ExternalIDsOutput struct {
+	IMDb string `json:"imdb,omitempty" xml:"imdb,omitempty"`
+	TVDB string `json:"tvdb,omitempty" xml:"tvdb,omitempty"`
+	TMDB string `json:"tmdb,omitempty" xml:"tmdb,omitempty"`
+}
+
+// newExternalIDs lists the external IDs that are set, in the
+// order of their providers as the repositories return them.
+func newExternalIDs(imdb, tvdb, tmdb string) []domain.ExternalID {
+	externalIDs := []domain.ExternalID{}
+	for _, externalID := range []domain.ExternalID{
+		domain.NewExternalID(domain.ProviderIMDb, imdb),
+		domain.NewExternalID(domain.ProviderTMDB, tmdb),
+		domain.NewExternalID(domain.ProviderTVDB, tvdb),
+	} {
+		if externalID.ID() != "" {
+			externalIDs = append(externalIDs, externalID)
+		}
+	}
+	return externalIDs
+}
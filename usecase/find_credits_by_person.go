@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindCreditsByPersonUseCase interface {
+		Execute(context.Context, domain.PersonID) (FindCreditsByPersonOutput, error)
+	}
+
+	FindCreditsByPersonCredit struct {
+		ID        string `json:"id"                  xml:"id"`
+		SeriesID  string `json:"series_id"           xml:"series_id"`
+		Title     string `json:"title"               xml:"title"`
+		Role      string `json:"role"                xml:"role"`
+		Character string `json:"character,omitempty" xml:"character,omitempty"`
+		Episodes  int    `json:"episodes"            xml:"episodes"`
+	}
+
+	// FindCreditsByPersonOutput is the filmography of a person.
+	FindCreditsByPersonOutput struct {
+		ID      string                      `json:"id"      xml:"id"`
+		Name    string                      `json:"name"    xml:"name"`
+		Credits []FindCreditsByPersonCredit `json:"credits" xml:"credits>credit"`
+	}
+
+	FindCreditsByPersonPresenter interface {
+		Output(domain.Person, []domain.Credit) FindCreditsByPersonOutput
+	}
+
+	findCreditsByPersonInteractor struct {
+		people    domain.PersonRepository
+		credits   domain.CreditRepository
+		presenter FindCreditsByPersonPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindCreditsByPersonInteractor(
+	people domain.PersonRepository,
+	credits domain.CreditRepository,
+	presenter FindCreditsByPersonPresenter,
+	timeout time.Duration,
+) FindCreditsByPersonUseCase {
+	return findCreditsByPersonInteractor{
+		people:    people,
+		credits:   credits,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findCreditsByPersonInteractor) Execute(
+	ctx context.Context, personID domain.PersonID,
+) (FindCreditsByPersonOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindCreditsByPerson")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	person, err := i.people.FindByID(ctx, personID)
+	if err != nil {
+		return i.presenter.Output(domain.Person{}, nil), err
+	}
+	credits, err := i.credits.FindByPerson(ctx, personID)
+	if err != nil {
+		return i.presenter.Output(domain.Person{}, nil), err
+	}
+	return i.presenter.Output(person, credits), nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindCreditsByPersonPersonRepo struct {
+	domain.PersonRepository
+	err error
+}
+
+func (r mockFindCreditsByPersonPersonRepo) FindByID(
+	_ context.Context,
+	ID domain.PersonID,
+) (domain.Person, error) {
+	if r.err != nil {
+		return domain.Person{}, r.err
+	}
+	return domain.NewPerson(ID, "Bryan Cranston"), nil
+}
+
+type mockFindCreditsByPersonCreditRepo struct {
+	domain.CreditRepository
+	credits []domain.Credit
+}
+
+func (r mockFindCreditsByPersonCreditRepo) FindByPerson(
+	_ context.Context,
+	_ domain.PersonID,
+) ([]domain.Credit, error) {
+	return r.credits, nil
+}
+
+type mockFindCreditsByPersonPresenter struct{}
+
+func (mockFindCreditsByPersonPresenter) Output(
+	person domain.Person,
+	credits []domain.Credit,
+) FindCreditsByPersonOutput {
+	output := FindCreditsByPersonOutput{
+		ID:      person.ID().String(),
+		Name:    person.Name(),
+		Credits: make([]FindCreditsByPersonCredit, len(credits)),
+	}
+	for i, credit := range credits {
+		output.Credits[i] = FindCreditsByPersonCredit{
+			ID:    credit.ID().String(),
+			Title: credit.SeriesTitle(),
+			Role:  credit.Role().String(),
+		}
+	}
+	return output
+}
+
+func TestFindCreditsByPersonInteractor(t *testing.T) {
+	t.Parallel()
+
+	credits := []domain.Credit{
+		domain.NewCredit(
+			"ID1",
+			"SeriesID1",
+			"PersonID",
+			domain.CreditActor,
+			"Walter White",
+			62,
+		).WithNames("Bryan Cranston", "Breaking Bad"),
+		domain.NewCredit(
+			"ID2",
+			"SeriesID2",
+			"PersonID",
+			domain.CreditActor,
+			"Hal",
+			151,
+		).WithNames("Bryan Cranston", "Malcolm in the Middle"),
+	}
+
+	type Test struct {
+		Description string
+		Person      domain.PersonRepository
+		Expected    FindCreditsByPersonOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of the filmography",
+			Person:      mockFindCreditsByPersonPersonRepo{},
+			Expected: FindCreditsByPersonOutput{
+				ID:   "PersonID",
+				Name: "Bryan Cranston",
+				Credits: []FindCreditsByPersonCredit{
+					{ID: "ID1", Title: "Breaking Bad", Role: "actor"},
+					{ID: "ID2", Title: "Malcolm in the Middle", Role: "actor"},
+				},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Searching credits for person that does not exist",
+			Person: mockFindCreditsByPersonPersonRepo{
+				err: domain.ErrPersonNotFound,
+			},
+			Expected: FindCreditsByPersonOutput{
+				Credits: []FindCreditsByPersonCredit{},
+			},
+			ExpectedErr: domain.ErrPersonNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindCreditsByPersonInteractor(
+				test.Person,
+				mockFindCreditsByPersonCreditRepo{credits: credits},
+				mockFindCreditsByPersonPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), "PersonID")
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindCreditsBySeriesUseCase interface {
+		Execute(context.Context, domain.SeriesID) (FindCreditsBySeriesOutput, error)
+	}
+
+	FindCreditsBySeriesCredit struct {
+		ID        string `json:"id"                  xml:"id"`
+		PersonID  string `json:"person_id"           xml:"person_id"`
+		Name      string `json:"name"                xml:"name"`
+		Role      string `json:"role"                xml:"role"`
+		Character string `json:"character,omitempty" xml:"character,omitempty"`
+		Episodes  int    `json:"episodes"            xml:"episodes"`
+	}
+
+	// FindCreditsBySeriesOutput is the cast and crew of a series.
+	FindCreditsBySeriesOutput struct {
+		Credits []FindCreditsBySeriesCredit `json:"credits" xml:"credits>credit"`
+	}
+
+	FindCreditsBySeriesPresenter interface {
+		Output([]domain.Credit) FindCreditsBySeriesOutput
+	}
+
+	findCreditsBySeriesInteractor struct {
+		series    domain.SeriesRepository
+		credits   domain.CreditRepository
+		presenter FindCreditsBySeriesPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindCreditsBySeriesInteractor(
+	series domain.SeriesRepository,
+	credits domain.CreditRepository,
+	presenter FindCreditsBySeriesPresenter,
+	timeout time.Duration,
+) FindCreditsBySeriesUseCase {
+	return findCreditsBySeriesInteractor{
+		series:    series,
+		credits:   credits,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findCreditsBySeriesInteractor) Execute(
+	ctx context.Context, seriesID domain.SeriesID,
+) (FindCreditsBySeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindCreditsBySeries")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var credits []domain.Credit
+	err := i.series.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.series.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+		credits, err = i.credits.FindBySeries(ctx, seriesID)
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(credits), nil
+}
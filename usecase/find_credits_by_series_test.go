@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindCreditsBySeriesSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockFindCreditsBySeriesSeriesRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockFindCreditsBySeriesSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockFindCreditsBySeriesCreditRepo struct {
+	domain.CreditRepository
+	credits []domain.Credit
+	err     error
+}
+
+func (r mockFindCreditsBySeriesCreditRepo) FindBySeries(
+	_ context.Context,
+	_ domain.SeriesID,
+) ([]domain.Credit, error) {
+	return r.credits, r.err
+}
+
+type mockFindCreditsBySeriesPresenter struct{}
+
+func (mockFindCreditsBySeriesPresenter) Output(
+	credits []domain.Credit,
+) FindCreditsBySeriesOutput {
+	output := FindCreditsBySeriesOutput{
+		Credits: make([]FindCreditsBySeriesCredit, len(credits)),
+	}
+	for i, credit := range credits {
+		output.Credits[i] = FindCreditsBySeriesCredit{
+			ID:   credit.ID().String(),
+			Name: credit.PersonName(),
+			Role: credit.Role().String(),
+		}
+	}
+	return output
+}
+
+func TestFindCreditsBySeriesInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Series      domain.SeriesRepository
+		Credits     domain.CreditRepository
+		Expected    FindCreditsBySeriesOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of credits",
+			Series:      mockFindCreditsBySeriesSeriesRepo{},
+			Credits: mockFindCreditsBySeriesCreditRepo{
+				credits: []domain.Credit{
+					domain.NewCredit(
+						"ID",
+						"SeriesID",
+						"PersonID",
+						domain.CreditCreator,
+						"",
+						0,
+					).WithNames("Vince Gilligan", "Breaking Bad"),
+				},
+			},
+			Expected: FindCreditsBySeriesOutput{
+				Credits: []FindCreditsBySeriesCredit{
+					{ID: "ID", Name: "Vince Gilligan", Role: "creator"},
+				},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Searching credits for series that does not exist",
+			Series: mockFindCreditsBySeriesSeriesRepo{
+				err: domain.ErrSeriesNotFound,
+			},
+			Credits: mockFindCreditsBySeriesCreditRepo{},
+			Expected: FindCreditsBySeriesOutput{
+				Credits: []FindCreditsBySeriesCredit{},
+			},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+
+		{
+			Description: "Some error",
+			Series:      mockFindCreditsBySeriesSeriesRepo{},
+			Credits: mockFindCreditsBySeriesCreditRepo{
+				err: testErr,
+			},
+			Expected: FindCreditsBySeriesOutput{
+				Credits: []FindCreditsBySeriesCredit{},
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindCreditsBySeriesInteractor(
+				test.Series,
+				test.Credits,
+				mockFindCreditsBySeriesPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), "SeriesID")
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+// deliveryLogSize is how many of the last deliveries of a webhook are listed.
+const deliveryLogSize = 100
+
+type (
+	FindDeliveriesByWebhookUseCase interface {
+		Execute(context.Context, domain.WebhookID) (FindDeliveriesByWebhookOutput, error)
+	}
+
+	FindDeliveriesByWebhookDelivery struct {
+		ID             string `json:"id"                         xml:"id"`
+		Event          string `json:"event"                      xml:"event"`
+		Status         string `json:"status"                     xml:"status"`
+		Attempts       int    `json:"attempts"                   xml:"attempts"`
+		NextAttemptAt  string `json:"next_attempt_at,omitempty"  xml:"next_attempt_at,omitempty"`
+		LastStatusCode int    `json:"last_status_code,omitempty" xml:"last_status_code,omitempty"`
+		LastError      string `json:"last_error,omitempty"       xml:"last_error,omitempty"`
+		CreatedAt      string `json:"created_at"                 xml:"created_at"`
+		UpdatedAt      string `json:"updated_at"                 xml:"updated_at"`
+	}
+
+	FindDeliveriesByWebhookOutput struct {
+		Deliveries []FindDeliveriesByWebhookDelivery `json:"deliveries" xml:"deliveries>delivery"`
+	}
+
+	FindDeliveriesByWebhookPresenter interface {
+		Output([]domain.Delivery) FindDeliveriesByWebhookOutput
+	}
+
+	findDeliveriesByWebhookInteractor struct {
+		webhooks   domain.WebhookRepository
+		deliveries domain.DeliveryRepository
+		presenter  FindDeliveriesByWebhookPresenter
+		timeout    time.Duration
+	}
+)
+
+func NewFindDeliveriesByWebhookInteractor(
+	webhooks domain.WebhookRepository,
+	deliveries domain.DeliveryRepository,
+	presenter FindDeliveriesByWebhookPresenter,
+	timeout time.Duration,
+) FindDeliveriesByWebhookUseCase {
+	return findDeliveriesByWebhookInteractor{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		presenter:  presenter,
+		timeout:    timeout,
+	}
+}
+
+func (i findDeliveriesByWebhookInteractor) Execute(
+	ctx context.Context,
+	webhookID domain.WebhookID,
+) (FindDeliveriesByWebhookOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindDeliveriesByWebhook")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var err error
+	var deliveries []domain.Delivery
+
+	err = i.deliveries.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err = i.webhooks.FindByID(ctx, webhookID)
+		if err != nil {
+			return err
+		}
+
+		deliveries, err = i.deliveries.FindByWebhook(ctx, webhookID, deliveryLogSize)
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(deliveries), nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindDeliveriesByWebhookWebhookRepo struct {
+	domain.WebhookRepository
+	err error
+}
+
+func (r mockFindDeliveriesByWebhookWebhookRepo) FindByID(
+	_ context.Context,
+	_ domain.WebhookID,
+) (domain.Webhook, error) {
+	return domain.Webhook{}, r.err
+}
+
+type mockFindDeliveriesByWebhookDeliveryRepo struct {
+	domain.DeliveryRepository
+	deliveries []domain.Delivery
+	err        error
+}
+
+func (r mockFindDeliveriesByWebhookDeliveryRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockFindDeliveriesByWebhookDeliveryRepo) FindByWebhook(
+	_ context.Context,
+	_ domain.WebhookID,
+	_ int,
+) ([]domain.Delivery, error) {
+	return r.deliveries, r.err
+}
+
+type mockFindDeliveriesByWebhookPresenter struct {
+	output FindDeliveriesByWebhookOutput
+}
+
+func (p mockFindDeliveriesByWebhookPresenter) Output(
+	_ []domain.Delivery,
+) FindDeliveriesByWebhookOutput {
+	return p.output
+}
+
+func TestFindDeliveriesByWebhookInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Webhooks    domain.WebhookRepository
+		Deliveries  domain.DeliveryRepository
+		Presenter   FindDeliveriesByWebhookPresenter
+		Expected    FindDeliveriesByWebhookOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of deliveries",
+			Webhooks:    mockFindDeliveriesByWebhookWebhookRepo{},
+			Deliveries: mockFindDeliveriesByWebhookDeliveryRepo{
+				deliveries: []domain.Delivery{
+					domain.NewDelivery(
+						"ID",
+						"WebhookID",
+						domain.NewEvent("EventID", domain.EventSeriesCreated, "SeriesID", nil),
+					),
+				},
+			},
+			Presenter: mockFindDeliveriesByWebhookPresenter{
+				output: FindDeliveriesByWebhookOutput{
+					Deliveries: []FindDeliveriesByWebhookDelivery{{ID: "ID"}},
+				},
+			},
+			Expected: FindDeliveriesByWebhookOutput{
+				Deliveries: []FindDeliveriesByWebhookDelivery{{ID: "ID"}},
+			},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Finding deliveries of webhook that does not exist",
+			Webhooks: mockFindDeliveriesByWebhookWebhookRepo{
+				err: domain.ErrWebhookNotFound,
+			},
+			Deliveries:  mockFindDeliveriesByWebhookDeliveryRepo{},
+			Presenter:   mockFindDeliveriesByWebhookPresenter{},
+			Expected:    FindDeliveriesByWebhookOutput{},
+			ExpectedErr: domain.ErrWebhookNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindDeliveriesByWebhookInteractor(
+				test.Webhooks,
+				test.Deliveries,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), "WebhookID")
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindEpisodesBySeasonUseCase interface {
+		Execute(context.Context, FindEpisodesBySeasonInput) (FindEpisodesBySeasonOutput, error)
+	}
+
+	// FindEpisodesBySeasonInput selects the season numbered Season of a series.
+	FindEpisodesBySeasonInput struct {
+		SeriesID domain.SeriesID
+		Season   int
+	}
+
+	FindEpisodesBySeasonEpisode struct {
+		ID        string       `json:"id"                 xml:"id"`
+		Number    int          `json:"number"             xml:"number"`
+		Title     string       `json:"title"              xml:"title"`
+		AirDate   string       `json:"air_date,omitempty" xml:"air_date,omitempty"`
+		Runtime   int          `json:"runtime,omitempty"  xml:"runtime,omitempty"`
+		Synopsis  string       `json:"synopsis"           xml:"synopsis"`
+		Rating    RatingOutput `json:"rating"             xml:"rating"`
+		CreatedAt string       `json:"created_at"         xml:"created_at"`
+		UpdatedAt string       `json:"updated_at"         xml:"updated_at"`
+	}
+
+	// FindEpisodesBySeasonOutput rates the season with the reviews
+	// of all its episodes.
+	FindEpisodesBySeasonOutput struct {
+		Season   int                           `json:"season"   xml:"season"`
+		Rating   RatingOutput                  `json:"rating"   xml:"rating"`
+		Episodes []FindEpisodesBySeasonEpisode `json:"episodes" xml:"episodes>episode"`
+	}
+
+	FindEpisodesBySeasonPresenter interface {
+		Output(domain.Season, []domain.Episode) FindEpisodesBySeasonOutput
+	}
+
+	findEpisodesBySeasonInteractor struct {
+		series    domain.SeriesRepository
+		seasons   domain.SeasonRepository
+		episodes  domain.EpisodeRepository
+		presenter FindEpisodesBySeasonPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindEpisodesBySeasonInteractor(
+	series domain.SeriesRepository,
+	seasons domain.SeasonRepository,
+	episodes domain.EpisodeRepository,
+	presenter FindEpisodesBySeasonPresenter,
+	timeout time.Duration,
+) FindEpisodesBySeasonUseCase {
+	return findEpisodesBySeasonInteractor{
+		series:    series,
+		seasons:   seasons,
+		episodes:  episodes,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findEpisodesBySeasonInteractor) Execute(
+	ctx context.Context,
+	input FindEpisodesBySeasonInput,
+) (FindEpisodesBySeasonOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindEpisodesBySeason")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var (
+		season   domain.Season
+		episodes []domain.Episode
+	)
+	err := i.seasons.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.series.FindByID(ctx, input.SeriesID)
+		if err != nil {
+			return err
+		}
+
+		season, err = i.seasons.FindByNumber(ctx, input.SeriesID, input.Season)
+		if err != nil {
+			return err
+		}
+
+		episodes, err = i.episodes.FindBySeason(ctx, season.ID())
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Season{}, nil), err
+	}
+	return i.presenter.Output(season, episodes), nil
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindEpisodesBySeasonSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockFindEpisodesBySeasonSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockFindEpisodesBySeasonSeasonRepo struct {
+	domain.SeasonRepository
+	season domain.Season
+	err    error
+}
+
+func (r mockFindEpisodesBySeasonSeasonRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockFindEpisodesBySeasonSeasonRepo) FindByNumber(
+	_ context.Context,
+	_ domain.SeriesID,
+	_ int,
+) (domain.Season, error) {
+	return r.season, r.err
+}
+
+type mockFindEpisodesBySeasonEpisodeRepo struct {
+	domain.EpisodeRepository
+	episodes []domain.Episode
+	err      error
+}
+
+func (r mockFindEpisodesBySeasonEpisodeRepo) FindBySeason(
+	_ context.Context,
+	_ domain.SeasonID,
+) ([]domain.Episode, error) {
+	return r.episodes, r.err
+}
+
+type mockFindEpisodesBySeasonPresenter struct{}
+
+func (mockFindEpisodesBySeasonPresenter) Output(
+	season domain.Season,
+	episodes []domain.Episode,
+) FindEpisodesBySeasonOutput {
+	output := FindEpisodesBySeasonOutput{
+		Season:   season.Number(),
+		Episodes: []FindEpisodesBySeasonEpisode{},
+	}
+	for _, episode := range episodes {
+		output.Episodes = append(output.Episodes, FindEpisodesBySeasonEpisode{
+			Number: episode.Number(),
+		})
+	}
+	return output
+}
+
+func TestFindEpisodesBySeasonInteractor(t *testing.T) {
+	t.Parallel()
+
+	season := domain.NewSeason("SeasonID", "SeriesID", 2, "", time.Time{}, "")
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Seasons     domain.SeasonRepository
+		Episodes    domain.EpisodeRepository
+		Expected    FindEpisodesBySeasonOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of episodes",
+			Seasons:     mockFindEpisodesBySeasonSeasonRepo{season: season},
+			Episodes: mockFindEpisodesBySeasonEpisodeRepo{
+				episodes: []domain.Episode{
+					domain.NewEpisode("ID1", "SeasonID", "SeriesID", 1, "", time.Time{}, 0, ""),
+					domain.NewEpisode("ID2", "SeasonID", "SeriesID", 2, "", time.Time{}, 0, ""),
+				},
+			},
+			Expected: FindEpisodesBySeasonOutput{
+				Season:   2,
+				Episodes: []FindEpisodesBySeasonEpisode{{Number: 1}, {Number: 2}},
+			},
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Seasons:     mockFindEpisodesBySeasonSeasonRepo{season: season},
+			Episodes:    mockFindEpisodesBySeasonEpisodeRepo{},
+			Expected: FindEpisodesBySeasonOutput{
+				Episodes: []FindEpisodesBySeasonEpisode{},
+			},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description: "Season not found",
+			Seasons: mockFindEpisodesBySeasonSeasonRepo{
+				err: domain.ErrSeasonNotFound,
+			},
+			Episodes: mockFindEpisodesBySeasonEpisodeRepo{},
+			Expected: FindEpisodesBySeasonOutput{
+				Episodes: []FindEpisodesBySeasonEpisode{},
+			},
+			ExpectedErr: domain.ErrSeasonNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindEpisodesBySeasonInteractor(
+				mockFindEpisodesBySeasonSeriesRepo{err: test.SeriesErr},
+				test.Seasons,
+				test.Episodes,
+				mockFindEpisodesBySeasonPresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), FindEpisodesBySeasonInput{
+				SeriesID: "SeriesID",
+				Season:   2,
+			})
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
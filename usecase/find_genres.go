@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindGenresUseCase interface {
+		Execute(context.Context) (FindGenresOutput, error)
+	}
+
+	FindGenresGenre struct {
+		ID        string `json:"id"         xml:"id"`
+		Name      string `json:"name"       xml:"name"`
+		CreatedAt string `json:"created_at" xml:"created_at"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	FindGenresOutput struct {
+		Genres []FindGenresGenre `json:"genres" xml:"genres>genre"`
+	}
+
+	FindGenresPresenter interface {
+		Output([]domain.Genre) FindGenresOutput
+	}
+
+	findGenresInteractor struct {
+		repo      domain.GenreRepository
+		presenter FindGenresPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindGenresInteractor(
+	repo domain.GenreRepository,
+	presenter FindGenresPresenter,
+	timeout time.Duration,
+) FindGenresUseCase {
+	return findGenresInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findGenresInteractor) Execute(
+	ctx context.Context,
+) (FindGenresOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindGenres")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	genres, err := i.repo.FindAll(ctx)
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(genres), nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindGenresRepo struct {
+	domain.GenreRepository
+	genres []domain.Genre
+	err    error
+}
+
+func (r mockFindGenresRepo) FindAll(context.Context) ([]domain.Genre, error) {
+	return r.genres, r.err
+}
+
+type mockFindGenresPresenter struct {
+	output FindGenresOutput
+}
+
+func (p mockFindGenresPresenter) Output([]domain.Genre) FindGenresOutput {
+	return p.output
+}
+
+func TestFindGenresInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Repo        domain.GenreRepository
+		Presenter   FindGenresPresenter
+		Expected    FindGenresOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			Repo: mockFindGenresRepo{
+				genres: []domain.Genre{domain.NewGenre("ID", "Drama")},
+			},
+			Presenter: mockFindGenresPresenter{
+				output: FindGenresOutput{
+					Genres: []FindGenresGenre{{ID: "ID", Name: "Drama"}},
+				},
+			},
+			Expected: FindGenresOutput{
+				Genres: []FindGenresGenre{{ID: "ID", Name: "Drama"}},
+			},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Some error",
+			Repo:        mockFindGenresRepo{err: testErr},
+			Presenter:   mockFindGenresPresenter{},
+			Expected:    FindGenresOutput{},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindGenresInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO())
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
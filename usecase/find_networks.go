@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindNetworksUseCase interface {
+		Execute(context.Context) (FindNetworksOutput, error)
+	}
+
+	FindNetworksNetwork struct {
+		ID        string `json:"id"         xml:"id"`
+		Name      string `json:"name"       xml:"name"`
+		Country   string `json:"country"    xml:"country"`
+		CreatedAt string `json:"created_at" xml:"created_at"`
+		UpdatedAt string `json:"updated_at" xml:"updated_at"`
+	}
+
+	FindNetworksOutput struct {
+		Networks []FindNetworksNetwork `json:"networks" xml:"networks>network"`
+	}
+
+	FindNetworksPresenter interface {
+		Output([]domain.Network) FindNetworksOutput
+	}
+
+	findNetworksInteractor struct {
+		repo      domain.NetworkRepository
+		presenter FindNetworksPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindNetworksInteractor(
+	repo domain.NetworkRepository,
+	presenter FindNetworksPresenter,
+	timeout time.Duration,
+) FindNetworksUseCase {
+	return findNetworksInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findNetworksInteractor) Execute(
+	ctx context.Context,
+) (FindNetworksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindNetworks")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	networks, err := i.repo.FindAll(ctx)
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(networks), nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindNetworksRepo struct {
+	domain.NetworkRepository
+	networks []domain.Network
+	err      error
+}
+
+func (r mockFindNetworksRepo) FindAll(context.Context) ([]domain.Network, error) {
+	return r.networks, r.err
+}
+
+type mockFindNetworksPresenter struct {
+	output FindNetworksOutput
+}
+
+func (p mockFindNetworksPresenter) Output([]domain.Network) FindNetworksOutput {
+	return p.output
+}
+
+func TestFindNetworksInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Repo        domain.NetworkRepository
+		Presenter   FindNetworksPresenter
+		Expected    FindNetworksOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			Repo: mockFindNetworksRepo{
+				networks: []domain.Network{domain.NewNetwork("ID", "HBO", "US")},
+			},
+			Presenter: mockFindNetworksPresenter{
+				output: FindNetworksOutput{
+					Networks: []FindNetworksNetwork{{ID: "ID", Name: "HBO", Country: "US"}},
+				},
+			},
+			Expected: FindNetworksOutput{
+				Networks: []FindNetworksNetwork{{ID: "ID", Name: "HBO", Country: "US"}},
+			},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Some error",
+			Repo:        mockFindNetworksRepo{err: testErr},
+			Presenter:   mockFindNetworksPresenter{},
+			Expected:    FindNetworksOutput{},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindNetworksInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO())
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindReviewByIDUseCase interface {
+		Execute(context.Context, domain.ReviewID) (FindReviewByIDOutput, error)
+	}
+
+	FindReviewByIDOutput struct {
+		ID        string `json:"id"                   xml:"id"`
+		SeriesID  string `json:"series_id"            xml:"series_id"`
+		EpisodeID string `json:"episode_id,omitempty" xml:"episode_id,omitempty"`
+		AuthorID  string `json:"author_id"            xml:"author_id"`
+		Text      string `json:"text"                 xml:"text"`
+		Rating    int    `json:"rating,omitempty"     xml:"rating,omitempty"`
+		Version   int    `json:"version"              xml:"version"`
+		CreatedAt string `json:"created_at"           xml:"created_at"`
+		UpdatedAt string `json:"updated_at"           xml:"updated_at"`
+	}
+
+	FindReviewByIDPresenter interface {
+		Output(domain.Review) FindReviewByIDOutput
+	}
+
+	findReviewByIDInteractor struct {
+		repo      domain.ReviewRepository
+		presenter FindReviewByIDPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindReviewByIDInteractor(
+	repo domain.ReviewRepository,
+	presenter FindReviewByIDPresenter,
+	timeout time.Duration,
+) FindReviewByIDUseCase {
+	return findReviewByIDInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findReviewByIDInteractor) Execute(
+	ctx context.Context,
+	ID domain.ReviewID,
+) (FindReviewByIDOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindReviewByID")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	review, err := i.repo.FindByID(ctx, ID)
+	if err != nil {
+		return i.presenter.Output(domain.Review{}), err
+	}
+	return i.presenter.Output(review), nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindReviewByIDRepo struct {
+	domain.ReviewRepository
+	review domain.Review
+	err    error
+}
+
+func (r mockFindReviewByIDRepo) FindByID(
+	_ context.Context,
+	_ domain.ReviewID,
+) (domain.Review, error) {
+	return r.review, r.err
+}
+
+type mockFindReviewByIDPresenter struct {
+	output FindReviewByIDOutput
+}
+
+func (p mockFindReviewByIDPresenter) Output(domain.Review) FindReviewByIDOutput {
+	return p.output
+}
+
+func TestFindReviewByIDInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Repo        domain.ReviewRepository
+		Presenter   FindReviewByIDPresenter
+		Expected    FindReviewByIDOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			Repo: mockFindReviewByIDRepo{
+				review: domain.NewReview("ID", "SeriesID", "AuthorID", "Text"),
+			},
+			Presenter: mockFindReviewByIDPresenter{
+				output: FindReviewByIDOutput{ID: "ID"},
+			},
+			Expected:    FindReviewByIDOutput{ID: "ID"},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Searching review that does not exist",
+			Repo:        mockFindReviewByIDRepo{err: domain.ErrReviewNotFound},
+			Presenter:   mockFindReviewByIDPresenter{},
+			Expected:    FindReviewByIDOutput{},
+			ExpectedErr: domain.ErrReviewNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindReviewByIDInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), "ID")
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindReviewsByEpisodeUseCase interface {
+		Execute(context.Context, domain.EpisodeID) (FindReviewsByEpisodeOutput, error)
+	}
+
+	FindReviewsByEpisodeReview struct {
+		ID        string `json:"id"               xml:"id"`
+		AuthorID  string `json:"author_id"        xml:"author_id"`
+		Text      string `json:"text"             xml:"text"`
+		Rating    int    `json:"rating,omitempty" xml:"rating,omitempty"`
+		Version   int    `json:"version"          xml:"version"`
+		CreatedAt string `json:"created_at"       xml:"created_at"`
+		UpdatedAt string `json:"updated_at"       xml:"updated_at"`
+	}
+
+	// FindReviewsByEpisodeOutput rates the episode with all its
+	// reviews, whatever the reviews listed.
+	FindReviewsByEpisodeOutput struct {
+		SeriesID string                       `json:"series_id" xml:"series_id"`
+		Rating   RatingOutput                 `json:"rating"    xml:"rating"`
+		Reviews  []FindReviewsByEpisodeReview `json:"reviews"   xml:"reviews>review"`
+	}
+
+	FindReviewsByEpisodePresenter interface {
+		Output(domain.Episode, []domain.Review) FindReviewsByEpisodeOutput
+	}
+
+	findReviewsByEpisodeInteractor struct {
+		episodes  domain.EpisodeRepository
+		reviews   domain.ReviewRepository
+		presenter FindReviewsByEpisodePresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindReviewsByEpisodeInteractor(
+	episodes domain.EpisodeRepository,
+	reviews domain.ReviewRepository,
+	presenter FindReviewsByEpisodePresenter,
+	timeout time.Duration,
+) FindReviewsByEpisodeUseCase {
+	return findReviewsByEpisodeInteractor{
+		episodes:  episodes,
+		reviews:   reviews,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findReviewsByEpisodeInteractor) Execute(
+	ctx context.Context,
+	episodeID domain.EpisodeID,
+) (FindReviewsByEpisodeOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindReviewsByEpisode")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var (
+		err     error
+		episode domain.Episode
+		reviews []domain.Review
+	)
+
+	err = i.reviews.WithTransaction(ctx, func(ctx context.Context) error {
+		episode, err = i.episodes.FindByID(ctx, episodeID)
+		if err != nil {
+			return err
+		}
+
+		reviews, err = i.reviews.FindByEpisode(ctx, episodeID)
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Episode{}, nil), err
+	}
+	return i.presenter.Output(episode, reviews), nil
+}
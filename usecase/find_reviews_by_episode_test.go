@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindReviewsByEpisodeEpisodeRepo struct {
+	domain.EpisodeRepository
+	episode domain.Episode
+	err     error
+}
+
+func (r mockFindReviewsByEpisodeEpisodeRepo) FindByID(
+	_ context.Context,
+	_ domain.EpisodeID,
+) (domain.Episode, error) {
+	return r.episode, r.err
+}
+
+type mockFindReviewsByEpisodeReviewRepo struct {
+	domain.ReviewRepository
+	reviews []domain.Review
+	err     error
+}
+
+func (r mockFindReviewsByEpisodeReviewRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockFindReviewsByEpisodeReviewRepo) FindByEpisode(
+	_ context.Context,
+	_ domain.EpisodeID,
+) ([]domain.Review, error) {
+	return r.reviews, r.err
+}
+
+type mockFindReviewsByEpisodePresenter struct{}
+
+func (p mockFindReviewsByEpisodePresenter) Output(
+	episode domain.Episode,
+	reviews []domain.Review,
+) FindReviewsByEpisodeOutput {
+	output := FindReviewsByEpisodeOutput{
+		SeriesID: episode.SeriesID().String(),
+		Reviews:  make([]FindReviewsByEpisodeReview, len(reviews)),
+	}
+	for i, review := range reviews {
+		output.Reviews[i] = FindReviewsByEpisodeReview{
+			ID:     review.ID().String(),
+			Rating: review.Rating(),
+		}
+	}
+	return output
+}
+
+func TestFindReviewsByEpisodeInteractor(t *testing.T) {
+	t.Parallel()
+
+	testEpisode := domain.NewEpisode(
+		"EpisodeID",
+		"SeasonID",
+		"SeriesID",
+		14,
+		"Ozymandias",
+		time.Time{},
+		0,
+		"",
+	)
+
+	type Test struct {
+		Description string
+		Episodes    domain.EpisodeRepository
+		Reviews     domain.ReviewRepository
+		Expected    FindReviewsByEpisodeOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of reviews",
+			Episodes: mockFindReviewsByEpisodeEpisodeRepo{
+				episode: testEpisode,
+			},
+			Reviews: mockFindReviewsByEpisodeReviewRepo{
+				reviews: []domain.Review{
+					domain.NewReview(
+						"ID",
+						"SeriesID",
+						"AuthorID",
+						"Text",
+					).WithEpisode("EpisodeID").WithRating(10),
+				},
+			},
+			Expected: FindReviewsByEpisodeOutput{
+				SeriesID: "SeriesID",
+				Reviews: []FindReviewsByEpisodeReview{
+					{ID: "ID", Rating: 10},
+				},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Searching reviews for episode that does not exist",
+			Episodes: mockFindReviewsByEpisodeEpisodeRepo{
+				err: domain.ErrEpisodeNotFound,
+			},
+			Reviews: mockFindReviewsByEpisodeReviewRepo{},
+			Expected: FindReviewsByEpisodeOutput{
+				Reviews: []FindReviewsByEpisodeReview{},
+			},
+			ExpectedErr: domain.ErrEpisodeNotFound,
+		},
+
+		{
+			Description: "Generic error",
+			Episodes: mockFindReviewsByEpisodeEpisodeRepo{
+				episode: testEpisode,
+			},
+			Reviews: mockFindReviewsByEpisodeReviewRepo{
+				err: errors.New("Error"),
+			},
+			Expected: FindReviewsByEpisodeOutput{
+				Reviews: []FindReviewsByEpisodeReview{},
+			},
+			ExpectedErr: errors.New("Error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindReviewsByEpisodeInteractor(
+				test.Episodes,
+				test.Reviews,
+				mockFindReviewsByEpisodePresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), domain.EpisodeID("EpisodeID"))
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
@@ -8,17 +8,30 @@ import (
 
 type (
 	FindReviewsBySeriesUseCase interface {
-		Execute(context.Context, domain.SeriesID) (FindReviewsBySeriesOutput, error)
+		Execute(context.Context, FindReviewsBySeriesInput) (FindReviewsBySeriesOutput, error)
+	}
+
+	// FindReviewsBySeriesInput finds the reviews of the series a page at
+	// a time, oldest first.
+	FindReviewsBySeriesInput struct {
+		SeriesID domain.SeriesID
+		PageInput
 	}
 
 	FindReviewsBySeriesReview struct {
-		ID       string `json:"id"`
-		AuthorID string `json:"author_id"`
-		Text     string `json:"text"`
+		ID        string `json:"id"               xml:"id"`
+		AuthorID  string `json:"author_id"        xml:"author_id"`
+		Text      string `json:"text"             xml:"text"`
+		Rating    int    `json:"rating,omitempty" xml:"rating,omitempty"`
+		Version   int    `json:"version"          xml:"version"`
+		CreatedAt string `json:"created_at"       xml:"created_at"`
+		UpdatedAt string `json:"updated_at"       xml:"updated_at"`
 	}
 
+	// FindReviewsBySeriesOutput has HasNext set when another page follows.
 	FindReviewsBySeriesOutput struct {
-		Reviews []FindReviewsBySeriesReview `json:"reviews"`
+		Reviews []FindReviewsBySeriesReview `json:"reviews" xml:"reviews>review"`
+		HasNext bool                        `json:"-"       xml:"-"`
 	}
 
 	FindReviewsBySeriesPresenter interface {
@@ -49,8 +62,11 @@ func NewFindReviewsBySeriesInteractor(
 
 func (i findReviewsBySeriesInteractor) Execute(
 	ctx context.Context,
-	seriesID domain.SeriesID,
+	input FindReviewsBySeriesInput,
 ) (FindReviewsBySeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindReviewsBySeries")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, i.timeout)
 	defer cancel()
 
@@ -58,17 +74,21 @@ func (i findReviewsBySeriesInteractor) Execute(
 	var reviews []domain.Review
 
 	err = i.reviews.WithTransaction(ctx, func(ctx context.Context) error {
-		_, err = i.series.FindByID(ctx, seriesID)
+		_, err = i.series.FindByID(ctx, input.SeriesID)
 		if err != nil {
 			return err
 		}
 
-		reviews, err = i.reviews.FindBySeries(ctx, seriesID)
+		reviews, err = i.reviews.FindBySeries(ctx, input.SeriesID, input.page())
 		return err
 	})
 
 	if err != nil {
 		return i.presenter.Output(nil), err
 	}
-	return i.presenter.Output(reviews), nil
+
+	reviews, hasNext := paginate(reviews, input.PageInput)
+	output := i.presenter.Output(reviews)
+	output.HasNext = hasNext
+	return output, nil
 }
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	// FindReviewsBySeriesIDsUseCase finds the reviews of several series in
+	// one go. Unlike FindReviewsBySeriesUseCase, unknown series are not an
+	// error, they have no reviews.
+	FindReviewsBySeriesIDsUseCase interface {
+		Execute(context.Context, []domain.SeriesID) (FindReviewsBySeriesIDsOutput, error)
+	}
+
+	FindReviewsBySeriesIDsReview struct {
+		ID        string `json:"id"                   xml:"id"`
+		SeriesID  string `json:"series_id"            xml:"series_id"`
+		EpisodeID string `json:"episode_id,omitempty" xml:"episode_id,omitempty"`
+		AuthorID  string `json:"author_id"            xml:"author_id"`
+		Text      string `json:"text"                 xml:"text"`
+		Rating    int    `json:"rating,omitempty"     xml:"rating,omitempty"`
+		Version   int    `json:"version"              xml:"version"`
+		CreatedAt string `json:"created_at"           xml:"created_at"`
+		UpdatedAt string `json:"updated_at"           xml:"updated_at"`
+	}
+
+	FindReviewsBySeriesIDsOutput struct {
+		Reviews []FindReviewsBySeriesIDsReview `json:"reviews" xml:"reviews>review"`
+	}
+
+	FindReviewsBySeriesIDsPresenter interface {
+		Output([]domain.Review) FindReviewsBySeriesIDsOutput
+	}
+
+	findReviewsBySeriesIDsInteractor struct {
+		repo      domain.ReviewRepository
+		presenter FindReviewsBySeriesIDsPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindReviewsBySeriesIDsInteractor(
+	repo domain.ReviewRepository,
+	presenter FindReviewsBySeriesIDsPresenter,
+	timeout time.Duration,
+) FindReviewsBySeriesIDsUseCase {
+	return findReviewsBySeriesIDsInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findReviewsBySeriesIDsInteractor) Execute(
+	ctx context.Context,
+	seriesIDs []domain.SeriesID,
+) (FindReviewsBySeriesIDsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindReviewsBySeriesIDs")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	reviews, err := i.repo.FindBySeriesIDs(ctx, seriesIDs)
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(reviews), nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindReviewsBySeriesIDsRepo struct {
+	domain.ReviewRepository
+	reviews []domain.Review
+	err     error
+}
+
+func (r mockFindReviewsBySeriesIDsRepo) FindBySeriesIDs(
+	_ context.Context,
+	_ []domain.SeriesID,
+) ([]domain.Review, error) {
+	return r.reviews, r.err
+}
+
+type mockFindReviewsBySeriesIDsPresenter struct {
+	output FindReviewsBySeriesIDsOutput
+}
+
+func (p mockFindReviewsBySeriesIDsPresenter) Output(
+	_ []domain.Review,
+) FindReviewsBySeriesIDsOutput {
+	return p.output
+}
+
+func TestFindReviewsBySeriesIDsInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Repo        domain.ReviewRepository
+		Presenter   FindReviewsBySeriesIDsPresenter
+		Expected    FindReviewsBySeriesIDsOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of reviews",
+			Repo: mockFindReviewsBySeriesIDsRepo{
+				reviews: []domain.Review{
+					domain.NewReview(
+						"ID",
+						"SeriesID",
+						"AuthorID",
+						"Text",
+					),
+				},
+				err: nil,
+			},
+			Presenter: mockFindReviewsBySeriesIDsPresenter{
+				output: FindReviewsBySeriesIDsOutput{
+					Reviews: []FindReviewsBySeriesIDsReview{
+						{
+							ID:       "ID",
+							SeriesID: "SeriesID",
+							AuthorID: "AuthorID",
+							Text:     "Text",
+						},
+					},
+				},
+			},
+			Expected: FindReviewsBySeriesIDsOutput{
+				Reviews: []FindReviewsBySeriesIDsReview{
+					{
+						ID:       "ID",
+						SeriesID: "SeriesID",
+						AuthorID: "AuthorID",
+						Text:     "Text",
+					},
+				},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Repository error",
+			Repo: mockFindReviewsBySeriesIDsRepo{
+				reviews: nil,
+				err:     errors.New("error"),
+			},
+			Presenter:   mockFindReviewsBySeriesIDsPresenter{},
+			Expected:    FindReviewsBySeriesIDsOutput{},
+			ExpectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindReviewsBySeriesIDsInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), []domain.SeriesID{"SeriesID"})
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
@@ -38,6 +38,7 @@ func (r mockFindReviewsBySeriesReviewRepo) WithTransaction(
 func (r mockFindReviewsBySeriesReviewRepo) FindBySeries(
 	_ context.Context,
 	_ domain.SeriesID,
+	_ domain.Page,
 ) ([]domain.Review, error) {
 	return r.reviews, r.err
 }
@@ -146,7 +147,7 @@ func TestFindReviewsBySeriesInteractor(t *testing.T) {
 				test.Presenter,
 				1*time.Second,
 			)
-			got, err := uc.Execute(context.TODO(), domain.SeriesID(""))
+			got, err := uc.Execute(context.TODO(), FindReviewsBySeriesInput{})
 			assert.Equal(test.ExpectedErr, err)
 			assert.Equal(test.Expected, got)
 		})
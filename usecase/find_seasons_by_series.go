@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindSeasonsBySeriesUseCase interface {
+		Execute(context.Context, domain.SeriesID) (FindSeasonsBySeriesOutput, error)
+	}
+
+	// FindSeasonsBySeriesSeason rates a season with the reviews of
+	// its episodes.
+	FindSeasonsBySeriesSeason struct {
+		ID        string       `json:"id"                 xml:"id"`
+		Number    int          `json:"number"             xml:"number"`
+		Title     string       `json:"title"              xml:"title"`
+		AirDate   string       `json:"air_date,omitempty" xml:"air_date,omitempty"`
+		Synopsis  string       `json:"synopsis"           xml:"synopsis"`
+		Episodes  int          `json:"episodes"           xml:"episodes"`
+		Rating    RatingOutput `json:"rating"             xml:"rating"`
+		CreatedAt string       `json:"created_at"         xml:"created_at"`
+		UpdatedAt string       `json:"updated_at"         xml:"updated_at"`
+	}
+
+	FindSeasonsBySeriesOutput struct {
+		Seasons []FindSeasonsBySeriesSeason `json:"seasons" xml:"seasons>season"`
+	}
+
+	FindSeasonsBySeriesPresenter interface {
+		Output([]domain.Season) FindSeasonsBySeriesOutput
+	}
+
+	findSeasonsBySeriesInteractor struct {
+		series    domain.SeriesRepository
+		seasons   domain.SeasonRepository
+		presenter FindSeasonsBySeriesPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindSeasonsBySeriesInteractor(
+	series domain.SeriesRepository,
+	seasons domain.SeasonRepository,
+	presenter FindSeasonsBySeriesPresenter,
+	timeout time.Duration,
+) FindSeasonsBySeriesUseCase {
+	return findSeasonsBySeriesInteractor{
+		series:    series,
+		seasons:   seasons,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findSeasonsBySeriesInteractor) Execute(
+	ctx context.Context,
+	seriesID domain.SeriesID,
+) (FindSeasonsBySeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindSeasonsBySeries")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var seasons []domain.Season
+	err := i.seasons.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.series.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		seasons, err = i.seasons.FindBySeries(ctx, seriesID)
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(seasons), nil
+}
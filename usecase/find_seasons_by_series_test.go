@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindSeasonsBySeriesSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockFindSeasonsBySeriesSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockFindSeasonsBySeriesSeasonRepo struct {
+	domain.SeasonRepository
+	seasons []domain.Season
+	err     error
+}
+
+func (r mockFindSeasonsBySeriesSeasonRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockFindSeasonsBySeriesSeasonRepo) FindBySeries(
+	_ context.Context,
+	_ domain.SeriesID,
+) ([]domain.Season, error) {
+	return r.seasons, r.err
+}
+
+type mockFindSeasonsBySeriesPresenter struct{}
+
+func (mockFindSeasonsBySeriesPresenter) Output(
+	seasons []domain.Season,
+) FindSeasonsBySeriesOutput {
+	output := FindSeasonsBySeriesOutput{Seasons: []FindSeasonsBySeriesSeason{}}
+	for _, season := range seasons {
+		output.Seasons = append(output.Seasons, FindSeasonsBySeriesSeason{
+			Number: season.Number(),
+		})
+	}
+	return output
+}
+
+func TestFindSeasonsBySeriesInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Series      domain.SeriesRepository
+		Seasons     domain.SeasonRepository
+		Expected    FindSeasonsBySeriesOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful finding of seasons",
+			Series:      mockFindSeasonsBySeriesSeriesRepo{},
+			Seasons: mockFindSeasonsBySeriesSeasonRepo{
+				seasons: []domain.Season{
+					domain.NewSeason("ID1", "SeriesID", 1, "", time.Time{}, ""),
+					domain.NewSeason("ID2", "SeriesID", 2, "", time.Time{}, ""),
+				},
+			},
+			Expected: FindSeasonsBySeriesOutput{
+				Seasons: []FindSeasonsBySeriesSeason{{Number: 1}, {Number: 2}},
+			},
+		},
+		{
+			Description: "No seasons means empty slice, not nil",
+			Series:      mockFindSeasonsBySeriesSeriesRepo{},
+			Seasons:     mockFindSeasonsBySeriesSeasonRepo{},
+			Expected: FindSeasonsBySeriesOutput{
+				Seasons: []FindSeasonsBySeriesSeason{},
+			},
+		},
+		{
+			Description: "Searching seasons for series that does not exist",
+			Series: mockFindSeasonsBySeriesSeriesRepo{
+				err: domain.ErrSeriesNotFound,
+			},
+			Seasons: mockFindSeasonsBySeriesSeasonRepo{},
+			Expected: FindSeasonsBySeriesOutput{
+				Seasons: []FindSeasonsBySeriesSeason{},
+			},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindSeasonsBySeriesInteractor(
+				test.Series,
+				test.Seasons,
+				mockFindSeasonsBySeriesPresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), domain.SeriesID(""))
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	// FindSeriesByExternalIDUseCase returns the series with an ID in
+	// another catalog, with all its attributes and no reviews.
+	FindSeriesByExternalIDUseCase interface {
+		Execute(context.Context, FindSeriesByExternalIDInput) (FindSeriesByIDOutput, error)
+	}
+
+	// FindSeriesByExternalIDInput has exactly one of the IDs set.
+	FindSeriesByExternalIDInput struct {
+		IMDb string `validate:"required_without_all=TVDB TMDB,excluded_with=TVDB TMDB,omitempty,external_id=imdb"`
+		TVDB string `validate:"excluded_with=IMDb TMDB,omitempty,external_id=tvdb"`
+		TMDB string `validate:"excluded_with=IMDb TVDB,omitempty,external_id=tmdb"`
+	}
+
+	findSeriesByExternalIDInteractor struct {
+		repo      domain.SeriesRepository
+		presenter FindSeriesByIDPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindSeriesByExternalIDInteractor(
+	repo domain.SeriesRepository,
+	presenter FindSeriesByIDPresenter,
+	timeout time.Duration,
+) FindSeriesByExternalIDUseCase {
+	return findSeriesByExternalIDInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findSeriesByExternalIDInteractor) Execute(
+	ctx context.Context, input FindSeriesByExternalIDInput,
+) (FindSeriesByIDOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindSeriesByExternalID")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	externalIDs := newExternalIDs(input.IMDb, input.TVDB, input.TMDB)
+	if len(externalIDs) == 0 {
+		return i.presenter.Output(domain.Series{}, nil, FindSeriesByIDInput{}), domain.ErrSeriesNotFound
+	}
+
+	series, err := i.repo.FindByExternalID(ctx, externalIDs[0])
+	if err != nil {
+		return i.presenter.Output(domain.Series{}, nil, FindSeriesByIDInput{}), err
+	}
+	return i.presenter.Output(series, nil, FindSeriesByIDInput{ID: series.ID()}), nil
+}
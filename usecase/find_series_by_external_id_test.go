@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindSeriesByExternalIDRepo struct {
+	domain.SeriesRepository
+	found *domain.ExternalID
+	err   error
+}
+
+func (r mockFindSeriesByExternalIDRepo) FindByExternalID(
+	_ context.Context,
+	externalID domain.ExternalID,
+) (domain.Series, error) {
+	*r.found = externalID
+	if r.err != nil {
+		return domain.Series{}, r.err
+	}
+	return domain.NewSeries("ID", "Title", "Description", 2008, 2013), nil
+}
+
+type mockFindSeriesByExternalIDPresenter struct{}
+
+func (mockFindSeriesByExternalIDPresenter) Output(
+	series domain.Series,
+	_ []domain.Review,
+	input FindSeriesByIDInput,
+) FindSeriesByIDOutput {
+	return FindSeriesByIDOutput{
+		ID:    input.ID.String(),
+		Title: series.Title(),
+	}
+}
+
+func TestFindSeriesByExternalIDInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Input       FindSeriesByExternalIDInput
+		Expected    FindSeriesByIDOutput
+		ExpectedErr error
+		// ExpectedFound is the external ID looked up.
+		ExpectedFound domain.ExternalID
+	}
+	tests := []Test{
+		{
+			Description: "Lookup by IMDb ID",
+			Input:       FindSeriesByExternalIDInput{IMDb: "tt0903747"},
+			Expected: FindSeriesByIDOutput{
+				ID:    "ID",
+				Title: "Title",
+			},
+			ExpectedFound: domain.NewExternalID(domain.ProviderIMDb, "tt0903747"),
+		},
+		{
+			Description: "Lookup by TVDB ID",
+			Input:       FindSeriesByExternalIDInput{TVDB: "81189"},
+			Expected: FindSeriesByIDOutput{
+				ID:    "ID",
+				Title: "Title",
+			},
+			ExpectedFound: domain.NewExternalID(domain.ProviderTVDB, "81189"),
+		},
+		{
+			Description:   "Series not found",
+			SeriesErr:     domain.ErrSeriesNotFound,
+			Input:         FindSeriesByExternalIDInput{TMDB: "1396"},
+			Expected:      FindSeriesByIDOutput{},
+			ExpectedErr:   domain.ErrSeriesNotFound,
+			ExpectedFound: domain.NewExternalID(domain.ProviderTMDB, "1396"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var found domain.ExternalID
+			uc := NewFindSeriesByExternalIDInteractor(
+				mockFindSeriesByExternalIDRepo{found: &found, err: test.SeriesErr},
+				mockFindSeriesByExternalIDPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedFound, found)
+		})
+	}
+}
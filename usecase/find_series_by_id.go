@@ -2,34 +2,91 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"series/domain"
+	"strings"
 	"time"
 )
 
+const (
+	FindSeriesByIDIncludeReviews       = "reviews"
+	FindSeriesByIDIncludeReviewAuthors = "reviews.author"
+)
+
 type (
 	FindSeriesByIDUseCase interface {
-		Execute(context.Context, domain.SeriesID) (FindSeriesByIDOutput, error)
+		Execute(context.Context, FindSeriesByIDInput) (FindSeriesByIDOutput, error)
+	}
+
+	// FindSeriesByIDInput finds the series by its ID, or by a slug it has
+	// or had when Slug is set. It selects the series attributes to return
+	// in Fields (all of them if empty) and the related resources to embed
+	// in Include.
+	FindSeriesByIDInput struct {
+		ID      domain.SeriesID `validate:"required_without=Slug,omitempty,uuid_rfc4122"`
+		Slug    string          `validate:"max=100"`
+		Fields  []string        `validate:"dive,oneof=id slug title description episodes begin_year end_year creator creator_ids status rating episode_rating genres tags network_id network country language translations aliases external_ids version created_at updated_at"`
+		Include []string        `validate:"dive,oneof=reviews reviews.author"`
 	}
 
+	// FindSeriesByIDAuthor is the author of a review, embedded
+	// with the reviews.author include.
+	FindSeriesByIDAuthor struct {
+		ID      string `json:"id"      xml:"id"`
+		Reviews int    `json:"reviews" xml:"reviews"`
+	}
+
+	// FindSeriesByIDReview has a null Rating when the review is unrated.
 	FindSeriesByIDReview struct {
-		ID       string `json:"id"`
-		AuthorID string `json:"author_id"`
-		Text     string `json:"text"`
+		ID        string                `json:"id"               xml:"id"`
+		AuthorID  string                `json:"author_id"        xml:"author_id"`
+		Text      string                `json:"text"             xml:"text"`
+		Rating    *int                  `json:"rating"           xml:"rating,omitempty"`
+		Author    *FindSeriesByIDAuthor `json:"author,omitempty" xml:"author,omitempty"`
+		Version   int                   `json:"version"          xml:"version"`
+		CreatedAt string                `json:"created_at"       xml:"created_at"`
+		UpdatedAt string                `json:"updated_at"       xml:"updated_at"`
 	}
 
+	// FindSeriesByIDOutput omits attributes that were not selected, and
+	// the network, country and language of a series when they are unknown.
+	// Episodes, EndYear, Genres, Tags, Translations, Aliases and Reviews
+	// are pointers because 0 and an empty list are meaningful values when
+	// they are selected.
+	// Rating aggregates the reviews of the series itself, EpisodeRating the
+	// ones of its episodes. Title and Description are translated in the
+	// first accepted language the series has a translation in, listed in
+	// Translations.
 	FindSeriesByIDOutput struct {
-		ID          string                 `json:"id"`
-		Title       string                 `json:"title"`
-		Description string                 `json:"description"`
-		Episodes    int                    `json:"episodes"`
-		BeginYear   int                    `json:"begin_year"`
-		EndYear     int                    `json:"end_year"`
-		Creator     string                 `json:"creator"`
-		Reviews     []FindSeriesByIDReview `json:"reviews"`
+		ID            string                  `json:"id,omitempty"             xml:"id,omitempty"`
+		Slug          string                  `json:"slug,omitempty"           xml:"slug,omitempty"`
+		Title         string                  `json:"title,omitempty"          xml:"title,omitempty"`
+		Description   string                  `json:"description,omitempty"    xml:"description,omitempty"`
+		Episodes      *int                    `json:"episodes,omitempty"       xml:"episodes,omitempty"`
+		BeginYear     int                     `json:"begin_year,omitempty"     xml:"begin_year,omitempty"`
+		EndYear       *int                    `json:"end_year,omitempty"       xml:"end_year,omitempty"`
+		Creator       string                  `json:"creator,omitempty"        xml:"creator,omitempty"`
+		CreatorIDs    *[]string               `json:"creator_ids,omitempty"    xml:"creator_ids>creator_id,omitempty"`
+		Status        string                  `json:"status,omitempty"         xml:"status,omitempty"`
+		Rating        *RatingOutput           `json:"rating,omitempty"         xml:"rating,omitempty"`
+		EpisodeRating *RatingOutput           `json:"episode_rating,omitempty" xml:"episode_rating,omitempty"`
+		Genres        *[]string               `json:"genres,omitempty"         xml:"genres>genre,omitempty"`
+		Tags          *[]string               `json:"tags,omitempty"           xml:"tags>tag,omitempty"`
+		NetworkID     string                  `json:"network_id,omitempty"     xml:"network_id,omitempty"`
+		Network       string                  `json:"network,omitempty"        xml:"network,omitempty"`
+		Country       string                  `json:"country,omitempty"        xml:"country,omitempty"`
+		Language      string                  `json:"language,omitempty"       xml:"language,omitempty"`
+		Translations  *[]string               `json:"translations,omitempty"   xml:"translations>language,omitempty"`
+		Aliases       *[]string               `json:"aliases,omitempty"        xml:"aliases>alias,omitempty"`
+		ExternalIDs   *ExternalIDsOutput      `json:"external_ids,omitempty"   xml:"external_ids,omitempty"`
+		Version       int                     `json:"version,omitempty"        xml:"version,omitempty"`
+		CreatedAt     string                  `json:"created_at,omitempty"     xml:"created_at,omitempty"`
+		UpdatedAt     string                  `json:"updated_at,omitempty"     xml:"updated_at,omitempty"`
+		Reviews       *[]FindSeriesByIDReview `json:"reviews,omitempty"        xml:"reviews>review,omitempty"`
 	}
 
 	FindSeriesByIDPresenter interface {
-		Output(domain.Series, []domain.Review) FindSeriesByIDOutput
+		Output(domain.Series, []domain.Review, FindSeriesByIDInput) FindSeriesByIDOutput
 	}
 
 	findSeriesByIDInteractor struct {
@@ -40,6 +97,29 @@ type (
 	}
 )
 
+func (i FindSeriesByIDInput) Selects(field string) bool {
+	if len(i.Fields) == 0 {
+		return true
+	}
+	for _, f := range i.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Includes reports whether the resource is included, by itself or
+// through one nested in it: reviews.author includes the reviews.
+func (i FindSeriesByIDInput) Includes(resource string) bool {
+	for _, r := range i.Include {
+		if r == resource || strings.HasPrefix(r, resource+".") {
+			return true
+		}
+	}
+	return false
+}
+
 func NewFindSeriesByIDInteractor(
 	series domain.SeriesRepository,
 	reviews domain.ReviewRepository,
@@ -55,8 +135,11 @@ func NewFindSeriesByIDInteractor(
 }
 
 func (i findSeriesByIDInteractor) Execute(
-	ctx context.Context, seriesID domain.SeriesID,
+	ctx context.Context, input FindSeriesByIDInput,
 ) (FindSeriesByIDOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindSeriesByID")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, i.timeout)
 	defer cancel()
 
@@ -65,19 +148,71 @@ func (i findSeriesByIDInteractor) Execute(
 	var reviews []domain.Review
 
 	err = i.reviews.WithTransaction(ctx, func(ctx context.Context) error {
-		series, err = i.series.FindByID(ctx, seriesID)
+		if input.Slug != "" {
+			series, err = i.series.FindBySlug(ctx, input.Slug)
+		} else {
+			series, err = i.series.FindByID(ctx, input.ID)
+		}
 		if err != nil {
 			return err
 		}
-		reviews, err = i.reviews.FindBySeries(ctx, seriesID)
+		if input.Slug != "" && input.Slug != series.Slug() {
+			return domain.ErrSeriesMoved
+		}
+		if !input.Includes(FindSeriesByIDIncludeReviews) {
+			return nil
+		}
+		reviews, err = i.reviews.FindBySeries(ctx, series.ID(), domain.Page{})
 		if err != nil {
 			return err
 		}
-		return nil
+		if !input.Includes(FindSeriesByIDIncludeReviewAuthors) {
+			return nil
+		}
+		reviews, err = withAuthors(ctx, i.reviews, reviews)
+		return err
 	})
 
+	// A series found by an old slug only has its current one, to be
+	// looked up by instead.
+	if errors.Is(err, domain.ErrSeriesMoved) {
+		return i.presenter.Output(series, nil, FindSeriesByIDInput{Fields: []string{"slug"}}), err
+	} else if err != nil {
+		return i.presenter.Output(domain.Series{}, nil, FindSeriesByIDInput{}), err
+	}
+	return i.presenter.Output(series, reviews, input), nil
+}
+
+// withAuthors returns copies of the reviews with their authors.
+func withAuthors(
+	ctx context.Context,
+	repo domain.ReviewRepository,
+	reviews []domain.Review,
+) ([]domain.Review, error) {
+	if len(reviews) == 0 {
+		return reviews, nil
+	}
+	IDs := make([]domain.AuthorID, 0, len(reviews))
+	seen := make(map[domain.AuthorID]bool, len(reviews))
+	for _, review := range reviews {
+		if !seen[review.AuthorID()] {
+			seen[review.AuthorID()] = true
+			IDs = append(IDs, review.AuthorID())
+		}
+	}
+
+	authors, err := repo.FindAuthors(ctx, IDs)
 	if err != nil {
-		return i.presenter.Output(domain.Series{}, nil), err
+		return nil, err
+	}
+	byID := make(map[domain.AuthorID]domain.Author, len(authors))
+	for _, author := range authors {
+		byID[author.ID()] = author
+	}
+
+	withAuthors := make([]domain.Review, len(reviews))
+	for i, review := range reviews {
+		withAuthors[i] = review.WithAuthor(byID[review.AuthorID()])
 	}
-	return i.presenter.Output(series, reviews), nil
+	return withAuthors, nil
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"series/domain"
 	"testing"
 	"time"
@@ -22,12 +23,27 @@ func (r mockFindSeriesByIDSeriesRepo) FindByID(
 	return r.series, r.err
 }
 
+func (r mockFindSeriesByIDSeriesRepo) FindBySlug(
+	_ context.Context,
+	_ string,
+) (domain.Series, error) {
+	return r.series, r.err
+}
+
 type mockFindSeriesByIDReviewRepo struct {
 	domain.ReviewRepository
 	reviews []domain.Review
+	authors []domain.Author
 	err     error
 }
 
+func (r mockFindSeriesByIDReviewRepo) FindAuthors(
+	_ context.Context,
+	_ []domain.AuthorID,
+) ([]domain.Author, error) {
+	return r.authors, r.err
+}
+
 func (r mockFindSeriesByIDReviewRepo) WithTransaction(
 	ctx context.Context,
 	fn func(ctx context.Context) error,
@@ -38,29 +54,40 @@ func (r mockFindSeriesByIDReviewRepo) WithTransaction(
 func (r mockFindSeriesByIDReviewRepo) FindBySeries(
 	_ context.Context,
 	_ domain.SeriesID,
+	_ domain.Page,
 ) ([]domain.Review, error) {
 	return r.reviews, r.err
 }
 
 type mockFindSeriesByIDPresenter struct {
 	output FindSeriesByIDOutput
+	// reviews, when set, gets the reviews the presenter is given.
+	reviews *[]domain.Review
 }
 
 func (p mockFindSeriesByIDPresenter) Output(
 	_ domain.Series,
-	_ []domain.Review,
+	reviews []domain.Review,
+	_ FindSeriesByIDInput,
 ) FindSeriesByIDOutput {
+	if p.reviews != nil {
+		*p.reviews = reviews
+	}
 	return p.output
 }
 
 func TestFindSeriesByIDInteractor(t *testing.T) {
 	t.Parallel()
 
+	endYear, episodes := 1990, 20
+
 	type Test struct {
 		Description string
 		Series      domain.SeriesRepository
 		Reviews     domain.ReviewRepository
 		Presenter   FindSeriesByIDPresenter
+		Include     []string
+		Slug        string
 		Expected    FindSeriesByIDOutput
 		ExpectedErr error
 	}
@@ -72,11 +99,9 @@ func TestFindSeriesByIDInteractor(t *testing.T) {
 					"ID",
 					"Title",
 					"Description",
-					20,
 					1980,
 					1990,
-					"Creator",
-				),
+				).WithEpisodes(20),
 				err: nil,
 			},
 			Reviews: mockFindSeriesByIDReviewRepo{
@@ -90,16 +115,17 @@ func TestFindSeriesByIDInteractor(t *testing.T) {
 				},
 				err: nil,
 			},
+			Include: []string{FindSeriesByIDIncludeReviews},
 			Presenter: mockFindSeriesByIDPresenter{
 				output: FindSeriesByIDOutput{
 					ID:          "ID",
 					Title:       "Title",
 					Description: "Description",
-					Episodes:    20,
+					Episodes:    &episodes,
 					BeginYear:   1980,
-					EndYear:     1990,
+					EndYear:     &endYear,
 					Creator:     "Creator",
-					Reviews: []FindSeriesByIDReview{
+					Reviews: &[]FindSeriesByIDReview{
 						{
 							ID:       "ID",
 							AuthorID: "AuthorID",
@@ -112,11 +138,11 @@ func TestFindSeriesByIDInteractor(t *testing.T) {
 				ID:          "ID",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
+				EndYear:     &endYear,
 				Creator:     "Creator",
-				Reviews: []FindSeriesByIDReview{
+				Reviews: &[]FindSeriesByIDReview{
 					{
 						ID:       "ID",
 						AuthorID: "AuthorID",
@@ -134,42 +160,123 @@ func TestFindSeriesByIDInteractor(t *testing.T) {
 					"ID",
 					"Title",
 					"Description",
-					20,
 					1980,
 					1990,
-					"Creator",
-				),
+				).WithEpisodes(20),
 				err: nil,
 			},
 			Reviews: mockFindSeriesByIDReviewRepo{
 				reviews: nil,
 				err:     nil,
 			},
+			Include: []string{FindSeriesByIDIncludeReviews},
 			Presenter: mockFindSeriesByIDPresenter{
 				output: FindSeriesByIDOutput{
 					ID:          "ID",
 					Title:       "Title",
 					Description: "Description",
-					Episodes:    20,
+					Episodes:    &episodes,
 					BeginYear:   1980,
-					EndYear:     1990,
+					EndYear:     &endYear,
 					Creator:     "Creator",
-					Reviews:     []FindSeriesByIDReview{},
+					Reviews:     &[]FindSeriesByIDReview{},
 				},
 			},
 			Expected: FindSeriesByIDOutput{
 				ID:          "ID",
 				Title:       "Title",
 				Description: "Description",
-				Episodes:    20,
+				Episodes:    &episodes,
 				BeginYear:   1980,
-				EndYear:     1990,
+				EndYear:     &endYear,
 				Creator:     "Creator",
-				Reviews:     []FindSeriesByIDReview{},
+				Reviews:     &[]FindSeriesByIDReview{},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Reviews are not fetched unless included",
+			Series: mockFindSeriesByIDSeriesRepo{
+				series: domain.NewSeries(
+					"ID",
+					"Title",
+					"Description",
+					1980,
+					1990,
+				).WithEpisodes(20),
+				err: nil,
+			},
+			Reviews: mockFindSeriesByIDReviewRepo{
+				err: errors.New("reviews must not be fetched"),
+			},
+			Include: nil,
+			Presenter: mockFindSeriesByIDPresenter{
+				output: FindSeriesByIDOutput{
+					ID:    "ID",
+					Title: "Title",
+				},
+			},
+			Expected: FindSeriesByIDOutput{
+				ID:    "ID",
+				Title: "Title",
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Series found by its current slug",
+			Series: mockFindSeriesByIDSeriesRepo{
+				series: domain.NewSeries(
+					"ID",
+					"Breaking Bad",
+					"Description",
+					2008,
+					2013,
+				).WithSlug("breaking-bad-2008"),
+			},
+			Reviews: mockFindSeriesByIDReviewRepo{},
+			Slug:    "breaking-bad-2008",
+			Presenter: mockFindSeriesByIDPresenter{
+				output: FindSeriesByIDOutput{
+					ID:   "ID",
+					Slug: "breaking-bad-2008",
+				},
+			},
+			Expected: FindSeriesByIDOutput{
+				ID:   "ID",
+				Slug: "breaking-bad-2008",
 			},
 			ExpectedErr: nil,
 		},
 
+		{
+			Description: "Series found by an old slug has moved",
+			Series: mockFindSeriesByIDSeriesRepo{
+				series: domain.NewSeries(
+					"ID",
+					"Breaking Bad",
+					"Description",
+					2008,
+					2013,
+				).WithSlug("breaking-bad-2008"),
+			},
+			Reviews: mockFindSeriesByIDReviewRepo{
+				err: errors.New("reviews must not be fetched"),
+			},
+			Include: []string{FindSeriesByIDIncludeReviews},
+			Slug:    "breaking-bad",
+			Presenter: mockFindSeriesByIDPresenter{
+				output: FindSeriesByIDOutput{
+					Slug: "breaking-bad-2008",
+				},
+			},
+			Expected: FindSeriesByIDOutput{
+				Slug: "breaking-bad-2008",
+			},
+			ExpectedErr: domain.ErrSeriesMoved,
+		},
+
 		{
 			Description: "Series with provided id does not exist",
 			Series: mockFindSeriesByIDSeriesRepo{
@@ -191,9 +298,49 @@ func TestFindSeriesByIDInteractor(t *testing.T) {
 				test.Presenter,
 				1*time.Second,
 			)
-			got, err := uc.Execute(context.TODO(), "")
+			got, err := uc.Execute(context.TODO(), FindSeriesByIDInput{
+				Include: test.Include,
+				Slug:    test.Slug,
+			})
 			assert.Equal(test.ExpectedErr, err)
 			assert.Equal(test.Expected, got)
 		})
 	}
 }
+
+func TestFindSeriesByIDInteractorAuthors(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+
+	reviews := []domain.Review{
+		domain.NewReview("ID1", "SeriesID", "AuthorID1", "Text"),
+		domain.NewReview("ID2", "SeriesID", "AuthorID2", "Text"),
+		domain.NewReview("ID3", "SeriesID", "AuthorID1", "Text"),
+	}
+	presented := []domain.Review{}
+	uc := NewFindSeriesByIDInteractor(
+		mockFindSeriesByIDSeriesRepo{
+			series: domain.NewSeries("SeriesID", "Title", "Description", 1980, 1990),
+		},
+		mockFindSeriesByIDReviewRepo{
+			reviews: reviews,
+			authors: []domain.Author{
+				domain.NewAuthor("AuthorID1", 5),
+				domain.NewAuthor("AuthorID2", 1),
+			},
+		},
+		mockFindSeriesByIDPresenter{reviews: &presented},
+		1*time.Second,
+	)
+	_, err := uc.Execute(context.TODO(), FindSeriesByIDInput{
+		Include: []string{FindSeriesByIDIncludeReviewAuthors},
+	})
+	assert.Nil(err)
+	assert.Len(presented, 3)
+	for i, want := range []int{5, 1, 5} {
+		author := presented[i].Author()
+		assert.Equal(reviews[i].AuthorID(), author.ID())
+		assert.Equal(want, author.Reviews())
+	}
+}
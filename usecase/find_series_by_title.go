@@ -8,19 +8,50 @@ import (
 
 type (
 	FindSeriesByTitleUseCase interface {
-		Execute(context.Context, string) (FindSeriesByTitleOutput, error)
+		Execute(context.Context, FindSeriesByTitleInput) (FindSeriesByTitleOutput, error)
 	}
 
+	// FindSeriesByTitleInput searches the series by the full-text Query
+	// among the ones of the Genre, with the Tag, broadcast by the Network,
+	// from the Country in the Language and with the Status. Any of them
+	// can be left empty, as long as one is set. The series come a page at
+	// a time, ordered by title.
+	FindSeriesByTitleInput struct {
+		Query    string `validate:"required_without_all=Genre Tag Network Country Language Status"`
+		Genre    string `validate:"max=50"`
+		Tag      string `validate:"max=30"`
+		Network  string `validate:"max=50"`
+		Country  string `validate:"omitempty,country"`
+		Language string `validate:"omitempty,language"`
+		Status   string `validate:"omitempty,oneof=announced airing on_hiatus ended cancelled miniseries"`
+		PageInput
+	}
+
+	// FindSeriesByTitleSeries has the alias the query matched in
+	// MatchedAlias, empty when none did.
 	FindSeriesByTitleSeries struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		BeginYear int    `json:"begin_year"`
-		EndYear   int    `json:"end_year"`
-		Creator   string `json:"creator"`
+		ID           string   `json:"id"                      xml:"id"`
+		Slug         string   `json:"slug"                    xml:"slug"`
+		Title        string   `json:"title"                   xml:"title"`
+		BeginYear    int      `json:"begin_year"              xml:"begin_year"`
+		EndYear      int      `json:"end_year"                xml:"end_year"`
+		Creator      string   `json:"creator"                 xml:"creator"`
+		Status       string   `json:"status"                  xml:"status"`
+		Genres       []string `json:"genres"                  xml:"genres>genre"`
+		Tags         []string `json:"tags"                    xml:"tags>tag"`
+		NetworkID    string   `json:"network_id"              xml:"network_id"`
+		Network      string   `json:"network"                 xml:"network"`
+		Country      string   `json:"country"                 xml:"country"`
+		Language     string   `json:"language"                xml:"language"`
+		MatchedAlias string   `json:"matched_alias,omitempty" xml:"matched_alias,omitempty"`
+		CreatedAt    string   `json:"created_at"              xml:"created_at"`
+		UpdatedAt    string   `json:"updated_at"              xml:"updated_at"`
 	}
 
+	// FindSeriesByTitleOutput has HasNext set when another page follows.
 	FindSeriesByTitleOutput struct {
-		Series []FindSeriesByTitleSeries `json:"series"`
+		Series  []FindSeriesByTitleSeries `json:"series" xml:"series>series"`
+		HasNext bool                      `json:"-"      xml:"-"`
 	}
 
 	FindSeriesByTitlePresenter interface {
@@ -47,14 +78,28 @@ func NewFindSeriesByTitleInteractor(
 }
 
 func (s findSeriesByTitleInteractor) Execute(
-	ctx context.Context, query string,
+	ctx context.Context, input FindSeriesByTitleInput,
 ) (FindSeriesByTitleOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindSeriesByTitle")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	series, err := s.repo.FindByTitle(ctx, query)
+	series, err := s.repo.FindByTitle(ctx, input.Query, domain.SeriesFilter{
+		Genre:    input.Genre,
+		Tag:      domain.NormalizeTag(input.Tag),
+		Network:  input.Network,
+		Country:  input.Country,
+		Language: input.Language,
+		Status:   domain.SeriesStatus(input.Status),
+	}, input.page())
 	if err != nil {
 		return s.presenter.Output(nil), err
 	}
-	return s.presenter.Output(series), nil
+
+	series, hasNext := paginate(series, input.PageInput)
+	output := s.presenter.Output(series)
+	output.HasNext = hasNext
+	return output, nil
 }
@@ -18,6 +18,8 @@ type mockFindSeriesByTitleRepo struct {
 func (r mockFindSeriesByTitleRepo) FindByTitle(
 	_ context.Context,
 	title string,
+	filter domain.SeriesFilter,
+	page domain.Page,
 ) ([]domain.Series, error) {
 	return r.series, r.err
 }
@@ -51,20 +53,16 @@ func TestFindSeriesByTitleInteractor(t *testing.T) {
 						"ID1",
 						"Title1",
 						"Description",
-						20,
 						1980,
 						1990,
-						"Creator1",
-					),
+					).WithEpisodes(20),
 					domain.NewSeries(
 						"ID2",
 						"Title2",
 						"Description",
-						13,
 						1970,
 						1978,
-						"Creator2",
-					),
+					).WithEpisodes(13),
 				},
 				err: nil,
 			},
@@ -109,6 +107,23 @@ func TestFindSeriesByTitleInteractor(t *testing.T) {
 			ExpectedErr: nil,
 		},
 
+		{
+			Description: "More series than a page",
+			Repo: mockFindSeriesByTitleRepo{
+				series: make([]domain.Series, DefaultPageLimit+1),
+			},
+			Presenter: mockFindSeriesByTitlePresenter{
+				output: FindSeriesByTitleOutput{
+					Series: []FindSeriesByTitleSeries{},
+				},
+			},
+			Expected: FindSeriesByTitleOutput{
+				Series:  []FindSeriesByTitleSeries{},
+				HasNext: true,
+			},
+			ExpectedErr: nil,
+		},
+
 		{
 			Description: "No series means empty slice, not nil",
 			Repo:        mockFindSeriesByTitleRepo{},
@@ -132,7 +147,7 @@ func TestFindSeriesByTitleInteractor(t *testing.T) {
 				test.Presenter,
 				1*time.Second,
 			)
-			got, err := uc.Execute(context.TODO(), "")
+			got, err := uc.Execute(context.TODO(), FindSeriesByTitleInput{})
 			assert.Equal(test.ExpectedErr, err)
 			assert.Equal(test.Expected, got)
 		})
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindWebhookByIDUseCase interface {
+		Execute(context.Context, domain.WebhookID) (FindWebhookByIDOutput, error)
+	}
+
+	FindWebhookByIDOutput struct {
+		ID        string   `json:"id"         xml:"id"`
+		URL       string   `json:"url"        xml:"url"`
+		Events    []string `json:"events"     xml:"events>event"`
+		CreatedAt string   `json:"created_at" xml:"created_at"`
+		UpdatedAt string   `json:"updated_at" xml:"updated_at"`
+	}
+
+	FindWebhookByIDPresenter interface {
+		Output(domain.Webhook) FindWebhookByIDOutput
+	}
+
+	findWebhookByIDInteractor struct {
+		repo      domain.WebhookRepository
+		presenter FindWebhookByIDPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindWebhookByIDInteractor(
+	repo domain.WebhookRepository,
+	presenter FindWebhookByIDPresenter,
+	timeout time.Duration,
+) FindWebhookByIDUseCase {
+	return findWebhookByIDInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findWebhookByIDInteractor) Execute(
+	ctx context.Context,
+	ID domain.WebhookID,
+) (FindWebhookByIDOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindWebhookByID")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	webhook, err := i.repo.FindByID(ctx, ID)
+	if err != nil {
+		return i.presenter.Output(domain.Webhook{}), err
+	}
+	return i.presenter.Output(webhook), nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindWebhookByIDRepo struct {
+	domain.WebhookRepository
+	webhook domain.Webhook
+	err     error
+}
+
+func (r mockFindWebhookByIDRepo) FindByID(
+	_ context.Context,
+	_ domain.WebhookID,
+) (domain.Webhook, error) {
+	return r.webhook, r.err
+}
+
+type mockFindWebhookByIDPresenter struct {
+	output FindWebhookByIDOutput
+}
+
+func (p mockFindWebhookByIDPresenter) Output(domain.Webhook) FindWebhookByIDOutput {
+	return p.output
+}
+
+func TestFindWebhookByIDInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Repo        domain.WebhookRepository
+		Presenter   FindWebhookByIDPresenter
+		Expected    FindWebhookByIDOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful search",
+			Repo: mockFindWebhookByIDRepo{
+				webhook: domain.NewWebhook("ID", "http://localhost/hook", "secret", nil),
+			},
+			Presenter: mockFindWebhookByIDPresenter{
+				output: FindWebhookByIDOutput{ID: "ID"},
+			},
+			Expected:    FindWebhookByIDOutput{ID: "ID"},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Searching webhook that does not exist",
+			Repo:        mockFindWebhookByIDRepo{err: domain.ErrWebhookNotFound},
+			Presenter:   mockFindWebhookByIDPresenter{},
+			Expected:    FindWebhookByIDOutput{},
+			ExpectedErr: domain.ErrWebhookNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindWebhookByIDInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), "ID")
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	FindWebhooksUseCase interface {
+		Execute(context.Context) (FindWebhooksOutput, error)
+	}
+
+	FindWebhooksWebhook struct {
+		ID        string   `json:"id"         xml:"id"`
+		URL       string   `json:"url"        xml:"url"`
+		Events    []string `json:"events"     xml:"events>event"`
+		CreatedAt string   `json:"created_at" xml:"created_at"`
+		UpdatedAt string   `json:"updated_at" xml:"updated_at"`
+	}
+
+	FindWebhooksOutput struct {
+		Webhooks []FindWebhooksWebhook `json:"webhooks" xml:"webhooks>webhook"`
+	}
+
+	FindWebhooksPresenter interface {
+		Output([]domain.Webhook) FindWebhooksOutput
+	}
+
+	findWebhooksInteractor struct {
+		repo      domain.WebhookRepository
+		presenter FindWebhooksPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewFindWebhooksInteractor(
+	repo domain.WebhookRepository,
+	presenter FindWebhooksPresenter,
+	timeout time.Duration,
+) FindWebhooksUseCase {
+	return findWebhooksInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i findWebhooksInteractor) Execute(
+	ctx context.Context,
+) (FindWebhooksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.FindWebhooks")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	webhooks, err := i.repo.FindAll(ctx)
+	if err != nil {
+		return i.presenter.Output(nil), err
+	}
+	return i.presenter.Output(webhooks), nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFindWebhooksRepo struct {
+	domain.WebhookRepository
+	webhooks []domain.Webhook
+	err      error
+}
+
+func (r mockFindWebhooksRepo) FindAll(context.Context) ([]domain.Webhook, error) {
+	return r.webhooks, r.err
+}
+
+type mockFindWebhooksPresenter struct {
+	output FindWebhooksOutput
+}
+
+func (p mockFindWebhooksPresenter) Output([]domain.Webhook) FindWebhooksOutput {
+	return p.output
+}
+
+func TestFindWebhooksInteractor(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Repo        domain.WebhookRepository
+		Presenter   FindWebhooksPresenter
+		Expected    FindWebhooksOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful listing",
+			Repo: mockFindWebhooksRepo{
+				webhooks: []domain.Webhook{
+					domain.NewWebhook("ID", "http://localhost/hook", "secret", nil),
+				},
+			},
+			Presenter: mockFindWebhooksPresenter{
+				output: FindWebhooksOutput{
+					Webhooks: []FindWebhooksWebhook{{ID: "ID"}},
+				},
+			},
+			Expected: FindWebhooksOutput{
+				Webhooks: []FindWebhooksWebhook{{ID: "ID"}},
+			},
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Some error",
+			Repo:        mockFindWebhooksRepo{err: testErr},
+			Presenter:   mockFindWebhooksPresenter{},
+			Expected:    FindWebhooksOutput{},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewFindWebhooksInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO())
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+)
+
+// Outcomes of the use cases, as counted by Metrics.
+const (
+	OutcomeSuccess                = "success"
+	OutcomeSeriesNotFound         = "series_not_found"
+	OutcomeEpisodeNotFound        = "episode_not_found"
+	OutcomeReviewNotFound         = "review_not_found"
+	OutcomeAlreadyReviewed        = "already_reviewed"
+	OutcomeConcurrentModification = "concurrent_modification"
+	OutcomeError                  = "error"
+)
+
+type (
+	// Metrics counts how use cases end, like the reviews created or the
+	// reviews rejected because their author already reviewed the series
+	// or episode.
+	Metrics interface {
+		IncUseCase(useCase, outcome string)
+	}
+
+	createSeriesMetrics struct {
+		CreateSeriesUseCase
+		metrics Metrics
+	}
+
+	updateSeriesMetrics struct {
+		UpdateSeriesUseCase
+		metrics Metrics
+	}
+
+	createReviewMetrics struct {
+		CreateReviewUseCase
+		metrics Metrics
+	}
+
+	updateReviewMetrics struct {
+		UpdateReviewUseCase
+		metrics Metrics
+	}
+)
+
+// outcome maps the error a use case returned to the outcome it is counted as.
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, domain.ErrSeriesNotFound):
+		return OutcomeSeriesNotFound
+	case errors.Is(err, domain.ErrEpisodeNotFound):
+		return OutcomeEpisodeNotFound
+	case errors.Is(err, domain.ErrReviewNotFound):
+		return OutcomeReviewNotFound
+	case errors.Is(err, domain.ErrAlreadyReviewed):
+		return OutcomeAlreadyReviewed
+	case errors.Is(err, domain.ErrConcurrentModification):
+		return OutcomeConcurrentModification
+	default:
+		return OutcomeError
+	}
+}
+
+func InstrumentCreateSeries(uc CreateSeriesUseCase, metrics Metrics) CreateSeriesUseCase {
+	return createSeriesMetrics{uc, metrics}
+}
+
+func (m createSeriesMetrics) Execute(
+	ctx context.Context, input CreateSeriesInput,
+) (CreateSeriesOutput, error) {
+	output, err := m.CreateSeriesUseCase.Execute(ctx, input)
+	m.metrics.IncUseCase("create_series", outcome(err))
+	return output, err
+}
+
+func InstrumentUpdateSeries(uc UpdateSeriesUseCase, metrics Metrics) UpdateSeriesUseCase {
+	return updateSeriesMetrics{uc, metrics}
+}
+
+func (m updateSeriesMetrics) Execute(
+	ctx context.Context, input UpdateSeriesInput,
+) (UpdateSeriesOutput, error) {
+	output, err := m.UpdateSeriesUseCase.Execute(ctx, input)
+	m.metrics.IncUseCase("update_series", outcome(err))
+	return output, err
+}
+
+func InstrumentCreateReview(uc CreateReviewUseCase, metrics Metrics) CreateReviewUseCase {
+	return createReviewMetrics{uc, metrics}
+}
+
+func (m createReviewMetrics) Execute(
+	ctx context.Context, input CreateReviewInput,
+) (CreateReviewOutput, error) {
+	output, err := m.CreateReviewUseCase.Execute(ctx, input)
+	m.metrics.IncUseCase("create_review", outcome(err))
+	return output, err
+}
+
+func InstrumentUpdateReview(uc UpdateReviewUseCase, metrics Metrics) UpdateReviewUseCase {
+	return updateReviewMetrics{uc, metrics}
+}
+
+func (m updateReviewMetrics) Execute(
+	ctx context.Context, input UpdateReviewInput,
+) (UpdateReviewOutput, error) {
+	output, err := m.UpdateReviewUseCase.Execute(ctx, input)
+	m.metrics.IncUseCase("update_review", outcome(err))
+	return output, err
+}
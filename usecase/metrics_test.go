@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"series/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMetrics struct {
+	counted *[]string
+}
+
+func (m mockMetrics) IncUseCase(useCase, outcome string) {
+	*m.counted = append(*m.counted, useCase+":"+outcome)
+}
+
+type mockCreateReviewUseCase struct {
+	err error
+}
+
+func (uc mockCreateReviewUseCase) Execute(
+	_ context.Context,
+	_ CreateReviewInput,
+) (CreateReviewOutput, error) {
+	return CreateReviewOutput{ID: "ReviewID"}, uc.err
+}
+
+func TestInstrumentCreateReview(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Err         error
+		Expected    string
+	}
+	tests := []Test{
+		{
+			Description: "Review created",
+			Err:         nil,
+			Expected:    "create_review:success",
+		},
+		{
+			Description: "Author already reviewed the series",
+			Err:         fmt.Errorf("creating review: %w", domain.ErrAlreadyReviewed),
+			Expected:    "create_review:already_reviewed",
+		},
+		{
+			Description: "Series not found",
+			Err:         domain.ErrSeriesNotFound,
+			Expected:    "create_review:series_not_found",
+		},
+		{
+			Description: "Episode not found",
+			Err:         domain.ErrEpisodeNotFound,
+			Expected:    "create_review:episode_not_found",
+		},
+		{
+			Description: "Unexpected error",
+			Err:         errors.New("Error"),
+			Expected:    "create_review:error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			counted := []string{}
+			uc := InstrumentCreateReview(
+				mockCreateReviewUseCase{err: test.Err},
+				mockMetrics{counted: &counted},
+			)
+			output, err := uc.Execute(context.TODO(), CreateReviewInput{})
+			assert.Equal(test.Err, err)
+			assert.Equal(CreateReviewOutput{ID: "ReviewID"}, output)
+			assert.Equal([]string{test.Expected}, counted)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import "series/domain"
+
+// DefaultPageLimit is the number of items of a page whose input doesn't
+// set a limit.
+const DefaultPageLimit = 20
+
+// PageInput selects Limit items of a list after skipping Offset of them.
+type PageInput struct {
+	Limit  int `validate:"omitempty,min=1,max=100"`
+	Offset int `validate:"min=0"`
+}
+
+// PageLimit returns the Limit, or DefaultPageLimit when it's not set.
+func (p PageInput) PageLimit() int {
+	if p.Limit == 0 {
+		return DefaultPageLimit
+	}
+	return p.Limit
+}
+
+// page asks the repositories for one item more than the limit, so that
+// paginate can tell whether another page follows.
+func (p PageInput) page() domain.Page {
+	return domain.Page{Limit: p.PageLimit() + 1, Offset: p.Offset}
+}
+
+// paginate cuts the items found for the page down to its limit, hasNext
+// is true when there were more.
+func paginate[T any](items []T, p PageInput) (page []T, hasNext bool) {
+	if len(items) > p.PageLimit() {
+		return items[:p.PageLimit()], true
+	}
+	return items, false
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"series/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description     string
+		Input           PageInput
+		Items           int
+		ExpectedPage    domain.Page
+		ExpectedItems   int
+		ExpectedHasNext bool
+	}
+	tests := []Test{
+		{
+			Description:     "Fewer items than the limit",
+			Input:           PageInput{Limit: 10},
+			Items:           4,
+			ExpectedPage:    domain.Page{Limit: 11},
+			ExpectedItems:   4,
+			ExpectedHasNext: false,
+		},
+		{
+			Description:     "As many items as the limit",
+			Input:           PageInput{Limit: 10, Offset: 20},
+			Items:           10,
+			ExpectedPage:    domain.Page{Limit: 11, Offset: 20},
+			ExpectedItems:   10,
+			ExpectedHasNext: false,
+		},
+		{
+			Description:     "More items than the limit",
+			Input:           PageInput{Limit: 10},
+			Items:           11,
+			ExpectedPage:    domain.Page{Limit: 11},
+			ExpectedItems:   10,
+			ExpectedHasNext: true,
+		},
+		{
+			Description:     "Default limit",
+			Input:           PageInput{Offset: 5},
+			Items:           DefaultPageLimit + 1,
+			ExpectedPage:    domain.Page{Limit: DefaultPageLimit + 1, Offset: 5},
+			ExpectedItems:   DefaultPageLimit,
+			ExpectedHasNext: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(test.ExpectedPage, test.Input.page())
+
+			items, hasNext := paginate(make([]int, test.Items), test.Input)
+			assert.Len(items, test.ExpectedItems)
+			assert.Equal(test.ExpectedHasNext, hasNext)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+)
+
+type (
+	fanOutPublisher []domain.EventPublisher
+
+	webhookDeliveriesPublisher struct {
+		webhooks   domain.WebhookRepository
+		deliveries domain.DeliveryRepository
+	}
+
+	reviewBrokerPublisher struct {
+		reviews domain.ReviewRepository
+		broker  domain.ReviewBroker
+	}
+)
+
+// NewFanOutPublisher publishes events to every publisher in turn. An
+// event is published again to all of them when one fails, which is
+// fine for at-least-once consumers.
+func NewFanOutPublisher(publishers ...domain.EventPublisher) domain.EventPublisher {
+	return fanOutPublisher(publishers)
+}
+
+func (p fanOutPublisher) Publish(ctx context.Context, event domain.Event) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewWebhookDeliveriesPublisher queues a delivery of every event for the
+// webhooks subscribed to its type. An event is queued once per webhook
+// however many times it is published.
+func NewWebhookDeliveriesPublisher(
+	webhooks domain.WebhookRepository,
+	deliveries domain.DeliveryRepository,
+) domain.EventPublisher {
+	return webhookDeliveriesPublisher{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+	}
+}
+
+func (p webhookDeliveriesPublisher) Publish(
+	ctx context.Context,
+	event domain.Event,
+) error {
+	subscribers, err := p.webhooks.FindByEvent(ctx, event.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range subscribers {
+		_, err := p.deliveries.Create(ctx, domain.NewDelivery(
+			domain.DeliveryID(domain.NewUUID()),
+			webhook.ID(),
+			event,
+		))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewReviewBrokerPublisher hands the reviews of review.created events to
+// the broker live streams subscribe to, other events are ignored.
+func NewReviewBrokerPublisher(
+	reviews domain.ReviewRepository,
+	broker domain.ReviewBroker,
+) domain.EventPublisher {
+	return reviewBrokerPublisher{
+		reviews: reviews,
+		broker:  broker,
+	}
+}
+
+func (p reviewBrokerPublisher) Publish(
+	ctx context.Context,
+	event domain.Event,
+) error {
+	if event.Type() != domain.EventReviewCreated {
+		return nil
+	}
+
+	review, err := p.reviews.FindByID(ctx, domain.ReviewID(event.AggregateID()))
+	if errors.Is(err, domain.ErrReviewNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return p.broker.Publish(ctx, review)
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPublishersWebhookRepo struct {
+	domain.WebhookRepository
+	webhooks []domain.Webhook
+	err      error
+}
+
+func (r mockPublishersWebhookRepo) FindByEvent(
+	_ context.Context,
+	_ domain.EventType,
+) ([]domain.Webhook, error) {
+	return r.webhooks, r.err
+}
+
+type mockPublishersDeliveryRepo struct {
+	domain.DeliveryRepository
+	created *[]domain.Delivery
+}
+
+func (r mockPublishersDeliveryRepo) Create(
+	_ context.Context,
+	delivery domain.Delivery,
+) (domain.Delivery, error) {
+	*r.created = append(*r.created, delivery)
+	return delivery, nil
+}
+
+type mockPublishersReviewRepo struct {
+	domain.ReviewRepository
+	review domain.Review
+	err    error
+}
+
+func (r mockPublishersReviewRepo) FindByID(
+	_ context.Context,
+	_ domain.ReviewID,
+) (domain.Review, error) {
+	return r.review, r.err
+}
+
+type mockPublishersBroker struct {
+	domain.ReviewBroker
+	published *[]domain.Review
+}
+
+func (b mockPublishersBroker) Publish(_ context.Context, review domain.Review) error {
+	*b.published = append(*b.published, review)
+	return nil
+}
+
+func TestFanOutPublisher(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	testErr := errors.New("Error")
+	event := domain.NewEvent("1", domain.EventSeriesCreated, "SeriesID", nil)
+
+	first, second := []domain.EventID{}, []domain.EventID{}
+	publisher := NewFanOutPublisher(
+		mockEventPublisher{published: &first},
+		mockEventPublisher{published: &second},
+	)
+	assert.Nil(publisher.Publish(context.TODO(), event))
+	assert.Equal([]domain.EventID{"1"}, first)
+	assert.Equal([]domain.EventID{"1"}, second)
+
+	third := []domain.EventID{}
+	publisher = NewFanOutPublisher(
+		mockEventPublisher{published: &first, failOn: "1", err: testErr},
+		mockEventPublisher{published: &third},
+	)
+	assert.Equal(testErr, publisher.Publish(context.TODO(), event))
+	assert.Empty(third)
+}
+
+func TestWebhookDeliveriesPublisher(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+	event := domain.NewEvent(
+		"EventID",
+		domain.EventSeriesCreated,
+		"SeriesID",
+		[]byte(`{"id":"SeriesID"}`),
+	)
+
+	type Test struct {
+		Description string
+		Webhooks    domain.WebhookRepository
+		ExpectedErr error
+		// ExpectedWebhooks are the webhooks deliveries are queued for.
+		ExpectedWebhooks []domain.WebhookID
+	}
+	tests := []Test{
+		{
+			Description: "Queuing deliveries for subscribed webhooks",
+			Webhooks: mockPublishersWebhookRepo{
+				webhooks: []domain.Webhook{
+					domain.NewWebhook("1", "http://localhost/hook", "secret", nil),
+					domain.NewWebhook("2", "http://localhost/other", "secret", nil),
+				},
+			},
+			ExpectedErr:      nil,
+			ExpectedWebhooks: []domain.WebhookID{"1", "2"},
+		},
+		{
+			Description:      "No subscribed webhooks",
+			Webhooks:         mockPublishersWebhookRepo{},
+			ExpectedErr:      nil,
+			ExpectedWebhooks: []domain.WebhookID{},
+		},
+		{
+			Description:      "Failing to find webhooks",
+			Webhooks:         mockPublishersWebhookRepo{err: testErr},
+			ExpectedErr:      testErr,
+			ExpectedWebhooks: []domain.WebhookID{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			created := []domain.Delivery{}
+			publisher := NewWebhookDeliveriesPublisher(
+				test.Webhooks,
+				mockPublishersDeliveryRepo{created: &created},
+			)
+			assert.Equal(test.ExpectedErr, publisher.Publish(context.TODO(), event))
+
+			webhooks := []domain.WebhookID{}
+			for _, delivery := range created {
+				webhooks = append(webhooks, delivery.WebhookID())
+				assert.Equal(event.ID(), delivery.EventID())
+				assert.Equal(event.Type(), delivery.Event())
+				assert.Equal(event.Payload(), delivery.Payload())
+				assert.Equal(domain.DeliveryPending, delivery.Status())
+			}
+			assert.Equal(test.ExpectedWebhooks, webhooks)
+		})
+	}
+}
+
+func TestReviewBrokerPublisher(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("Error")
+	review := domain.NewReview("ReviewID", "SeriesID", "AuthorID", "Text")
+
+	type Test struct {
+		Description string
+		Event       domain.Event
+		Reviews     domain.ReviewRepository
+		ExpectedErr error
+		// ExpectedPublished is the number of reviews sent to the broker.
+		ExpectedPublished int
+	}
+	tests := []Test{
+		{
+			Description:       "Publishing created review",
+			Event:             domain.NewEvent("1", domain.EventReviewCreated, "ReviewID", nil),
+			Reviews:           mockPublishersReviewRepo{review: review},
+			ExpectedErr:       nil,
+			ExpectedPublished: 1,
+		},
+		{
+			Description:       "Other events are ignored",
+			Event:             domain.NewEvent("1", domain.EventSeriesCreated, "SeriesID", nil),
+			Reviews:           mockPublishersReviewRepo{review: review},
+			ExpectedErr:       nil,
+			ExpectedPublished: 0,
+		},
+		{
+			Description:       "Review that does not exist anymore is skipped",
+			Event:             domain.NewEvent("1", domain.EventReviewCreated, "ReviewID", nil),
+			Reviews:           mockPublishersReviewRepo{err: domain.ErrReviewNotFound},
+			ExpectedErr:       nil,
+			ExpectedPublished: 0,
+		},
+		{
+			Description:       "Failing to find the review",
+			Event:             domain.NewEvent("1", domain.EventReviewCreated, "ReviewID", nil),
+			Reviews:           mockPublishersReviewRepo{err: testErr},
+			ExpectedErr:       testErr,
+			ExpectedPublished: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			published := []domain.Review{}
+			publisher := NewReviewBrokerPublisher(
+				test.Reviews,
+				mockPublishersBroker{published: &published},
+			)
+			assert.Equal(test.ExpectedErr, publisher.Publish(context.TODO(), test.Event))
+			assert.Len(published, test.ExpectedPublished)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package usecase
+
+// RatingOutput aggregates the ratings, from 1 to 10, of a set of
+// reviews. Average is 0 when none of them was rated.
+type RatingOutput struct {
+	Average float64 `json:"average" xml:"average"`
+	Count   int     `json:"count"   xml:"count"`
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+const (
+	// relayBatchSize is how many events are claimed at once.
+	relayBatchSize = 50
+	// relayLease has to outlast the publication of a whole batch. Events
+	// left unpublished by a failure are relayed again once it expires.
+	relayLease = 30 * time.Second
+)
+
+type (
+	// RelayEventsUseCase publishes a batch of events of the outbox.
+	// It is meant to be run in a loop by background workers.
+	RelayEventsUseCase interface {
+		Execute(context.Context) (RelayEventsOutput, error)
+	}
+
+	RelayEventsOutput struct {
+		Published int
+	}
+
+	relayEventsInteractor struct {
+		events    domain.EventRepository
+		publisher domain.EventPublisher
+		timeout   time.Duration
+	}
+)
+
+// NewRelayEventsInteractor returns a use case that bounds every
+// database operation and every publication with timeout.
+func NewRelayEventsInteractor(
+	events domain.EventRepository,
+	publisher domain.EventPublisher,
+	timeout time.Duration,
+) RelayEventsUseCase {
+	return relayEventsInteractor{
+		events:    events,
+		publisher: publisher,
+		timeout:   timeout,
+	}
+}
+
+// Execute stops at the first event that fails to be published, so that
+// events are published in the order they occurred as much as possible.
+func (i relayEventsInteractor) Execute(
+	ctx context.Context,
+) (RelayEventsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.RelayEvents")
+	defer span.End()
+
+	var output RelayEventsOutput
+
+	claimCtx, cancel := context.WithTimeout(ctx, i.timeout)
+	events, err := i.events.Claim(claimCtx, relayBatchSize, relayLease)
+	cancel()
+	if err != nil {
+		return output, err
+	}
+
+	for _, event := range events {
+		log := logger.FromContext(ctx).WithFields(logger.Fields{
+			"event_id": event.ID().String(),
+			"event":    event.Type().String(),
+		})
+		if err := i.relay(ctx, event); err != nil {
+			log.WithFields(logger.Fields{"error": err}).
+				Warnf("Failed to relay event, it is relayed again later")
+			return output, err
+		}
+		log.Debugf("Event relayed")
+		output.Published++
+	}
+	return output, nil
+}
+
+func (i relayEventsInteractor) relay(ctx context.Context, event domain.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	if err := i.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+	return i.events.Delete(ctx, event.ID())
+}
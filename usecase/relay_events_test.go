@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRelayEventsEventRepo struct {
+	domain.EventRepository
+	claimed  []domain.Event
+	claimErr error
+	deleted  *[]domain.EventID
+}
+
+func (r mockRelayEventsEventRepo) Claim(
+	_ context.Context,
+	_ int,
+	_ time.Duration,
+) ([]domain.Event, error) {
+	return r.claimed, r.claimErr
+}
+
+func (r mockRelayEventsEventRepo) Delete(_ context.Context, ID domain.EventID) error {
+	*r.deleted = append(*r.deleted, ID)
+	return nil
+}
+
+type mockEventPublisher struct {
+	published *[]domain.EventID
+	// failOn is the id of the event that fails to be published.
+	failOn domain.EventID
+	err    error
+}
+
+func (p mockEventPublisher) Publish(_ context.Context, event domain.Event) error {
+	if event.ID() == p.failOn {
+		return p.err
+	}
+	*p.published = append(*p.published, event.ID())
+	return nil
+}
+
+func TestRelayEventsInteractor(t *testing.T) {
+	t.Parallel()
+
+	testEvents := []domain.Event{
+		domain.NewEvent("1", domain.EventSeriesCreated, "SeriesID", []byte(`{}`)),
+		domain.NewEvent("2", domain.EventReviewCreated, "ReviewID", []byte(`{}`)),
+		domain.NewEvent("3", domain.EventReviewCreated, "ReviewID", []byte(`{}`)),
+	}
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Claimed     []domain.Event
+		ClaimErr    error
+		FailOn      domain.EventID
+		Expected    RelayEventsOutput
+		ExpectedErr error
+		// ExpectedDeleted are the events removed from the outbox.
+		ExpectedDeleted []domain.EventID
+	}
+	tests := []Test{
+		{
+			Description:     "Successful relay",
+			Claimed:         testEvents,
+			Expected:        RelayEventsOutput{Published: 3},
+			ExpectedErr:     nil,
+			ExpectedDeleted: []domain.EventID{"1", "2", "3"},
+		},
+		{
+			Description:     "Failing to publish stops the relay",
+			Claimed:         testEvents,
+			FailOn:          "2",
+			Expected:        RelayEventsOutput{Published: 1},
+			ExpectedErr:     testErr,
+			ExpectedDeleted: []domain.EventID{"1"},
+		},
+		{
+			Description:     "Failing to claim events",
+			ClaimErr:        testErr,
+			Expected:        RelayEventsOutput{},
+			ExpectedErr:     testErr,
+			ExpectedDeleted: []domain.EventID{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			deleted := []domain.EventID{}
+			published := []domain.EventID{}
+			uc := NewRelayEventsInteractor(
+				mockRelayEventsEventRepo{
+					claimed:  test.Claimed,
+					claimErr: test.ClaimErr,
+					deleted:  &deleted,
+				},
+				mockEventPublisher{
+					published: &published,
+					failOn:    test.FailOn,
+					err:       testErr,
+				},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO())
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedDeleted, deleted)
+			assert.Equal(test.ExpectedDeleted, published)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"time"
+)
+
+type (
+	// StreamReviewsBySeriesUseCase streams the reviews created for a series
+	// until ctx is done, the returned channel is closed then.
+	StreamReviewsBySeriesUseCase interface {
+		Execute(context.Context, StreamReviewsBySeriesInput) (<-chan StreamReviewsBySeriesOutput, error)
+	}
+
+	// StreamReviewsBySeriesInput resumes the stream after LastEventID
+	// when it is set.
+	StreamReviewsBySeriesInput struct {
+		SeriesID    domain.SeriesID
+		LastEventID domain.ReviewID
+	}
+
+	// StreamReviewsBySeriesOutput is a review of the series, or of one
+	// of its episodes when EpisodeID is set.
+	StreamReviewsBySeriesOutput struct {
+		ID        string `json:"id"                   xml:"id"`
+		EpisodeID string `json:"episode_id,omitempty" xml:"episode_id,omitempty"`
+		AuthorID  string `json:"author_id"            xml:"author_id"`
+		Text      string `json:"text"                 xml:"text"`
+		Rating    int    `json:"rating,omitempty"     xml:"rating,omitempty"`
+		Version   int    `json:"version"              xml:"version"`
+		CreatedAt string `json:"created_at"           xml:"created_at"`
+		UpdatedAt string `json:"updated_at"           xml:"updated_at"`
+	}
+
+	StreamReviewsBySeriesPresenter interface {
+		Output(domain.Review) StreamReviewsBySeriesOutput
+	}
+
+	streamReviewsBySeriesInteractor struct {
+		series    domain.SeriesRepository
+		broker    domain.ReviewBroker
+		presenter StreamReviewsBySeriesPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewStreamReviewsBySeriesInteractor(
+	series domain.SeriesRepository,
+	broker domain.ReviewBroker,
+	presenter StreamReviewsBySeriesPresenter,
+	timeout time.Duration,
+) StreamReviewsBySeriesUseCase {
+	return streamReviewsBySeriesInteractor{
+		series:    series,
+		broker:    broker,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i streamReviewsBySeriesInteractor) Execute(
+	ctx context.Context,
+	input StreamReviewsBySeriesInput,
+) (<-chan StreamReviewsBySeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.StreamReviewsBySeries")
+	defer span.End()
+
+	// The timeout only applies to the lookup, the stream
+	// itself lasts as long as ctx.
+	findCtx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	if _, err := i.series.FindByID(findCtx, input.SeriesID); err != nil {
+		return nil, err
+	}
+
+	reviews, err := i.broker.Subscribe(ctx, input.SeriesID, input.LastEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(chan StreamReviewsBySeriesOutput)
+	go func() {
+		defer close(outputs)
+		for review := range reviews {
+			select {
+			case outputs <- i.presenter.Output(review):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return outputs, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStreamReviewsBySeriesSeriesRepo struct {
+	domain.SeriesRepository
+	err error
+}
+
+func (r mockStreamReviewsBySeriesSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+type mockStreamReviewsBySeriesBroker struct {
+	domain.ReviewBroker
+	reviews []domain.Review
+	err     error
+}
+
+func (b mockStreamReviewsBySeriesBroker) Subscribe(
+	_ context.Context,
+	_ domain.SeriesID,
+	_ domain.ReviewID,
+) (<-chan domain.Review, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	reviews := make(chan domain.Review, len(b.reviews))
+	for _, review := range b.reviews {
+		reviews <- review
+	}
+	close(reviews)
+	return reviews, nil
+}
+
+type mockStreamReviewsBySeriesPresenter struct{}
+
+func (p mockStreamReviewsBySeriesPresenter) Output(
+	review domain.Review,
+) StreamReviewsBySeriesOutput {
+	return StreamReviewsBySeriesOutput{ID: review.ID().String()}
+}
+
+func TestStreamReviewsBySeriesInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		Series      domain.SeriesRepository
+		Broker      domain.ReviewBroker
+		Expected    []StreamReviewsBySeriesOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Streaming published reviews",
+			Series:      mockStreamReviewsBySeriesSeriesRepo{},
+			Broker: mockStreamReviewsBySeriesBroker{
+				reviews: []domain.Review{
+					domain.NewReview("ID1", "SeriesID", "AuthorID", "Text"),
+					domain.NewReview("ID2", "SeriesID", "AuthorID", "Text"),
+				},
+			},
+			Expected: []StreamReviewsBySeriesOutput{
+				{ID: "ID1"},
+				{ID: "ID2"},
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Streaming reviews for series that does not exist",
+			Series: mockStreamReviewsBySeriesSeriesRepo{
+				err: domain.ErrSeriesNotFound,
+			},
+			Broker:      mockStreamReviewsBySeriesBroker{},
+			Expected:    nil,
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+
+		{
+			Description: "Subscription error",
+			Series:      mockStreamReviewsBySeriesSeriesRepo{},
+			Broker: mockStreamReviewsBySeriesBroker{
+				err: errors.New("error"),
+			},
+			Expected:    nil,
+			ExpectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewStreamReviewsBySeriesInteractor(
+				test.Series,
+				test.Broker,
+				mockStreamReviewsBySeriesPresenter{},
+				1*time.Second,
+			)
+			outputs, err := uc.Execute(
+				context.TODO(),
+				StreamReviewsBySeriesInput{SeriesID: "SeriesID"},
+			)
+			assert.Equal(test.ExpectedErr, err)
+
+			var got []StreamReviewsBySeriesOutput
+			if outputs != nil {
+				for output := range outputs {
+					got = append(got, output)
+				}
+			}
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
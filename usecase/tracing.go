@@ -0,0 +1,7 @@
+package usecase
+
+import "go.opentelemetry.io/otel"
+
+// tracer starts a span for every execution of a use case. It is a no-op
+// until a tracer provider is installed with otel.SetTracerProvider.
+var tracer = otel.Tracer("series/usecase")
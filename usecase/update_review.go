@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	UpdateReviewUseCase interface {
+		Execute(context.Context, UpdateReviewInput) (UpdateReviewOutput, error)
+	}
+
+	// UpdateReviewInput keeps the stored rating when Rating is nil.
+	UpdateReviewInput struct {
+		ID      string `json:"-"                xml:"-"                validate:"required,uuid_rfc4122"`
+		Version int    `json:"version"          xml:"version"          validate:"required,min=1"`
+		Text    string `json:"text"             xml:"text"             validate:"required,max=500"`
+		Rating  *int   `json:"rating,omitempty" xml:"rating,omitempty" validate:"omitempty,min=1,max=10"`
+	}
+
+	UpdateReviewOutput struct {
+		ID        string `json:"id"                   xml:"id"`
+		SeriesID  string `json:"series_id"            xml:"series_id"`
+		EpisodeID string `json:"episode_id,omitempty" xml:"episode_id,omitempty"`
+		AuthorID  string `json:"author_id"            xml:"author_id"`
+		Text      string `json:"text"                 xml:"text"`
+		Rating    int    `json:"rating,omitempty"     xml:"rating,omitempty"`
+		Version   int    `json:"version"              xml:"version"`
+		CreatedAt string `json:"created_at"           xml:"created_at"`
+		UpdatedAt string `json:"updated_at"           xml:"updated_at"`
+	}
+
+	UpdateReviewPresenter interface {
+		Output(domain.Review) UpdateReviewOutput
+	}
+
+	updateReviewInteractor struct {
+		reviews   domain.ReviewRepository
+		presenter UpdateReviewPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateReviewInteractor(
+	reviews domain.ReviewRepository,
+	presenter UpdateReviewPresenter,
+	timeout time.Duration,
+) UpdateReviewUseCase {
+	return updateReviewInteractor{
+		reviews:   reviews,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateReviewInteractor) Execute(
+	ctx context.Context, input UpdateReviewInput,
+) (UpdateReviewOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateReview")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	var (
+		err    error
+		review domain.Review
+	)
+
+	err = i.reviews.WithTransaction(ctx, func(ctx context.Context) error {
+		review, err = i.reviews.FindByID(ctx, domain.ReviewID(input.ID))
+		if err != nil {
+			return err
+		}
+		if review.Version() != input.Version {
+			return domain.ErrConcurrentModification
+		}
+
+		review = review.WithText(input.Text)
+		if input.Rating != nil {
+			review = review.WithRating(*input.Rating)
+		}
+
+		review, err = i.reviews.Update(ctx, review)
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Review{}), err
+	}
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"review_id": review.ID().String(),
+			"version":   review.Version(),
+		}).
+		Infof("Review updated")
+
+	return i.presenter.Output(review), nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateReviewReviewRepo struct {
+	domain.ReviewRepository
+	review    domain.Review
+	findErr   error
+	updateErr error
+}
+
+func (r mockUpdateReviewReviewRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateReviewReviewRepo) FindByID(
+	_ context.Context,
+	_ domain.ReviewID,
+) (domain.Review, error) {
+	return r.review, r.findErr
+}
+
+func (r mockUpdateReviewReviewRepo) Update(
+	_ context.Context,
+	review domain.Review,
+) (domain.Review, error) {
+	return review.WithVersion(review.Version() + 1), r.updateErr
+}
+
+type mockUpdateReviewPresenter struct{}
+
+func (p mockUpdateReviewPresenter) Output(
+	review domain.Review,
+) UpdateReviewOutput {
+	return UpdateReviewOutput{
+		ID:       review.ID().String(),
+		SeriesID: review.SeriesID().String(),
+		AuthorID: review.AuthorID().String(),
+		Text:     review.Text(),
+		Rating:   review.Rating(),
+		Version:  review.Version(),
+	}
+}
+
+func TestUpdateReviewInteractor(t *testing.T) {
+	t.Parallel()
+
+	testReview := domain.NewReview(
+		"ID",
+		"SeriesID",
+		"AuthorID",
+		"Text",
+	).WithRating(7)
+	newRating := 9
+
+	type Test struct {
+		Description string
+		Reviews     domain.ReviewRepository
+		Input       UpdateReviewInput
+		Expected    UpdateReviewOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			Reviews: mockUpdateReviewReviewRepo{
+				review: testReview,
+			},
+			Input: UpdateReviewInput{
+				ID:      "ID",
+				Version: 1,
+				Text:    "New text",
+			},
+			Expected: UpdateReviewOutput{
+				ID:       "ID",
+				SeriesID: "SeriesID",
+				AuthorID: "AuthorID",
+				Text:     "New text",
+				Rating:   7,
+				Version:  2,
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Successful update of the rating",
+			Reviews: mockUpdateReviewReviewRepo{
+				review: testReview,
+			},
+			Input: UpdateReviewInput{
+				ID:      "ID",
+				Version: 1,
+				Text:    "New text",
+				Rating:  &newRating,
+			},
+			Expected: UpdateReviewOutput{
+				ID:       "ID",
+				SeriesID: "SeriesID",
+				AuthorID: "AuthorID",
+				Text:     "New text",
+				Rating:   9,
+				Version:  2,
+			},
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Updating review that does not exist",
+			Reviews: mockUpdateReviewReviewRepo{
+				findErr: domain.ErrReviewNotFound,
+			},
+			Input:       UpdateReviewInput{Version: 1},
+			Expected:    UpdateReviewOutput{},
+			ExpectedErr: domain.ErrReviewNotFound,
+		},
+
+		{
+			Description: "Updating stale version of review",
+			Reviews: mockUpdateReviewReviewRepo{
+				review: testReview.WithVersion(2),
+			},
+			Input:       UpdateReviewInput{Version: 1},
+			Expected:    UpdateReviewOutput{},
+			ExpectedErr: domain.ErrConcurrentModification,
+		},
+
+		{
+			Description: "Concurrent update between read and write",
+			Reviews: mockUpdateReviewReviewRepo{
+				review:    testReview,
+				updateErr: domain.ErrConcurrentModification,
+			},
+			Input:       UpdateReviewInput{Version: 1},
+			Expected:    UpdateReviewOutput{},
+			ExpectedErr: domain.ErrConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewUpdateReviewInteractor(
+				test.Reviews,
+				mockUpdateReviewPresenter{},
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
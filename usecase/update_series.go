@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	UpdateSeriesUseCase interface {
+		Execute(context.Context, UpdateSeriesInput) (UpdateSeriesOutput, error)
+	}
+
+	UpdateSeriesInput struct {
+		ID          string   `json:"-"           xml:"-"                      validate:"required,uuid_rfc4122"`
+		Version     int      `json:"version"     xml:"version"                validate:"required,min=1"`
+		Title       string   `json:"title"       xml:"title"                  validate:"required,max=70"`
+		Description string   `json:"description" xml:"description"            validate:"required,max=200"`
+		BeginYear   int      `json:"begin_year"  xml:"begin_year"             validate:"required,min=1946,max=2030"`
+		EndYear     int      `json:"end_year"    xml:"end_year"               validate:"eq=0|gtefield=BeginYear"`
+		CreatorIDs  []string `json:"creator_ids" xml:"creator_ids>creator_id" validate:"required,min=1,max=10,unique,dive,uuid_rfc4122"`
+		NetworkID   string   `json:"network_id"  xml:"network_id"             validate:"omitempty,uuid_rfc4122"`
+		Country     string   `json:"country"     xml:"country"                validate:"omitempty,country"`
+		Language    string   `json:"language"    xml:"language"               validate:"omitempty,language"`
+	}
+
+	UpdateSeriesOutput struct {
+		ID          string   `json:"id"          xml:"id"`
+		Slug        string   `json:"slug"        xml:"slug"`
+		Title       string   `json:"title"       xml:"title"`
+		Description string   `json:"description" xml:"description"`
+		Episodes    int      `json:"episodes"    xml:"episodes"`
+		BeginYear   int      `json:"begin_year"  xml:"begin_year"`
+		EndYear     int      `json:"end_year"    xml:"end_year"`
+		Creator     string   `json:"creator"     xml:"creator"`
+		CreatorIDs  []string `json:"creator_ids" xml:"creator_ids>creator_id"`
+		Status      string   `json:"status"      xml:"status"`
+		NetworkID   string   `json:"network_id"  xml:"network_id"`
+		Network     string   `json:"network"     xml:"network"`
+		Country     string   `json:"country"     xml:"country"`
+		Language    string   `json:"language"    xml:"language"`
+		Version     int      `json:"version"     xml:"version"`
+		CreatedAt   string   `json:"created_at"  xml:"created_at"`
+		UpdatedAt   string   `json:"updated_at"  xml:"updated_at"`
+	}
+
+	UpdateSeriesPresenter interface {
+		Output(domain.Series) UpdateSeriesOutput
+	}
+
+	updateSeriesInteractor struct {
+		repo      domain.SeriesRepository
+		networks  domain.NetworkRepository
+		people    domain.PersonRepository
+		presenter UpdateSeriesPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesInteractor(
+	repo domain.SeriesRepository,
+	networks domain.NetworkRepository,
+	people domain.PersonRepository,
+	presenter UpdateSeriesPresenter,
+	timeout time.Duration,
+) UpdateSeriesUseCase {
+	return updateSeriesInteractor{
+		repo:      repo,
+		networks:  networks,
+		people:    people,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesInteractor) Execute(
+	ctx context.Context, input UpdateSeriesInput,
+) (UpdateSeriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeries")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	series := domain.NewSeries(
+		domain.SeriesID(input.ID),
+		input.Title, input.Description,
+		input.BeginYear, input.EndYear,
+	).WithOrigin(input.Country, input.Language).
+		WithVersion(input.Version)
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		// The status only changes through ChangeSeriesStatus, the end year
+		// has to stay consistent with the stored one.
+		current, err := i.repo.FindByID(ctx, series.ID())
+		if err != nil {
+			return err
+		}
+		if err := current.Status().CheckEndYear(input.EndYear); err != nil {
+			return err
+		}
+		series = series.WithStatus(current.Status())
+
+		series, err = withNetwork(ctx, i.networks, series, input.NetworkID)
+		if err != nil {
+			return err
+		}
+
+		series, err = withCreators(ctx, i.people, series, input.CreatorIDs)
+		if err != nil {
+			return err
+		}
+
+		// The slug follows the title and begin year, the previous
+		// one keeps redirecting to the series.
+		slug := current.Slug()
+		if series.Title() != current.Title() || series.BeginYear() != current.BeginYear() {
+			slug, err = uniqueSlug(ctx, i.repo, series)
+			if err != nil {
+				return err
+			}
+		}
+
+		series, err = i.repo.Update(ctx, series.WithSlug(slug))
+		return err
+	})
+
+	if err != nil {
+		return i.presenter.Output(domain.Series{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": series.ID().String(),
+			"version":   series.Version(),
+		}).
+		Infof("Series updated")
+
+	return i.presenter.Output(series), nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	UpdateSeriesAliasesUseCase interface {
+		Execute(context.Context, UpdateSeriesAliasesInput) (UpdateSeriesAliasesOutput, error)
+	}
+
+	// UpdateSeriesAliasesInput replaces the other titles the series is
+	// known by, an empty list removes them all. Aliases are searched along
+	// with the title, in any language.
+	UpdateSeriesAliasesInput struct {
+		SeriesID string   `json:"-"       xml:"-"              validate:"required,uuid_rfc4122"`
+		Aliases  []string `json:"aliases" xml:"aliases>alias" validate:"required,max=20,dive,required,max=70"`
+	}
+
+	UpdateSeriesAliasesOutput struct {
+		SeriesID string   `json:"series_id" xml:"series_id"`
+		Aliases  []string `json:"aliases"   xml:"aliases>alias"`
+	}
+
+	UpdateSeriesAliasesPresenter interface {
+		Output(domain.SeriesID, []string) UpdateSeriesAliasesOutput
+	}
+
+	updateSeriesAliasesInteractor struct {
+		repo      domain.SeriesRepository
+		presenter UpdateSeriesAliasesPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesAliasesInteractor(
+	repo domain.SeriesRepository,
+	presenter UpdateSeriesAliasesPresenter,
+	timeout time.Duration,
+) UpdateSeriesAliasesUseCase {
+	return updateSeriesAliasesInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesAliasesInteractor) Execute(
+	ctx context.Context, input UpdateSeriesAliasesInput,
+) (UpdateSeriesAliasesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeriesAliases")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	seriesID := domain.SeriesID(input.SeriesID)
+	aliases := normalizeAliases(input.Aliases)
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.repo.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+		return i.repo.UpdateAliases(ctx, seriesID, aliases)
+	})
+
+	if err != nil {
+		return i.presenter.Output("", nil), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": seriesID.String(),
+			"aliases":   len(aliases),
+		}).
+		Infof("Series aliases updated")
+
+	return i.presenter.Output(seriesID, aliases), nil
+}
+
+// normalizeAliases collapses the spaces of the aliases and drops the
+// duplicates in any case, keeping the first spelling. The remaining
+// ones are sorted as the repositories return them.
+func normalizeAliases(aliases []string) []string {
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, alias := range aliases {
+		alias = strings.Join(strings.Fields(alias), " ")
+		key := strings.ToLower(alias)
+		if alias == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, alias)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
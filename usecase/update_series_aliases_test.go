@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesAliasesRepo struct {
+	domain.SeriesRepository
+	updated *[]string
+	err     error
+}
+
+func (r mockUpdateSeriesAliasesRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateSeriesAliasesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+func (r mockUpdateSeriesAliasesRepo) UpdateAliases(
+	_ context.Context,
+	_ domain.SeriesID,
+	aliases []string,
+) error {
+	*r.updated = aliases
+	return nil
+}
+
+type mockUpdateSeriesAliasesPresenter struct{}
+
+func (mockUpdateSeriesAliasesPresenter) Output(
+	seriesID domain.SeriesID,
+	aliases []string,
+) UpdateSeriesAliasesOutput {
+	return UpdateSeriesAliasesOutput{
+		SeriesID: seriesID.String(),
+		Aliases:  aliases,
+	}
+}
+
+func TestUpdateSeriesAliasesInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Input       UpdateSeriesAliasesInput
+		Expected    UpdateSeriesAliasesOutput
+		ExpectedErr error
+		// ExpectedUpdated are the aliases stored, nil if not updated.
+		ExpectedUpdated []string
+	}
+	tests := []Test{
+		{
+			Description: "Aliases are trimmed, deduplicated in any case and sorted",
+			Input: UpdateSeriesAliasesInput{
+				SeriesID: "SeriesID",
+				Aliases: []string{
+					"Money Heist", " La casa  de papel ", "money heist", " ",
+				},
+			},
+			Expected: UpdateSeriesAliasesOutput{
+				SeriesID: "SeriesID",
+				Aliases:  []string{"La casa de papel", "Money Heist"},
+			},
+			ExpectedUpdated: []string{"La casa de papel", "Money Heist"},
+		},
+		{
+			Description: "No aliases removes them all",
+			Input: UpdateSeriesAliasesInput{
+				SeriesID: "SeriesID",
+				Aliases:  []string{},
+			},
+			Expected: UpdateSeriesAliasesOutput{
+				SeriesID: "SeriesID",
+				Aliases:  []string{},
+			},
+			ExpectedUpdated: []string{},
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Input: UpdateSeriesAliasesInput{
+				SeriesID: "SeriesID",
+				Aliases:  []string{"Money Heist"},
+			},
+			Expected:    UpdateSeriesAliasesOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var updated []string
+			uc := NewUpdateSeriesAliasesInteractor(
+				mockUpdateSeriesAliasesRepo{updated: &updated, err: test.SeriesErr},
+				mockUpdateSeriesAliasesPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	UpdateSeriesExternalIDsUseCase interface {
+		Execute(context.Context, UpdateSeriesExternalIDsInput) (UpdateSeriesExternalIDsOutput, error)
+	}
+
+	// UpdateSeriesExternalIDsInput replaces the IDs of the series in other
+	// catalogs, the ones left empty are removed. Each is checked against
+	// the format of its provider.
+	UpdateSeriesExternalIDsInput struct {
+		SeriesID string `json:"-"    xml:"-"    validate:"required,uuid_rfc4122"`
+		IMDb     string `json:"imdb" xml:"imdb" validate:"omitempty,external_id=imdb"`
+		TVDB     string `json:"tvdb" xml:"tvdb" validate:"omitempty,external_id=tvdb"`
+		TMDB     string `json:"tmdb" xml:"tmdb" validate:"omitempty,external_id=tmdb"`
+	}
+
+	UpdateSeriesExternalIDsOutput struct {
+		SeriesID    string            `json:"series_id"    xml:"series_id"`
+		ExternalIDs ExternalIDsOutput `json:"external_ids" xml:"external_ids"`
+	}
+
+	UpdateSeriesExternalIDsPresenter interface {
+		Output(domain.SeriesID, []domain.ExternalID) UpdateSeriesExternalIDsOutput
+	}
+
+	updateSeriesExternalIDsInteractor struct {
+		repo      domain.SeriesRepository
+		presenter UpdateSeriesExternalIDsPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesExternalIDsInteractor(
+	repo domain.SeriesRepository,
+	presenter UpdateSeriesExternalIDsPresenter,
+	timeout time.Duration,
+) UpdateSeriesExternalIDsUseCase {
+	return updateSeriesExternalIDsInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesExternalIDsInteractor) Execute(
+	ctx context.Context, input UpdateSeriesExternalIDsInput,
+) (UpdateSeriesExternalIDsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeriesExternalIDs")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	seriesID := domain.SeriesID(input.SeriesID)
+	externalIDs := newExternalIDs(input.IMDb, input.TVDB, input.TMDB)
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.repo.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+		return i.repo.UpdateExternalIDs(ctx, seriesID, externalIDs)
+	})
+
+	if err != nil {
+		return i.presenter.Output("", nil), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id":    seriesID.String(),
+			"external_ids": len(externalIDs),
+		}).
+		Infof("Series external ids updated")
+
+	return i.presenter.Output(seriesID, externalIDs), nil
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesExternalIDsRepo struct {
+	domain.SeriesRepository
+	updated   *[]domain.ExternalID
+	err       error
+	updateErr error
+}
+
+func (r mockUpdateSeriesExternalIDsRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateSeriesExternalIDsRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+func (r mockUpdateSeriesExternalIDsRepo) UpdateExternalIDs(
+	_ context.Context,
+	_ domain.SeriesID,
+	externalIDs []domain.ExternalID,
+) error {
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+	*r.updated = externalIDs
+	return nil
+}
+
+type mockUpdateSeriesExternalIDsPresenter struct{}
+
+func (mockUpdateSeriesExternalIDsPresenter) Output(
+	seriesID domain.SeriesID,
+	externalIDs []domain.ExternalID,
+) UpdateSeriesExternalIDsOutput {
+	output := UpdateSeriesExternalIDsOutput{SeriesID: seriesID.String()}
+	for _, externalID := range externalIDs {
+		if externalID.Provider() == domain.ProviderIMDb {
+			output.ExternalIDs.IMDb = externalID.ID()
+		}
+	}
+	return output
+}
+
+func TestUpdateSeriesExternalIDsInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		UpdateErr   error
+		Input       UpdateSeriesExternalIDsInput
+		Expected    UpdateSeriesExternalIDsOutput
+		ExpectedErr error
+		// ExpectedUpdated are the external IDs stored, nil if not updated.
+		ExpectedUpdated []domain.ExternalID
+	}
+	tests := []Test{
+		{
+			Description: "Only the IDs that are set are stored",
+			Input: UpdateSeriesExternalIDsInput{
+				SeriesID: "SeriesID",
+				IMDb:     "tt0903747",
+				TVDB:     "81189",
+			},
+			Expected: UpdateSeriesExternalIDsOutput{
+				SeriesID:    "SeriesID",
+				ExternalIDs: ExternalIDsOutput{IMDb: "tt0903747"},
+			},
+			ExpectedUpdated: []domain.ExternalID{
+				domain.NewExternalID(domain.ProviderIMDb, "tt0903747"),
+				domain.NewExternalID(domain.ProviderTVDB, "81189"),
+			},
+		},
+		{
+			Description: "No IDs removes them all",
+			Input: UpdateSeriesExternalIDsInput{
+				SeriesID: "SeriesID",
+			},
+			Expected: UpdateSeriesExternalIDsOutput{
+				SeriesID: "SeriesID",
+			},
+			ExpectedUpdated: []domain.ExternalID{},
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Input: UpdateSeriesExternalIDsInput{
+				SeriesID: "SeriesID",
+				IMDb:     "tt0903747",
+			},
+			Expected:    UpdateSeriesExternalIDsOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+		{
+			Description: "ID of another series",
+			UpdateErr:   domain.ErrExternalIDExists,
+			Input: UpdateSeriesExternalIDsInput{
+				SeriesID: "SeriesID",
+				IMDb:     "tt0903747",
+			},
+			Expected:    UpdateSeriesExternalIDsOutput{},
+			ExpectedErr: domain.ErrExternalIDExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var updated []domain.ExternalID
+			uc := NewUpdateSeriesExternalIDsInteractor(
+				mockUpdateSeriesExternalIDsRepo{
+					updated:   &updated,
+					err:       test.SeriesErr,
+					updateErr: test.UpdateErr,
+				},
+				mockUpdateSeriesExternalIDsPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedUpdated, updated)
+		})
+	}
+}
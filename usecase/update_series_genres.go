@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"series/adapter/logger"
+	"series/domain"
+	"strings"
+	"time"
+)
+
+type (
+	UpdateSeriesGenresUseCase interface {
+		Execute(context.Context, UpdateSeriesGenresInput) (UpdateSeriesGenresOutput, error)
+	}
+
+	// UpdateSeriesGenresInput replaces the genres of the series with the
+	// named ones, an empty list removes them all. Every name must be the
+	// one of an existing genre, in any case.
+	UpdateSeriesGenresInput struct {
+		SeriesID string   `json:"-"      xml:"-"            validate:"required,uuid_rfc4122"`
+		Genres   []string `json:"genres" xml:"genres>genre" validate:"required,max=10,dive,required,max=50"`
+	}
+
+	UpdateSeriesGenresOutput struct {
+		SeriesID string   `json:"series_id" xml:"series_id"`
+		Genres   []string `json:"genres"    xml:"genres>genre"`
+	}
+
+	UpdateSeriesGenresPresenter interface {
+		Output(domain.SeriesID, []domain.Genre) UpdateSeriesGenresOutput
+	}
+
+	updateSeriesGenresInteractor struct {
+		series    domain.SeriesRepository
+		genres    domain.GenreRepository
+		presenter UpdateSeriesGenresPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesGenresInteractor(
+	series domain.SeriesRepository,
+	genres domain.GenreRepository,
+	presenter UpdateSeriesGenresPresenter,
+	timeout time.Duration,
+) UpdateSeriesGenresUseCase {
+	return updateSeriesGenresInteractor{
+		series:    series,
+		genres:    genres,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesGenresInteractor) Execute(
+	ctx context.Context, input UpdateSeriesGenresInput,
+) (UpdateSeriesGenresOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeriesGenres")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	seriesID := domain.SeriesID(input.SeriesID)
+
+	var genres []domain.Genre
+	err := i.series.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.series.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		genres, err = i.genres.FindByNames(ctx, input.Genres)
+		if err != nil {
+			return err
+		}
+		if name, ok := missingGenre(input.Genres, genres); ok {
+			return fmt.Errorf("%w: %s", domain.ErrGenreNotFound, name)
+		}
+
+		IDs := make([]domain.GenreID, len(genres))
+		for i, genre := range genres {
+			IDs[i] = genre.ID()
+		}
+		return i.series.UpdateGenres(ctx, seriesID, IDs)
+	})
+
+	if err != nil {
+		return i.presenter.Output("", nil), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": seriesID.String(),
+			"genres":    len(genres),
+		}).
+		Infof("Series genres updated")
+
+	return i.presenter.Output(seriesID, genres), nil
+}
+
+// missingGenre returns the first of the names no genre has.
+func missingGenre(names []string, genres []domain.Genre) (string, bool) {
+	found := map[string]bool{}
+	for _, genre := range genres {
+		found[strings.ToLower(genre.Name())] = true
+	}
+	for _, name := range names {
+		if !found[strings.ToLower(name)] {
+			return name, true
+		}
+	}
+	return "", false
+}
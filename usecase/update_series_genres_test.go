@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesGenresSeriesRepo struct {
+	domain.SeriesRepository
+	updated *[]domain.GenreID
+	err     error
+}
+
+func (r mockUpdateSeriesGenresSeriesRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateSeriesGenresSeriesRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+func (r mockUpdateSeriesGenresSeriesRepo) UpdateGenres(
+	_ context.Context,
+	_ domain.SeriesID,
+	genres []domain.GenreID,
+) error {
+	*r.updated = genres
+	return nil
+}
+
+type mockUpdateSeriesGenresGenreRepo struct {
+	domain.GenreRepository
+	genres []domain.Genre
+}
+
+func (r mockUpdateSeriesGenresGenreRepo) FindByNames(
+	_ context.Context,
+	_ []string,
+) ([]domain.Genre, error) {
+	return r.genres, nil
+}
+
+type mockUpdateSeriesGenresPresenter struct{}
+
+func (mockUpdateSeriesGenresPresenter) Output(
+	seriesID domain.SeriesID,
+	genres []domain.Genre,
+) UpdateSeriesGenresOutput {
+	names := make([]string, len(genres))
+	for i, genre := range genres {
+		names[i] = genre.Name()
+	}
+	return UpdateSeriesGenresOutput{
+		SeriesID: seriesID.String(),
+		Genres:   names,
+	}
+}
+
+func TestUpdateSeriesGenresInteractor(t *testing.T) {
+	t.Parallel()
+
+	genres := []domain.Genre{
+		domain.NewGenre("GenreID1", "Drama"),
+		domain.NewGenre("GenreID2", "Thriller"),
+	}
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Genres      []domain.Genre
+		Input       UpdateSeriesGenresInput
+		Expected    UpdateSeriesGenresOutput
+		ExpectedErr error
+		// ExpectedUpdated are the genres stored, nil if not updated.
+		ExpectedUpdated []domain.GenreID
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			Genres:      genres,
+			Input: UpdateSeriesGenresInput{
+				SeriesID: "SeriesID",
+				Genres:   []string{"thriller", "DRAMA"},
+			},
+			Expected: UpdateSeriesGenresOutput{
+				SeriesID: "SeriesID",
+				Genres:   []string{"Drama", "Thriller"},
+			},
+			ExpectedUpdated: []domain.GenreID{"GenreID1", "GenreID2"},
+		},
+		{
+			Description: "No genres removes them all",
+			Genres:      []domain.Genre{},
+			Input: UpdateSeriesGenresInput{
+				SeriesID: "SeriesID",
+				Genres:   []string{},
+			},
+			Expected: UpdateSeriesGenresOutput{
+				SeriesID: "SeriesID",
+				Genres:   []string{},
+			},
+			ExpectedUpdated: []domain.GenreID{},
+		},
+		{
+			Description: "Unknown genre",
+			Genres:      genres[:1],
+			Input: UpdateSeriesGenresInput{
+				SeriesID: "SeriesID",
+				Genres:   []string{"Drama", "Western"},
+			},
+			Expected:    UpdateSeriesGenresOutput{Genres: []string{}},
+			ExpectedErr: domain.ErrGenreNotFound,
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Input: UpdateSeriesGenresInput{
+				SeriesID: "SeriesID",
+				Genres:   []string{"Drama"},
+			},
+			Expected:    UpdateSeriesGenresOutput{Genres: []string{}},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var updated []domain.GenreID
+			uc := NewUpdateSeriesGenresInteractor(
+				mockUpdateSeriesGenresSeriesRepo{updated: &updated, err: test.SeriesErr},
+				mockUpdateSeriesGenresGenreRepo{genres: test.Genres},
+				mockUpdateSeriesGenresPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.True(errors.Is(err, test.ExpectedErr), err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedUpdated, updated)
+		})
+	}
+}
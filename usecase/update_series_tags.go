@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"sort"
+	"time"
+)
+
+type (
+	UpdateSeriesTagsUseCase interface {
+		Execute(context.Context, UpdateSeriesTagsInput) (UpdateSeriesTagsOutput, error)
+	}
+
+	// UpdateSeriesTagsInput replaces the tags of the series, an empty
+	// list removes them all. Tags are free-form, they are normalized
+	// and the ones no series had before are created.
+	UpdateSeriesTagsInput struct {
+		SeriesID string   `json:"-"    xml:"-"        validate:"required,uuid_rfc4122"`
+		Tags     []string `json:"tags" xml:"tags>tag" validate:"required,max=20,dive,required,max=30"`
+	}
+
+	UpdateSeriesTagsOutput struct {
+		SeriesID string   `json:"series_id" xml:"series_id"`
+		Tags     []string `json:"tags"      xml:"tags>tag"`
+	}
+
+	UpdateSeriesTagsPresenter interface {
+		Output(domain.SeriesID, []string) UpdateSeriesTagsOutput
+	}
+
+	updateSeriesTagsInteractor struct {
+		repo      domain.SeriesRepository
+		presenter UpdateSeriesTagsPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesTagsInteractor(
+	repo domain.SeriesRepository,
+	presenter UpdateSeriesTagsPresenter,
+	timeout time.Duration,
+) UpdateSeriesTagsUseCase {
+	return updateSeriesTagsInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesTagsInteractor) Execute(
+	ctx context.Context, input UpdateSeriesTagsInput,
+) (UpdateSeriesTagsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeriesTags")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	seriesID := domain.SeriesID(input.SeriesID)
+	tags := normalizeTags(input.Tags)
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.repo.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+		return i.repo.UpdateTags(ctx, seriesID, newTags(tags))
+	})
+
+	if err != nil {
+		return i.presenter.Output("", nil), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": seriesID.String(),
+			"tags":      len(tags),
+		}).
+		Infof("Series tags updated")
+
+	return i.presenter.Output(seriesID, tags), nil
+}
+
+// newTags gives the tags an ID, that the repositories keep
+// for the tags no series had before.
+func newTags(names []string) []domain.Tag {
+	tags := make([]domain.Tag, len(names))
+	for i, name := range names {
+		tags[i] = domain.NewTag(domain.TagID(domain.NewUUID()), name)
+	}
+	return tags
+}
+
+// normalizeTags normalizes the tags and drops the duplicates,
+// the remaining ones are sorted as the repositories return them.
+func normalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = domain.NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
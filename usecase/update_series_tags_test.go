@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesTagsRepo struct {
+	domain.SeriesRepository
+	t       *testing.T
+	updated *[]string
+	err     error
+}
+
+func (r mockUpdateSeriesTagsRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateSeriesTagsRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+func (r mockUpdateSeriesTagsRepo) UpdateTags(
+	_ context.Context,
+	_ domain.SeriesID,
+	tags []domain.Tag,
+) error {
+	*r.updated = make([]string, len(tags))
+	for i, tag := range tags {
+		assert.True(r.t, domain.IsValidUUID(tag.ID().String()))
+		(*r.updated)[i] = tag.Name()
+	}
+	return nil
+}
+
+type mockUpdateSeriesTagsPresenter struct{}
+
+func (mockUpdateSeriesTagsPresenter) Output(
+	seriesID domain.SeriesID,
+	tags []string,
+) UpdateSeriesTagsOutput {
+	return UpdateSeriesTagsOutput{
+		SeriesID: seriesID.String(),
+		Tags:     tags,
+	}
+}
+
+func TestUpdateSeriesTagsInteractor(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Input       UpdateSeriesTagsInput
+		Expected    UpdateSeriesTagsOutput
+		ExpectedErr error
+		// ExpectedUpdated are the tags stored, nil if not updated.
+		ExpectedUpdated []string
+	}
+	tests := []Test{
+		{
+			Description: "Tags are normalized, deduplicated and sorted",
+			Input: UpdateSeriesTagsInput{
+				SeriesID: "SeriesID",
+				Tags:     []string{"Period ", "anthology", "ANTHOLOGY", " "},
+			},
+			Expected: UpdateSeriesTagsOutput{
+				SeriesID: "SeriesID",
+				Tags:     []string{"anthology", "period"},
+			},
+			ExpectedUpdated: []string{"anthology", "period"},
+		},
+		{
+			Description: "No tags removes them all",
+			Input: UpdateSeriesTagsInput{
+				SeriesID: "SeriesID",
+				Tags:     []string{},
+			},
+			Expected: UpdateSeriesTagsOutput{
+				SeriesID: "SeriesID",
+				Tags:     []string{},
+			},
+			ExpectedUpdated: []string{},
+		},
+		{
+			Description: "Series not found",
+			SeriesErr:   domain.ErrSeriesNotFound,
+			Input: UpdateSeriesTagsInput{
+				SeriesID: "SeriesID",
+				Tags:     []string{"anthology"},
+			},
+			Expected:    UpdateSeriesTagsOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			var updated []string
+			uc := NewUpdateSeriesTagsInteractor(
+				mockUpdateSeriesTagsRepo{t: t, updated: &updated, err: test.SeriesErr},
+				mockUpdateSeriesTagsPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), test.Input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesRepository struct {
+	domain.SeriesRepository
+	current domain.Series
+	findErr error
+	result  domain.Series
+	err     error
+	txErr   *error
+}
+
+func (r mockUpdateSeriesRepository) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return r.current, r.findErr
+}
+
+func (r mockUpdateSeriesRepository) FindBySlug(
+	_ context.Context,
+	_ string,
+) (domain.Series, error) {
+	return domain.Series{}, domain.ErrSeriesNotFound
+}
+
+func (r mockUpdateSeriesRepository) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	err := fn(ctx)
+	if r.txErr != nil {
+		*r.txErr = err
+	}
+	return err
+}
+
+func (r mockUpdateSeriesRepository) Update(
+	_ context.Context,
+	_ domain.Series,
+) (domain.Series, error) {
+	return r.result, r.err
+}
+
+type mockUpdateSeriesPresenter struct {
+	result UpdateSeriesOutput
+}
+
+func (p mockUpdateSeriesPresenter) Output(domain.Series) UpdateSeriesOutput {
+	return p.result
+}
+
+func TestUpdateSeriesInteractor(t *testing.T) {
+	t.Parallel()
+
+	testOutput := UpdateSeriesOutput{
+		ID:          "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+		Title:       "Title",
+		Description: "Description",
+		Episodes:    20,
+		BeginYear:   1980,
+		EndYear:     1990,
+		Creator:     "Creator",
+		Version:     2,
+	}
+
+	type Test struct {
+		Description string
+		Repo        domain.SeriesRepository
+		PersonErr   error
+		Presenter   UpdateSeriesPresenter
+		Expected    UpdateSeriesOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			Repo:        mockUpdateSeriesRepository{},
+			Presenter: mockUpdateSeriesPresenter{
+				result: testOutput,
+			},
+			Expected:    testOutput,
+			ExpectedErr: nil,
+		},
+
+		{
+			Description: "Updating series that does not exist",
+			Repo: mockUpdateSeriesRepository{
+				findErr: domain.ErrSeriesNotFound,
+			},
+			Presenter:   mockUpdateSeriesPresenter{},
+			Expected:    UpdateSeriesOutput{},
+			ExpectedErr: domain.ErrSeriesNotFound,
+		},
+
+		{
+			Description: "Removing the end year of an ended series",
+			Repo: mockUpdateSeriesRepository{
+				current: domain.NewSeries(
+					"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+					"Title",
+					"Description",
+					1980,
+					1990,
+				),
+			},
+			Presenter:   mockUpdateSeriesPresenter{},
+			Expected:    UpdateSeriesOutput{},
+			ExpectedErr: domain.ErrStatusEndYear,
+		},
+
+		{
+			Description: "Creator not found",
+			Repo:        mockUpdateSeriesRepository{},
+			PersonErr:   domain.ErrPersonNotFound,
+			Presenter:   mockUpdateSeriesPresenter{},
+			Expected:    UpdateSeriesOutput{},
+			ExpectedErr: domain.ErrPersonNotFound,
+		},
+
+		{
+			Description: "Slug taken by another series meanwhile",
+			Repo: mockUpdateSeriesRepository{
+				err: domain.ErrSlugTaken,
+			},
+			Presenter:   mockUpdateSeriesPresenter{},
+			Expected:    UpdateSeriesOutput{},
+			ExpectedErr: domain.ErrSlugTaken,
+		},
+
+		{
+			Description: "Updating stale version of series",
+			Repo: mockUpdateSeriesRepository{
+				err: domain.ErrConcurrentModification,
+			},
+			Presenter:   mockUpdateSeriesPresenter{},
+			Expected:    UpdateSeriesOutput{},
+			ExpectedErr: domain.ErrConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewUpdateSeriesInteractor(
+				test.Repo,
+				mockSeriesNetworkRepo{},
+				mockSeriesPersonRepo{err: test.PersonErr},
+				test.Presenter,
+				1*time.Second,
+			)
+			got, err := uc.Execute(context.TODO(), UpdateSeriesInput{
+				CreatorIDs: []string{"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c"},
+			})
+			assert.ErrorIs(err, test.ExpectedErr)
+			assert.Equal(test.Expected, got)
+		})
+	}
+}
+
+func TestUpdateSeriesInteractorTakenSlug(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// The slug is in the history of another series, the transaction
+	// gets the error to roll the update back.
+	var txErr error
+	uc := NewUpdateSeriesInteractor(
+		mockUpdateSeriesRepository{
+			current: domain.NewSeries(
+				"1be9775b-8d32-4710-9ce6-7ece88e30f01",
+				"Previous title",
+				"Description",
+				1980,
+				0,
+			),
+			err:   domain.ErrSlugTaken,
+			txErr: &txErr,
+		},
+		mockSeriesNetworkRepo{},
+		mockSeriesPersonRepo{},
+		mockUpdateSeriesPresenter{},
+		1*time.Second,
+	)
+	got, err := uc.Execute(context.TODO(), UpdateSeriesInput{
+		ID:         "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+		Title:      "Title",
+		BeginYear:  1980,
+		CreatorIDs: []string{"5f2a8c34-2d6e-4a7f-9b1c-8e3d4f5a6b7c"},
+	})
+	assert.ErrorIs(err, domain.ErrSlugTaken)
+	assert.ErrorIs(txErr, domain.ErrSlugTaken)
+	assert.Equal(UpdateSeriesOutput{}, got)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	UpdateSeriesTranslationUseCase interface {
+		Execute(context.Context, UpdateSeriesTranslationInput) (UpdateSeriesTranslationOutput, error)
+	}
+
+	// UpdateSeriesTranslationInput adds the translation of the series in
+	// Language, an ISO 639-1 code, or replaces the one it already has.
+	UpdateSeriesTranslationInput struct {
+		SeriesID    string `json:"-"           xml:"-"           validate:"required,uuid_rfc4122"`
+		Language    string `json:"-"           xml:"-"           validate:"required,language"`
+		Title       string `json:"title"       xml:"title"       validate:"required,max=70"`
+		Description string `json:"description" xml:"description" validate:"required,max=200"`
+	}
+
+	UpdateSeriesTranslationOutput struct {
+		SeriesID    string `json:"series_id"   xml:"series_id"`
+		Language    string `json:"language"    xml:"language"`
+		Title       string `json:"title"       xml:"title"`
+		Description string `json:"description" xml:"description"`
+	}
+
+	UpdateSeriesTranslationPresenter interface {
+		Output(domain.SeriesID, domain.Translation) UpdateSeriesTranslationOutput
+	}
+
+	updateSeriesTranslationInteractor struct {
+		repo      domain.SeriesRepository
+		presenter UpdateSeriesTranslationPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateSeriesTranslationInteractor(
+	repo domain.SeriesRepository,
+	presenter UpdateSeriesTranslationPresenter,
+	timeout time.Duration,
+) UpdateSeriesTranslationUseCase {
+	return updateSeriesTranslationInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateSeriesTranslationInteractor) Execute(
+	ctx context.Context, input UpdateSeriesTranslationInput,
+) (UpdateSeriesTranslationOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateSeriesTranslation")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	seriesID := domain.SeriesID(input.SeriesID)
+	translation := domain.NewTranslation(input.Language, input.Title, input.Description)
+
+	err := i.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := i.repo.FindByID(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+		return i.repo.UpdateTranslation(ctx, seriesID, translation)
+	})
+
+	if err != nil {
+		return i.presenter.Output("", domain.Translation{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"series_id": seriesID.String(),
+			"language":  translation.Language(),
+		}).
+		Infof("Series translation updated")
+
+	return i.presenter.Output(seriesID, translation), nil
+}
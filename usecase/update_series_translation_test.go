@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateSeriesTranslationRepo struct {
+	domain.SeriesRepository
+	updated *[]domain.Translation
+	err     error
+}
+
+func (r mockUpdateSeriesTranslationRepo) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (r mockUpdateSeriesTranslationRepo) FindByID(
+	_ context.Context,
+	_ domain.SeriesID,
+) (domain.Series, error) {
+	return domain.Series{}, r.err
+}
+
+func (r mockUpdateSeriesTranslationRepo) UpdateTranslation(
+	_ context.Context,
+	_ domain.SeriesID,
+	translation domain.Translation,
+) error {
+	*r.updated = append(*r.updated, translation)
+	return nil
+}
+
+type mockUpdateSeriesTranslationPresenter struct{}
+
+func (mockUpdateSeriesTranslationPresenter) Output(
+	seriesID domain.SeriesID,
+	translation domain.Translation,
+) UpdateSeriesTranslationOutput {
+	return UpdateSeriesTranslationOutput{
+		SeriesID:    seriesID.String(),
+		Language:    translation.Language(),
+		Title:       translation.Title(),
+		Description: translation.Description(),
+	}
+}
+
+func TestUpdateSeriesTranslationInteractor(t *testing.T) {
+	t.Parallel()
+
+	input := UpdateSeriesTranslationInput{
+		SeriesID:    "SeriesID",
+		Language:    "fr",
+		Title:       "Le Titre",
+		Description: "La description",
+	}
+
+	type Test struct {
+		Description string
+		SeriesErr   error
+		Expected    UpdateSeriesTranslationOutput
+		ExpectedErr error
+		// ExpectedUpdated are the translations stored.
+		ExpectedUpdated []domain.Translation
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			Expected: UpdateSeriesTranslationOutput{
+				SeriesID:    "SeriesID",
+				Language:    "fr",
+				Title:       "Le Titre",
+				Description: "La description",
+			},
+			ExpectedUpdated: []domain.Translation{
+				domain.NewTranslation("fr", "Le Titre", "La description"),
+			},
+		},
+		{
+			Description:     "Series not found",
+			SeriesErr:       domain.ErrSeriesNotFound,
+			Expected:        UpdateSeriesTranslationOutput{},
+			ExpectedErr:     domain.ErrSeriesNotFound,
+			ExpectedUpdated: []domain.Translation{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			updated := []domain.Translation{}
+			uc := NewUpdateSeriesTranslationInteractor(
+				mockUpdateSeriesTranslationRepo{updated: &updated, err: test.SeriesErr},
+				mockUpdateSeriesTranslationPresenter{},
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), input)
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+			assert.Equal(test.ExpectedUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"series/adapter/logger"
+	"series/domain"
+	"time"
+)
+
+type (
+	UpdateWebhookUseCase interface {
+		Execute(context.Context, UpdateWebhookInput) (UpdateWebhookOutput, error)
+	}
+
+	// UpdateWebhookInput replaces the URL and the events of a webhook,
+	// its secret is kept.
+	UpdateWebhookInput struct {
+		ID     string   `json:"-"      xml:"-"            validate:"required,uuid_rfc4122"`
+		URL    string   `json:"url"    xml:"url"          validate:"required,http_url,max=2000"`
+		Events []string `json:"events" xml:"events>event" validate:"required,min=1,dive,oneof=series.created review.created"`
+	}
+
+	UpdateWebhookOutput struct {
+		ID        string   `json:"id"         xml:"id"`
+		URL       string   `json:"url"        xml:"url"`
+		Events    []string `json:"events"     xml:"events>event"`
+		CreatedAt string   `json:"created_at" xml:"created_at"`
+		UpdatedAt string   `json:"updated_at" xml:"updated_at"`
+	}
+
+	UpdateWebhookPresenter interface {
+		Output(domain.Webhook) UpdateWebhookOutput
+	}
+
+	updateWebhookInteractor struct {
+		repo      domain.WebhookRepository
+		presenter UpdateWebhookPresenter
+		timeout   time.Duration
+	}
+)
+
+func NewUpdateWebhookInteractor(
+	repo domain.WebhookRepository,
+	presenter UpdateWebhookPresenter,
+	timeout time.Duration,
+) UpdateWebhookUseCase {
+	return updateWebhookInteractor{
+		repo:      repo,
+		presenter: presenter,
+		timeout:   timeout,
+	}
+}
+
+func (i updateWebhookInteractor) Execute(
+	ctx context.Context, input UpdateWebhookInput,
+) (UpdateWebhookOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase.UpdateWebhook")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	webhook := domain.NewWebhook(
+		domain.WebhookID(input.ID),
+		input.URL,
+		"",
+		eventTypes(input.Events),
+	)
+
+	webhook, err := i.repo.Update(ctx, webhook)
+	if err != nil {
+		return i.presenter.Output(domain.Webhook{}), err
+	}
+
+	logger.FromContext(ctx).
+		WithFields(logger.Fields{
+			"webhook_id": webhook.ID().String(),
+			"url":        webhook.URL(),
+		}).
+		Infof("Webhook updated")
+
+	return i.presenter.Output(webhook), nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"series/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateWebhookRepo struct {
+	domain.WebhookRepository
+	result domain.Webhook
+	err    error
+}
+
+func (r mockUpdateWebhookRepo) Update(
+	_ context.Context,
+	_ domain.Webhook,
+) (domain.Webhook, error) {
+	return r.result, r.err
+}
+
+type mockUpdateWebhookPresenter struct {
+	result UpdateWebhookOutput
+}
+
+func (p mockUpdateWebhookPresenter) Output(domain.Webhook) UpdateWebhookOutput {
+	return p.result
+}
+
+func TestUpdateWebhookInteractor(t *testing.T) {
+	t.Parallel()
+
+	testOutput := UpdateWebhookOutput{
+		ID:     "1be9775b-8d32-4710-9ce6-7ece88e30f01",
+		URL:    "http://localhost/hook",
+		Events: []string{"review.created"},
+	}
+	testErr := errors.New("Error")
+
+	type Test struct {
+		Description string
+		Repo        domain.WebhookRepository
+		Presenter   UpdateWebhookPresenter
+		Expected    UpdateWebhookOutput
+		ExpectedErr error
+	}
+	tests := []Test{
+		{
+			Description: "Successful update",
+			Repo:        mockUpdateWebhookRepo{},
+			Presenter:   mockUpdateWebhookPresenter{result: testOutput},
+			Expected:    testOutput,
+			ExpectedErr: nil,
+		},
+		{
+			Description: "Updating webhook that does not exist",
+			Repo:        mockUpdateWebhookRepo{err: domain.ErrWebhookNotFound},
+			Presenter:   mockUpdateWebhookPresenter{},
+			Expected:    UpdateWebhookOutput{},
+			ExpectedErr: domain.ErrWebhookNotFound,
+		},
+		{
+			Description: "Some error",
+			Repo:        mockUpdateWebhookRepo{err: testErr},
+			Presenter:   mockUpdateWebhookPresenter{},
+			Expected:    UpdateWebhookOutput{},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			assert := assert.New(t)
+			uc := NewUpdateWebhookInteractor(
+				test.Repo,
+				test.Presenter,
+				1*time.Second,
+			)
+			output, err := uc.Execute(context.TODO(), UpdateWebhookInput{})
+			assert.Equal(test.ExpectedErr, err)
+			assert.Equal(test.Expected, output)
+		})
+	}
+}